@@ -2138,6 +2138,376 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateTaskQueueConfigRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueueConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueConfigRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueueConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueConfigRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueueConfigRequest:
+		that1 = t
+	case UpdateTaskQueueConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueConfigResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueueConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueConfigResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueueConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueConfigResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueueConfigResponse:
+		that1 = t
+	case UpdateTaskQueueConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *ListTaskQueueBacklogRequest:
+		that1 = t
+	case ListTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *ListTaskQueueBacklogResponse:
+		that1 = t
+	case ListTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteTaskQueueBacklogTasksRequest to the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteTaskQueueBacklogTasksRequest from the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteTaskQueueBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteTaskQueueBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteTaskQueueBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteTaskQueueBacklogTasksRequest
+	switch t := that.(type) {
+	case *DeleteTaskQueueBacklogTasksRequest:
+		that1 = t
+	case DeleteTaskQueueBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteTaskQueueBacklogTasksResponse to the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteTaskQueueBacklogTasksResponse from the protobuf v3 wire format
+func (val *DeleteTaskQueueBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteTaskQueueBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteTaskQueueBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteTaskQueueBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteTaskQueueBacklogTasksResponse
+	switch t := that.(type) {
+	case *DeleteTaskQueueBacklogTasksResponse:
+		that1 = t
+	case DeleteTaskQueueBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueWorkersRequest to the protobuf v3 wire format
+func (val *ListTaskQueueWorkersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueWorkersRequest from the protobuf v3 wire format
+func (val *ListTaskQueueWorkersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueWorkersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueWorkersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueWorkersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueWorkersRequest
+	switch t := that.(type) {
+	case *ListTaskQueueWorkersRequest:
+		that1 = t
+	case ListTaskQueueWorkersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueWorkersResponse to the protobuf v3 wire format
+func (val *ListTaskQueueWorkersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueWorkersResponse from the protobuf v3 wire format
+func (val *ListTaskQueueWorkersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueWorkersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueWorkersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueWorkersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueWorkersResponse
+	switch t := that.(type) {
+	case *ListTaskQueueWorkersResponse:
+		that1 = t
+	case ListTaskQueueWorkersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskQueueScalingRecommendationRequest to the protobuf v3 wire format
+func (val *GetTaskQueueScalingRecommendationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskQueueScalingRecommendationRequest from the protobuf v3 wire format
+func (val *GetTaskQueueScalingRecommendationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskQueueScalingRecommendationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskQueueScalingRecommendationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskQueueScalingRecommendationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskQueueScalingRecommendationRequest
+	switch t := that.(type) {
+	case *GetTaskQueueScalingRecommendationRequest:
+		that1 = t
+	case GetTaskQueueScalingRecommendationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskQueueScalingRecommendationResponse to the protobuf v3 wire format
+func (val *GetTaskQueueScalingRecommendationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskQueueScalingRecommendationResponse from the protobuf v3 wire format
+func (val *GetTaskQueueScalingRecommendationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskQueueScalingRecommendationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskQueueScalingRecommendationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskQueueScalingRecommendationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskQueueScalingRecommendationResponse
+	switch t := that.(type) {
+	case *GetTaskQueueScalingRecommendationResponse:
+		that1 = t
+	case GetTaskQueueScalingRecommendationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
 func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3321,3 +3691,299 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DescribeNexusEndpointRequest to the protobuf v3 wire format
+func (val *DescribeNexusEndpointRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusEndpointRequest from the protobuf v3 wire format
+func (val *DescribeNexusEndpointRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusEndpointRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusEndpointRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusEndpointRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusEndpointRequest
+	switch t := that.(type) {
+	case *DescribeNexusEndpointRequest:
+		that1 = t
+	case DescribeNexusEndpointRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusEndpointResponse to the protobuf v3 wire format
+func (val *DescribeNexusEndpointResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusEndpointResponse from the protobuf v3 wire format
+func (val *DescribeNexusEndpointResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusEndpointResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusEndpointResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusEndpointResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusEndpointResponse
+	switch t := that.(type) {
+	case *DescribeNexusEndpointResponse:
+		that1 = t
+	case DescribeNexusEndpointResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BackupPersistenceRequest to the protobuf v3 wire format
+func (val *BackupPersistenceRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BackupPersistenceRequest from the protobuf v3 wire format
+func (val *BackupPersistenceRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BackupPersistenceRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BackupPersistenceRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BackupPersistenceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BackupPersistenceRequest
+	switch t := that.(type) {
+	case *BackupPersistenceRequest:
+		that1 = t
+	case BackupPersistenceRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BackupPersistenceResponse to the protobuf v3 wire format
+func (val *BackupPersistenceResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BackupPersistenceResponse from the protobuf v3 wire format
+func (val *BackupPersistenceResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BackupPersistenceResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BackupPersistenceResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BackupPersistenceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BackupPersistenceResponse
+	switch t := that.(type) {
+	case *BackupPersistenceResponse:
+		that1 = t
+	case BackupPersistenceResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListUsageRecordsRequest to the protobuf v3 wire format
+func (val *ListUsageRecordsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListUsageRecordsRequest from the protobuf v3 wire format
+func (val *ListUsageRecordsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListUsageRecordsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListUsageRecordsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListUsageRecordsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListUsageRecordsRequest
+	switch t := that.(type) {
+	case *ListUsageRecordsRequest:
+		that1 = t
+	case ListUsageRecordsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListUsageRecordsResponse to the protobuf v3 wire format
+func (val *ListUsageRecordsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListUsageRecordsResponse from the protobuf v3 wire format
+func (val *ListUsageRecordsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListUsageRecordsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListUsageRecordsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListUsageRecordsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListUsageRecordsResponse
+	switch t := that.(type) {
+	case *ListUsageRecordsResponse:
+		that1 = t
+	case ListUsageRecordsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointOutboundSettingsRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundSettingsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointOutboundSettingsRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundSettingsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointOutboundSettingsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointOutboundSettingsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointOutboundSettingsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointOutboundSettingsRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointOutboundSettingsRequest:
+		that1 = t
+	case UpdateNexusEndpointOutboundSettingsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointOutboundSettingsResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundSettingsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointOutboundSettingsResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointOutboundSettingsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointOutboundSettingsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointOutboundSettingsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointOutboundSettingsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointOutboundSettingsResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointOutboundSettingsResponse:
+		that1 = t
+	case UpdateNexusEndpointOutboundSettingsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -70,6 +70,11 @@ const (
 	AdminService_RefreshWorkflowTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
 	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
 	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_UpdateTaskQueueConfig_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/UpdateTaskQueueConfig"
+	AdminService_ListTaskQueueBacklog_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListTaskQueueBacklog"
+	AdminService_DeleteTaskQueueBacklogTasks_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/DeleteTaskQueueBacklogTasks"
+	AdminService_ListTaskQueueWorkers_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListTaskQueueWorkers"
+	AdminService_GetTaskQueueScalingRecommendation_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueScalingRecommendation"
 	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
 	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
 	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
@@ -85,6 +90,10 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_DescribeNexusEndpoint_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeNexusEndpoint"
+	AdminService_UpdateNexusEndpointOutboundSettings_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointOutboundSettings"
+	AdminService_BackupPersistence_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/BackupPersistence"
+	AdminService_ListUsageRecords_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListUsageRecords"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -156,6 +165,17 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// UpdateTaskQueueConfig updates the per-type dispatch rate limits of a task queue.
+	UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error)
+	// ListTaskQueueBacklog pages through the backlog of a task queue partition with optional filters.
+	ListTaskQueueBacklog(ctx context.Context, in *ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogResponse, error)
+	// DeleteTaskQueueBacklogTasks deletes a range of backlog tasks from a task queue partition.
+	DeleteTaskQueueBacklogTasks(ctx context.Context, in *DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueBacklogTasksResponse, error)
+	// ListTaskQueueWorkers lists the workers recently seen polling a task queue, with their reported slot usage.
+	ListTaskQueueWorkers(ctx context.Context, in *ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*ListTaskQueueWorkersResponse, error)
+	// GetTaskQueueScalingRecommendation returns the backlog and rate stats of a task queue, aggregated across all
+	// partitions and versions, together with a recommended worker scaling ratio for autoscalers.
+	GetTaskQueueScalingRecommendation(ctx context.Context, in *GetTaskQueueScalingRecommendationRequest, opts ...grpc.CallOption) (*GetTaskQueueScalingRecommendationResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
@@ -175,6 +195,18 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// DescribeNexusEndpoint returns the state of the circuit breakers all history hosts maintain for a Nexus endpoint.
+	DescribeNexusEndpoint(ctx context.Context, in *DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*DescribeNexusEndpointResponse, error)
+	// UpdateNexusEndpointOutboundSettings sets the outbound TLS and auth header settings of a Nexus endpoint with an
+	// external target. These settings are not part of the public endpoint spec.
+	UpdateNexusEndpointOutboundSettings(ctx context.Context, in *UpdateNexusEndpointOutboundSettingsRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointOutboundSettingsResponse, error)
+	// BackupPersistence writes a consistent copy of a persistence store to a file on the host running the frontend
+	// service, while the cluster keeps serving requests. Only supported by SQL stores whose plugin can make online
+	// backups, e.g. SQLite.
+	BackupPersistence(ctx context.Context, in *BackupPersistenceRequest, opts ...grpc.CallOption) (*BackupPersistenceResponse, error)
+	// ListUsageRecords returns the resource usage metered by the history service for a namespace, aggregated per
+	// workflow type and cost center over fixed windows.
+	ListUsageRecords(ctx context.Context, in *ListUsageRecordsRequest, opts ...grpc.CallOption) (*ListUsageRecordsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -437,6 +469,51 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error) {
+	out := new(UpdateTaskQueueConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaskQueueConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTaskQueueBacklog(ctx context.Context, in *ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogResponse, error) {
+	out := new(ListTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteTaskQueueBacklogTasks(ctx context.Context, in *DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueBacklogTasksResponse, error) {
+	out := new(DeleteTaskQueueBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteTaskQueueBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTaskQueueWorkers(ctx context.Context, in *ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*ListTaskQueueWorkersResponse, error) {
+	out := new(ListTaskQueueWorkersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaskQueueWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetTaskQueueScalingRecommendation(ctx context.Context, in *GetTaskQueueScalingRecommendationRequest, opts ...grpc.CallOption) (*GetTaskQueueScalingRecommendationResponse, error) {
+	out := new(GetTaskQueueScalingRecommendationResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetTaskQueueScalingRecommendation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, AdminService_DeleteWorkflowExecution_FullMethodName, in, out, opts...)
@@ -594,6 +671,42 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeNexusEndpoint(ctx context.Context, in *DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*DescribeNexusEndpointResponse, error) {
+	out := new(DescribeNexusEndpointResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeNexusEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointOutboundSettings(ctx context.Context, in *UpdateNexusEndpointOutboundSettingsRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointOutboundSettingsResponse, error) {
+	out := new(UpdateNexusEndpointOutboundSettingsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointOutboundSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BackupPersistence(ctx context.Context, in *BackupPersistenceRequest, opts ...grpc.CallOption) (*BackupPersistenceResponse, error) {
+	out := new(BackupPersistenceResponse)
+	err := c.cc.Invoke(ctx, AdminService_BackupPersistence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUsageRecords(ctx context.Context, in *ListUsageRecordsRequest, opts ...grpc.CallOption) (*ListUsageRecordsResponse, error) {
+	out := new(ListUsageRecordsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsageRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -663,6 +776,17 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// UpdateTaskQueueConfig updates the per-type dispatch rate limits of a task queue.
+	UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error)
+	// ListTaskQueueBacklog pages through the backlog of a task queue partition with optional filters.
+	ListTaskQueueBacklog(context.Context, *ListTaskQueueBacklogRequest) (*ListTaskQueueBacklogResponse, error)
+	// DeleteTaskQueueBacklogTasks deletes a range of backlog tasks from a task queue partition.
+	DeleteTaskQueueBacklogTasks(context.Context, *DeleteTaskQueueBacklogTasksRequest) (*DeleteTaskQueueBacklogTasksResponse, error)
+	// ListTaskQueueWorkers lists the workers recently seen polling a task queue, with their reported slot usage.
+	ListTaskQueueWorkers(context.Context, *ListTaskQueueWorkersRequest) (*ListTaskQueueWorkersResponse, error)
+	// GetTaskQueueScalingRecommendation returns the backlog and rate stats of a task queue, aggregated across all
+	// partitions and versions, together with a recommended worker scaling ratio for autoscalers.
+	GetTaskQueueScalingRecommendation(context.Context, *GetTaskQueueScalingRecommendationRequest) (*GetTaskQueueScalingRecommendationResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
@@ -682,6 +806,18 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// DescribeNexusEndpoint returns the state of the circuit breakers all history hosts maintain for a Nexus endpoint.
+	DescribeNexusEndpoint(context.Context, *DescribeNexusEndpointRequest) (*DescribeNexusEndpointResponse, error)
+	// UpdateNexusEndpointOutboundSettings sets the outbound TLS and auth header settings of a Nexus endpoint with an
+	// external target. These settings are not part of the public endpoint spec.
+	UpdateNexusEndpointOutboundSettings(context.Context, *UpdateNexusEndpointOutboundSettingsRequest) (*UpdateNexusEndpointOutboundSettingsResponse, error)
+	// BackupPersistence writes a consistent copy of a persistence store to a file on the host running the frontend
+	// service, while the cluster keeps serving requests. Only supported by SQL stores whose plugin can make online
+	// backups, e.g. SQLite.
+	BackupPersistence(context.Context, *BackupPersistenceRequest) (*BackupPersistenceResponse, error)
+	// ListUsageRecords returns the resource usage metered by the history service for a namespace, aggregated per
+	// workflow type and cost center over fixed windows.
+	ListUsageRecords(context.Context, *ListUsageRecordsRequest) (*ListUsageRecordsResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -773,6 +909,21 @@ func (UnimplementedAdminServiceServer) ResendReplicationTasks(context.Context, *
 func (UnimplementedAdminServiceServer) GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTaskQueueBacklog(context.Context, *ListTaskQueueBacklogRequest) (*ListTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueBacklog not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteTaskQueueBacklogTasks(context.Context, *DeleteTaskQueueBacklogTasksRequest) (*DeleteTaskQueueBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskQueueBacklogTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTaskQueueWorkers(context.Context, *ListTaskQueueWorkersRequest) (*ListTaskQueueWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueWorkers not implemented")
+}
+func (UnimplementedAdminServiceServer) GetTaskQueueScalingRecommendation(context.Context, *GetTaskQueueScalingRecommendationRequest) (*GetTaskQueueScalingRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueScalingRecommendation not implemented")
+}
 func (UnimplementedAdminServiceServer) DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
@@ -818,6 +969,18 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeNexusEndpoint(context.Context, *DescribeNexusEndpointRequest) (*DescribeNexusEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNexusEndpoint not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointOutboundSettings(context.Context, *UpdateNexusEndpointOutboundSettingsRequest) (*UpdateNexusEndpointOutboundSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointOutboundSettings not implemented")
+}
+func (UnimplementedAdminServiceServer) BackupPersistence(context.Context, *BackupPersistenceRequest) (*BackupPersistenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupPersistence not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUsageRecords(context.Context, *ListUsageRecordsRequest) (*ListUsageRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsageRecords not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1335,6 +1498,96 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateTaskQueueConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaskQueueConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaskQueueConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaskQueueConfig(ctx, req.(*UpdateTaskQueueConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaskQueueBacklog(ctx, req.(*ListTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteTaskQueueBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskQueueBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteTaskQueueBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteTaskQueueBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteTaskQueueBacklogTasks(ctx, req.(*DeleteTaskQueueBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTaskQueueWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaskQueueWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaskQueueWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaskQueueWorkers(ctx, req.(*ListTaskQueueWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetTaskQueueScalingRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskQueueScalingRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTaskQueueScalingRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetTaskQueueScalingRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTaskQueueScalingRecommendation(ctx, req.(*GetTaskQueueScalingRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1613,6 +1866,78 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeNexusEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNexusEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNexusEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeNexusEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNexusEndpoint(ctx, req.(*DescribeNexusEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointOutboundSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointOutboundSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointOutboundSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointOutboundSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointOutboundSettings(ctx, req.(*UpdateNexusEndpointOutboundSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BackupPersistence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupPersistenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BackupPersistence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BackupPersistence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BackupPersistence(ctx, req.(*BackupPersistenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUsageRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsageRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsageRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsageRecords(ctx, req.(*ListUsageRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1732,6 +2057,26 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueueConfig",
+			Handler:    _AdminService_UpdateTaskQueueConfig_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueBacklog",
+			Handler:    _AdminService_ListTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "DeleteTaskQueueBacklogTasks",
+			Handler:    _AdminService_DeleteTaskQueueBacklogTasks_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueWorkers",
+			Handler:    _AdminService_ListTaskQueueWorkers_Handler,
+		},
+		{
+			MethodName: "GetTaskQueueScalingRecommendation",
+			Handler:    _AdminService_GetTaskQueueScalingRecommendation_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
@@ -1788,6 +2133,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "DescribeNexusEndpoint",
+			Handler:    _AdminService_DescribeNexusEndpoint_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointOutboundSettings",
+			Handler:    _AdminService_UpdateNexusEndpointOutboundSettings_Handler,
+		},
+		{
+			MethodName: "BackupPersistence",
+			Handler:    _AdminService_BackupPersistence_Handler,
+		},
+		{
+			MethodName: "ListUsageRecords",
+			Handler:    _AdminService_ListUsageRecords_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
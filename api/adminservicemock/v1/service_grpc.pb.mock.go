@@ -127,6 +127,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddTasks(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).AddTasks), varargs...)
 }
 
+// BackupPersistence mocks base method.
+func (m *MockAdminServiceClient) BackupPersistence(ctx context.Context, in *adminservice.BackupPersistenceRequest, opts ...grpc.CallOption) (*adminservice.BackupPersistenceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackupPersistence", varargs...)
+	ret0, _ := ret[0].(*adminservice.BackupPersistenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupPersistence indicates an expected call of BackupPersistence.
+func (mr *MockAdminServiceClientMockRecorder) BackupPersistence(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupPersistence", reflect.TypeOf((*MockAdminServiceClient)(nil).BackupPersistence), varargs...)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceClient) CancelDLQJob(ctx context.Context, in *adminservice.CancelDLQJobRequest, opts ...grpc.CallOption) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +207,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceClient) DeleteTaskQueueBacklogTasks(ctx context.Context, in *adminservice.DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskQueueBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueBacklogTasks indicates an expected call of DeleteTaskQueueBacklogTasks.
+func (mr *MockAdminServiceClientMockRecorder) DeleteTaskQueueBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteTaskQueueBacklogTasks), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -287,6 +327,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNexusEndpoint mocks base method.
+func (m *MockAdminServiceClient) DescribeNexusEndpoint(ctx context.Context, in *adminservice.DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*adminservice.DescribeNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNexusEndpoint", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNexusEndpoint indicates an expected call of DescribeNexusEndpoint.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNexusEndpoint(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNexusEndpoint", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNexusEndpoint), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -507,6 +567,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetShard(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockAdminServiceClient)(nil).GetShard), varargs...)
 }
 
+// GetTaskQueueScalingRecommendation mocks base method.
+func (m *MockAdminServiceClient) GetTaskQueueScalingRecommendation(ctx context.Context, in *adminservice.GetTaskQueueScalingRecommendationRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskQueueScalingRecommendation", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetTaskQueueScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueScalingRecommendation indicates an expected call of GetTaskQueueScalingRecommendation.
+func (mr *MockAdminServiceClientMockRecorder) GetTaskQueueScalingRecommendation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueScalingRecommendation", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskQueueScalingRecommendation), varargs...)
+}
+
 // GetTaskQueueTasks mocks base method.
 func (m *MockAdminServiceClient) GetTaskQueueTasks(ctx context.Context, in *adminservice.GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -667,6 +747,66 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) ListTaskQueueBacklog(ctx context.Context, in *adminservice.ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklog indicates an expected call of ListTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) ListTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTaskQueueBacklog), varargs...)
+}
+
+// ListTaskQueueWorkers mocks base method.
+func (m *MockAdminServiceClient) ListTaskQueueWorkers(ctx context.Context, in *adminservice.ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueWorkers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueWorkers indicates an expected call of ListTaskQueueWorkers.
+func (mr *MockAdminServiceClientMockRecorder) ListTaskQueueWorkers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueWorkers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTaskQueueWorkers), varargs...)
+}
+
+// ListUsageRecords mocks base method.
+func (m *MockAdminServiceClient) ListUsageRecords(ctx context.Context, in *adminservice.ListUsageRecordsRequest, opts ...grpc.CallOption) (*adminservice.ListUsageRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageRecords", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListUsageRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageRecords indicates an expected call of ListUsageRecords.
+func (mr *MockAdminServiceClientMockRecorder) ListUsageRecords(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecords", reflect.TypeOf((*MockAdminServiceClient)(nil).ListUsageRecords), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -927,6 +1067,46 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpdateNexusEndpointOutboundSettings mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointOutboundSettings(ctx context.Context, in *adminservice.UpdateNexusEndpointOutboundSettingsRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointOutboundSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointOutboundSettings", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointOutboundSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointOutboundSettings indicates an expected call of UpdateNexusEndpointOutboundSettings.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointOutboundSettings(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointOutboundSettings", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointOutboundSettings), varargs...)
+}
+
+// UpdateTaskQueueConfig mocks base method.
+func (m *MockAdminServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *adminservice.UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*adminservice.UpdateTaskQueueConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueConfig indicates an expected call of UpdateTaskQueueConfig.
+func (mr *MockAdminServiceClientMockRecorder) UpdateTaskQueueConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateTaskQueueConfig), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1134,6 +1314,21 @@ func (mr *MockAdminServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// BackupPersistence mocks base method.
+func (m *MockAdminServiceServer) BackupPersistence(arg0 context.Context, arg1 *adminservice.BackupPersistenceRequest) (*adminservice.BackupPersistenceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupPersistence", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.BackupPersistenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupPersistence indicates an expected call of BackupPersistence.
+func (mr *MockAdminServiceServerMockRecorder) BackupPersistence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupPersistence", reflect.TypeOf((*MockAdminServiceServer)(nil).BackupPersistence), arg0, arg1)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceServer) CancelDLQJob(arg0 context.Context, arg1 *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1179,6 +1374,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteTaskQueueBacklogTasks mocks base method.
+func (m *MockAdminServiceServer) DeleteTaskQueueBacklogTasks(arg0 context.Context, arg1 *adminservice.DeleteTaskQueueBacklogTasksRequest) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskQueueBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteTaskQueueBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueBacklogTasks indicates an expected call of DeleteTaskQueueBacklogTasks.
+func (mr *MockAdminServiceServerMockRecorder) DeleteTaskQueueBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueBacklogTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteTaskQueueBacklogTasks), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1254,6 +1464,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeNexusEndpoint mocks base method.
+func (m *MockAdminServiceServer) DescribeNexusEndpoint(arg0 context.Context, arg1 *adminservice.DescribeNexusEndpointRequest) (*adminservice.DescribeNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNexusEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNexusEndpoint indicates an expected call of DescribeNexusEndpoint.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNexusEndpoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNexusEndpoint", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNexusEndpoint), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1419,6 +1644,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetShard(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockAdminServiceServer)(nil).GetShard), arg0, arg1)
 }
 
+// GetTaskQueueScalingRecommendation mocks base method.
+func (m *MockAdminServiceServer) GetTaskQueueScalingRecommendation(arg0 context.Context, arg1 *adminservice.GetTaskQueueScalingRecommendationRequest) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskQueueScalingRecommendation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetTaskQueueScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueScalingRecommendation indicates an expected call of GetTaskQueueScalingRecommendation.
+func (mr *MockAdminServiceServerMockRecorder) GetTaskQueueScalingRecommendation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueScalingRecommendation", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskQueueScalingRecommendation), arg0, arg1)
+}
+
 // GetTaskQueueTasks mocks base method.
 func (m *MockAdminServiceServer) GetTaskQueueTasks(arg0 context.Context, arg1 *adminservice.GetTaskQueueTasksRequest) (*adminservice.GetTaskQueueTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1539,6 +1779,51 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) ListTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.ListTaskQueueBacklogRequest) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklog indicates an expected call of ListTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) ListTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTaskQueueBacklog), arg0, arg1)
+}
+
+// ListTaskQueueWorkers mocks base method.
+func (m *MockAdminServiceServer) ListTaskQueueWorkers(arg0 context.Context, arg1 *adminservice.ListTaskQueueWorkersRequest) (*adminservice.ListTaskQueueWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueWorkers indicates an expected call of ListTaskQueueWorkers.
+func (mr *MockAdminServiceServerMockRecorder) ListTaskQueueWorkers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueWorkers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTaskQueueWorkers), arg0, arg1)
+}
+
+// ListUsageRecords mocks base method.
+func (m *MockAdminServiceServer) ListUsageRecords(arg0 context.Context, arg1 *adminservice.ListUsageRecordsRequest) (*adminservice.ListUsageRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsageRecords", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListUsageRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageRecords indicates an expected call of ListUsageRecords.
+func (mr *MockAdminServiceServerMockRecorder) ListUsageRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecords", reflect.TypeOf((*MockAdminServiceServer)(nil).ListUsageRecords), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1733,6 +2018,36 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateNexusEndpointOutboundSettings mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointOutboundSettings(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointOutboundSettingsRequest) (*adminservice.UpdateNexusEndpointOutboundSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointOutboundSettings", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointOutboundSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointOutboundSettings indicates an expected call of UpdateNexusEndpointOutboundSettings.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointOutboundSettings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointOutboundSettings", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointOutboundSettings), arg0, arg1)
+}
+
+// UpdateTaskQueueConfig mocks base method.
+func (m *MockAdminServiceServer) UpdateTaskQueueConfig(arg0 context.Context, arg1 *adminservice.UpdateTaskQueueConfigRequest) (*adminservice.UpdateTaskQueueConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueConfig indicates an expected call of UpdateTaskQueueConfig.
+func (mr *MockAdminServiceServerMockRecorder) UpdateTaskQueueConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateTaskQueueConfig), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
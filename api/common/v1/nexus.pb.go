@@ -0,0 +1,240 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/common/v1/nexus.proto
+
+package commonspb
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/server/api/enums/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NexusEndpointCircuitBreakerInfo describes the circuit breaker a single history host maintains for a Nexus endpoint.
+type NexusEndpointCircuitBreakerInfo struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// Name of the endpoint as last seen by the host. Empty if the host has not dispatched any requests to the endpoint.
+	EndpointName string                              `protobuf:"bytes,2,opt,name=endpoint_name,json=endpointName,proto3" json:"endpoint_name,omitempty"`
+	State        v1.NexusEndpointCircuitBreakerState `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.enums.v1.NexusEndpointCircuitBreakerState" json:"state,omitempty"`
+	// Request counts for the current generation of the circuit breaker. Counts are cleared on every state change and
+	// periodically while the circuit breaker is closed.
+	Requests             uint32 `protobuf:"varint,4,opt,name=requests,proto3" json:"requests,omitempty"`
+	TotalSuccesses       uint32 `protobuf:"varint,5,opt,name=total_successes,json=totalSuccesses,proto3" json:"total_successes,omitempty"`
+	TotalFailures        uint32 `protobuf:"varint,6,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty"`
+	ConsecutiveSuccesses uint32 `protobuf:"varint,7,opt,name=consecutive_successes,json=consecutiveSuccesses,proto3" json:"consecutive_successes,omitempty"`
+	ConsecutiveFailures  uint32 `protobuf:"varint,8,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Time of the last active health probe. Unset if health probing is disabled or the endpoint was never probed.
+	LastProbeTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_probe_time,json=lastProbeTime,proto3" json:"last_probe_time,omitempty"`
+	// Error returned by the last active health probe. Empty if the probe succeeded.
+	LastProbeFailure string `protobuf:"bytes,10,opt,name=last_probe_failure,json=lastProbeFailure,proto3" json:"last_probe_failure,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) Reset() {
+	*x = NexusEndpointCircuitBreakerInfo{}
+	mi := &file_temporal_server_api_common_v1_nexus_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointCircuitBreakerInfo) ProtoMessage() {}
+
+func (x *NexusEndpointCircuitBreakerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_common_v1_nexus_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointCircuitBreakerInfo.ProtoReflect.Descriptor instead.
+func (*NexusEndpointCircuitBreakerInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_common_v1_nexus_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetEndpointName() string {
+	if x != nil {
+		return x.EndpointName
+	}
+	return ""
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetState() v1.NexusEndpointCircuitBreakerState {
+	if x != nil {
+		return x.State
+	}
+	return v1.NexusEndpointCircuitBreakerState(0)
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetTotalSuccesses() uint32 {
+	if x != nil {
+		return x.TotalSuccesses
+	}
+	return 0
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetTotalFailures() uint32 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetConsecutiveSuccesses() uint32 {
+	if x != nil {
+		return x.ConsecutiveSuccesses
+	}
+	return 0
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetLastProbeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastProbeTime
+	}
+	return nil
+}
+
+func (x *NexusEndpointCircuitBreakerInfo) GetLastProbeFailure() string {
+	if x != nil {
+		return x.LastProbeFailure
+	}
+	return ""
+}
+
+var File_temporal_server_api_common_v1_nexus_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_common_v1_nexus_proto_rawDesc = "" +
+	"\n" +
+	")temporal/server/api/common/v1/nexus.proto\x12\x1dtemporal.server.api.common.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(temporal/server/api/enums/v1/nexus.proto\"\x83\x04\n" +
+	"\x1fNexusEndpointCircuitBreakerInfo\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\tR\n" +
+	"endpointId\x12#\n" +
+	"\rendpoint_name\x18\x02 \x01(\tR\fendpointName\x12T\n" +
+	"\x05state\x18\x03 \x01(\x0e2>.temporal.server.api.enums.v1.NexusEndpointCircuitBreakerStateR\x05state\x12\x1a\n" +
+	"\brequests\x18\x04 \x01(\rR\brequests\x12'\n" +
+	"\x0ftotal_successes\x18\x05 \x01(\rR\x0etotalSuccesses\x12%\n" +
+	"\x0etotal_failures\x18\x06 \x01(\rR\rtotalFailures\x123\n" +
+	"\x15consecutive_successes\x18\a \x01(\rR\x14consecutiveSuccesses\x121\n" +
+	"\x14consecutive_failures\x18\b \x01(\rR\x13consecutiveFailures\x12B\n" +
+	"\x0flast_probe_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rlastProbeTime\x12,\n" +
+	"\x12last_probe_failure\x18\n" +
+	" \x01(\tR\x10lastProbeFailureB/Z-go.temporal.io/server/api/common/v1;commonspbb\x06proto3"
+
+var (
+	file_temporal_server_api_common_v1_nexus_proto_rawDescOnce sync.Once
+	file_temporal_server_api_common_v1_nexus_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_common_v1_nexus_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_common_v1_nexus_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_common_v1_nexus_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_common_v1_nexus_proto_rawDesc), len(file_temporal_server_api_common_v1_nexus_proto_rawDesc)))
+	})
+	return file_temporal_server_api_common_v1_nexus_proto_rawDescData
+}
+
+var file_temporal_server_api_common_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_temporal_server_api_common_v1_nexus_proto_goTypes = []any{
+	(*NexusEndpointCircuitBreakerInfo)(nil),  // 0: temporal.server.api.common.v1.NexusEndpointCircuitBreakerInfo
+	(v1.NexusEndpointCircuitBreakerState)(0), // 1: temporal.server.api.enums.v1.NexusEndpointCircuitBreakerState
+	(*timestamppb.Timestamp)(nil),            // 2: google.protobuf.Timestamp
+}
+var file_temporal_server_api_common_v1_nexus_proto_depIdxs = []int32{
+	1, // 0: temporal.server.api.common.v1.NexusEndpointCircuitBreakerInfo.state:type_name -> temporal.server.api.enums.v1.NexusEndpointCircuitBreakerState
+	2, // 1: temporal.server.api.common.v1.NexusEndpointCircuitBreakerInfo.last_probe_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_common_v1_nexus_proto_init() }
+func file_temporal_server_api_common_v1_nexus_proto_init() {
+	if File_temporal_server_api_common_v1_nexus_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_common_v1_nexus_proto_rawDesc), len(file_temporal_server_api_common_v1_nexus_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_common_v1_nexus_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_common_v1_nexus_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_common_v1_nexus_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_common_v1_nexus_proto = out.File
+	file_temporal_server_api_common_v1_nexus_proto_goTypes = nil
+	file_temporal_server_api_common_v1_nexus_proto_depIdxs = nil
+}
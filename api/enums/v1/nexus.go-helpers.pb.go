@@ -52,3 +52,23 @@ func NexusOperationStateFromString(s string) (NexusOperationState, error) {
 	}
 	return NexusOperationState(0), fmt.Errorf("%s is not a valid NexusOperationState", s)
 }
+
+var (
+	NexusEndpointCircuitBreakerState_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Closed":      1,
+		"HalfOpen":    2,
+		"Open":        3,
+	}
+)
+
+// NexusEndpointCircuitBreakerStateFromString parses a NexusEndpointCircuitBreakerState value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to NexusEndpointCircuitBreakerState
+func NexusEndpointCircuitBreakerStateFromString(s string) (NexusEndpointCircuitBreakerState, error) {
+	if v, ok := NexusEndpointCircuitBreakerState_value[s]; ok {
+		return NexusEndpointCircuitBreakerState(v), nil
+	} else if v, ok := NexusEndpointCircuitBreakerState_shorthandValue[s]; ok {
+		return NexusEndpointCircuitBreakerState(v), nil
+	}
+	return NexusEndpointCircuitBreakerState(0), fmt.Errorf("%s is not a valid NexusEndpointCircuitBreakerState", s)
+}
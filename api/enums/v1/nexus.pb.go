@@ -140,6 +140,73 @@ func (NexusOperationState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{0}
 }
 
+type NexusEndpointCircuitBreakerState int32
+
+const (
+	NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_UNSPECIFIED NexusEndpointCircuitBreakerState = 0
+	// Requests to the endpoint are allowed.
+	NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED NexusEndpointCircuitBreakerState = 1
+	// A limited number of requests are allowed through to test whether the endpoint has recovered.
+	NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN NexusEndpointCircuitBreakerState = 2
+	// Requests to the endpoint are rejected without being sent.
+	NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN NexusEndpointCircuitBreakerState = 3
+)
+
+// Enum value maps for NexusEndpointCircuitBreakerState.
+var (
+	NexusEndpointCircuitBreakerState_name = map[int32]string{
+		0: "NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_UNSPECIFIED",
+		1: "NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED",
+		2: "NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN",
+		3: "NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN",
+	}
+	NexusEndpointCircuitBreakerState_value = map[string]int32{
+		"NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_UNSPECIFIED": 0,
+		"NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED":      1,
+		"NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN":   2,
+		"NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN":        3,
+	}
+)
+
+func (x NexusEndpointCircuitBreakerState) Enum() *NexusEndpointCircuitBreakerState {
+	p := new(NexusEndpointCircuitBreakerState)
+	*p = x
+	return p
+}
+
+func (x NexusEndpointCircuitBreakerState) String() string {
+	switch x {
+	case NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_UNSPECIFIED:
+		return "Unspecified"
+	case NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED:
+		return "Closed"
+	case NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		return "HalfOpen"
+	case NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN:
+		return "Open"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (NexusEndpointCircuitBreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_nexus_proto_enumTypes[1].Descriptor()
+}
+
+func (NexusEndpointCircuitBreakerState) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_nexus_proto_enumTypes[1]
+}
+
+func (x NexusEndpointCircuitBreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NexusEndpointCircuitBreakerState.Descriptor instead.
+func (NexusEndpointCircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP(), []int{1}
+}
+
 var File_temporal_server_api_enums_v1_nexus_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_enums_v1_nexus_proto_rawDesc = "" +
@@ -153,7 +220,12 @@ const file_temporal_server_api_enums_v1_nexus_proto_rawDesc = "" +
 	"\x1fNEXUS_OPERATION_STATE_SUCCEEDED\x10\x04\x12 \n" +
 	"\x1cNEXUS_OPERATION_STATE_FAILED\x10\x05\x12\"\n" +
 	"\x1eNEXUS_OPERATION_STATE_CANCELED\x10\x06\x12#\n" +
-	"\x1fNEXUS_OPERATION_STATE_TIMED_OUT\x10\aB*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+	"\x1fNEXUS_OPERATION_STATE_TIMED_OUT\x10\a*\xec\x01\n" +
+	" NexusEndpointCircuitBreakerState\x124\n" +
+	"0NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_UNSPECIFIED\x10\x00\x12/\n" +
+	"+NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED\x10\x01\x122\n" +
+	".NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN\x10\x02\x12-\n" +
+	")NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN\x10\x03B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
 
 var (
 	file_temporal_server_api_enums_v1_nexus_proto_rawDescOnce sync.Once
@@ -167,9 +239,10 @@ func file_temporal_server_api_enums_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_nexus_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_enums_v1_nexus_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_temporal_server_api_enums_v1_nexus_proto_goTypes = []any{
-	(NexusOperationState)(0), // 0: temporal.server.api.enums.v1.NexusOperationState
+	(NexusOperationState)(0),              // 0: temporal.server.api.enums.v1.NexusOperationState
+	(NexusEndpointCircuitBreakerState)(0), // 1: temporal.server.api.enums.v1.NexusEndpointCircuitBreakerState
 }
 var file_temporal_server_api_enums_v1_nexus_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -189,7 +262,7 @@ func file_temporal_server_api_enums_v1_nexus_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_nexus_proto_rawDesc), len(file_temporal_server_api_enums_v1_nexus_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
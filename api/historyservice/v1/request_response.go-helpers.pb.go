@@ -5578,3 +5578,77 @@ func (this *UpdateWorkflowExecutionOptionsResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DescribeNexusEndpointRequest to the protobuf v3 wire format
+func (val *DescribeNexusEndpointRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusEndpointRequest from the protobuf v3 wire format
+func (val *DescribeNexusEndpointRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusEndpointRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusEndpointRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusEndpointRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusEndpointRequest
+	switch t := that.(type) {
+	case *DescribeNexusEndpointRequest:
+		that1 = t
+	case DescribeNexusEndpointRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusEndpointResponse to the protobuf v3 wire format
+func (val *DescribeNexusEndpointResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusEndpointResponse from the protobuf v3 wire format
+func (val *DescribeNexusEndpointResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusEndpointResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusEndpointResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusEndpointResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusEndpointResponse
+	switch t := that.(type) {
+	case *DescribeNexusEndpointResponse:
+		that1 = t
+	case DescribeNexusEndpointResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -113,6 +113,7 @@ const (
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
 	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
 	HistoryService_ResetActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity"
+	HistoryService_DescribeNexusEndpoint_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/DescribeNexusEndpoint"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -391,6 +392,8 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// DescribeNexusEndpoint returns the state of the circuit breaker a history host maintains for a Nexus endpoint.
+	DescribeNexusEndpoint(ctx context.Context, in *DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*DescribeNexusEndpointResponse, error)
 }
 
 type historyServiceClient struct {
@@ -1062,6 +1065,15 @@ func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActiv
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeNexusEndpoint(ctx context.Context, in *DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*DescribeNexusEndpointResponse, error) {
+	out := new(DescribeNexusEndpointResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeNexusEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1338,6 +1350,8 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// DescribeNexusEndpoint returns the state of the circuit breaker a history host maintains for a Nexus endpoint.
+	DescribeNexusEndpoint(context.Context, *DescribeNexusEndpointRequest) (*DescribeNexusEndpointResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1558,6 +1572,9 @@ func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *Unpau
 func (UnimplementedHistoryServiceServer) ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeNexusEndpoint(context.Context, *DescribeNexusEndpointRequest) (*DescribeNexusEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNexusEndpoint not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2857,6 +2874,24 @@ func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeNexusEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNexusEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeNexusEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeNexusEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeNexusEndpoint(ctx, req.(*DescribeNexusEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3144,6 +3179,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetActivity",
 			Handler:    _HistoryService_ResetActivity_Handler,
 		},
+		{
+			MethodName: "DescribeNexusEndpoint",
+			Handler:    _HistoryService_DescribeNexusEndpoint_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -247,6 +247,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNexusEndpoint mocks base method.
+func (m *MockHistoryServiceClient) DescribeNexusEndpoint(ctx context.Context, in *historyservice.DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*historyservice.DescribeNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNexusEndpoint", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNexusEndpoint indicates an expected call of DescribeNexusEndpoint.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeNexusEndpoint(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNexusEndpoint", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeNexusEndpoint), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1784,6 +1804,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeNexusEndpoint mocks base method.
+func (m *MockHistoryServiceServer) DescribeNexusEndpoint(arg0 context.Context, arg1 *historyservice.DescribeNexusEndpointRequest) (*historyservice.DescribeNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNexusEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNexusEndpoint indicates an expected call of DescribeNexusEndpoint.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeNexusEndpoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNexusEndpoint", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeNexusEndpoint), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
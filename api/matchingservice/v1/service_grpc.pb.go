@@ -62,6 +62,10 @@ const (
 	MatchingService_UpdateWorkerVersioningRules_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerVersioningRules"
 	MatchingService_GetWorkerVersioningRules_FullMethodName               = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules"
 	MatchingService_SyncDeploymentUserData_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData"
+	MatchingService_UpdateTaskQueueConfig_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig"
+	MatchingService_ListBacklogTasks_FullMethodName                       = "/temporal.server.api.matchingservice.v1.MatchingService/ListBacklogTasks"
+	MatchingService_DeleteBacklogTasks_FullMethodName                     = "/temporal.server.api.matchingservice.v1.MatchingService/DeleteBacklogTasks"
+	MatchingService_ListTaskQueueWorkers_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueueWorkers"
 	MatchingService_ApplyTaskQueueUserDataReplicationEvent_FullMethodName = "/temporal.server.api.matchingservice.v1.MatchingService/ApplyTaskQueueUserDataReplicationEvent"
 	MatchingService_GetBuildIdTaskQueueMapping_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdTaskQueueMapping"
 	MatchingService_ForceLoadTaskQueuePartition_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition"
@@ -150,6 +154,15 @@ type MatchingServiceClient interface {
 	GetWorkerVersioningRules(ctx context.Context, in *GetWorkerVersioningRulesRequest, opts ...grpc.CallOption) (*GetWorkerVersioningRulesResponse, error)
 	// This request should always be routed to the node holding the root partition of the workflow task queue.
 	SyncDeploymentUserData(ctx context.Context, in *SyncDeploymentUserDataRequest, opts ...grpc.CallOption) (*SyncDeploymentUserDataResponse, error)
+	// Update the per-type dispatch rate limits of a task queue. Stored in task queue user data.
+	UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error)
+	// Page through the backlog tasks of a physical task queue, optionally filtered by workflow ID or type.
+	ListBacklogTasks(ctx context.Context, in *ListBacklogTasksRequest, opts ...grpc.CallOption) (*ListBacklogTasksResponse, error)
+	// Delete a range of backlog tasks from a physical task queue. Tasks in the range are dropped
+	// instead of dispatched; ack levels are not changed.
+	DeleteBacklogTasks(ctx context.Context, in *DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteBacklogTasksResponse, error)
+	// List the workers recently seen polling a task queue partition, with the metadata they reported.
+	ListTaskQueueWorkers(ctx context.Context, in *ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*ListTaskQueueWorkersResponse, error)
 	// Apply a user data replication event.
 	ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, in *ApplyTaskQueueUserDataReplicationEventRequest, opts ...grpc.CallOption) (*ApplyTaskQueueUserDataReplicationEventResponse, error)
 	// Gets all task queue names mapped to a given build ID
@@ -403,6 +416,42 @@ func (c *matchingServiceClient) SyncDeploymentUserData(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error) {
+	out := new(UpdateTaskQueueConfigResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ListBacklogTasks(ctx context.Context, in *ListBacklogTasksRequest, opts ...grpc.CallOption) (*ListBacklogTasksResponse, error) {
+	out := new(ListBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) DeleteBacklogTasks(ctx context.Context, in *DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteBacklogTasksResponse, error) {
+	out := new(DeleteBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DeleteBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ListTaskQueueWorkers(ctx context.Context, in *ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*ListTaskQueueWorkersResponse, error) {
+	out := new(ListTaskQueueWorkersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListTaskQueueWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, in *ApplyTaskQueueUserDataReplicationEventRequest, opts ...grpc.CallOption) (*ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	out := new(ApplyTaskQueueUserDataReplicationEventResponse)
 	err := c.cc.Invoke(ctx, MatchingService_ApplyTaskQueueUserDataReplicationEvent_FullMethodName, in, out, opts...)
@@ -585,6 +634,15 @@ type MatchingServiceServer interface {
 	GetWorkerVersioningRules(context.Context, *GetWorkerVersioningRulesRequest) (*GetWorkerVersioningRulesResponse, error)
 	// This request should always be routed to the node holding the root partition of the workflow task queue.
 	SyncDeploymentUserData(context.Context, *SyncDeploymentUserDataRequest) (*SyncDeploymentUserDataResponse, error)
+	// Update the per-type dispatch rate limits of a task queue. Stored in task queue user data.
+	UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error)
+	// Page through the backlog tasks of a physical task queue, optionally filtered by workflow ID or type.
+	ListBacklogTasks(context.Context, *ListBacklogTasksRequest) (*ListBacklogTasksResponse, error)
+	// Delete a range of backlog tasks from a physical task queue. Tasks in the range are dropped
+	// instead of dispatched; ack levels are not changed.
+	DeleteBacklogTasks(context.Context, *DeleteBacklogTasksRequest) (*DeleteBacklogTasksResponse, error)
+	// List the workers recently seen polling a task queue partition, with the metadata they reported.
+	ListTaskQueueWorkers(context.Context, *ListTaskQueueWorkersRequest) (*ListTaskQueueWorkersResponse, error)
 	// Apply a user data replication event.
 	ApplyTaskQueueUserDataReplicationEvent(context.Context, *ApplyTaskQueueUserDataReplicationEventRequest) (*ApplyTaskQueueUserDataReplicationEventResponse, error)
 	// Gets all task queue names mapped to a given build ID
@@ -715,6 +773,18 @@ func (UnimplementedMatchingServiceServer) GetWorkerVersioningRules(context.Conte
 func (UnimplementedMatchingServiceServer) SyncDeploymentUserData(context.Context, *SyncDeploymentUserDataRequest) (*SyncDeploymentUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncDeploymentUserData not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueConfig not implemented")
+}
+func (UnimplementedMatchingServiceServer) ListBacklogTasks(context.Context, *ListBacklogTasksRequest) (*ListBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBacklogTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) DeleteBacklogTasks(context.Context, *DeleteBacklogTasksRequest) (*DeleteBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBacklogTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) ListTaskQueueWorkers(context.Context, *ListTaskQueueWorkersRequest) (*ListTaskQueueWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueWorkers not implemented")
+}
 func (UnimplementedMatchingServiceServer) ApplyTaskQueueUserDataReplicationEvent(context.Context, *ApplyTaskQueueUserDataReplicationEventRequest) (*ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyTaskQueueUserDataReplicationEvent not implemented")
 }
@@ -1124,6 +1194,78 @@ func _MatchingService_SyncDeploymentUserData_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateTaskQueueConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueueConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateTaskQueueConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueueConfig(ctx, req.(*UpdateTaskQueueConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ListBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListBacklogTasks(ctx, req.(*ListBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_DeleteBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DeleteBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DeleteBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DeleteBacklogTasks(ctx, req.(*DeleteBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ListTaskQueueWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListTaskQueueWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListTaskQueueWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListTaskQueueWorkers(ctx, req.(*ListTaskQueueWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_ApplyTaskQueueUserDataReplicationEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyTaskQueueUserDataReplicationEventRequest)
 	if err := dec(in); err != nil {
@@ -1427,6 +1569,22 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncDeploymentUserData",
 			Handler:    _MatchingService_SyncDeploymentUserData_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueueConfig",
+			Handler:    _MatchingService_UpdateTaskQueueConfig_Handler,
+		},
+		{
+			MethodName: "ListBacklogTasks",
+			Handler:    _MatchingService_ListBacklogTasks_Handler,
+		},
+		{
+			MethodName: "DeleteBacklogTasks",
+			Handler:    _MatchingService_DeleteBacklogTasks_Handler,
+		},
+		{
+			MethodName: "ListTaskQueueWorkers",
+			Handler:    _MatchingService_ListTaskQueueWorkers_Handler,
+		},
 		{
 			MethodName: "ApplyTaskQueueUserDataReplicationEvent",
 			Handler:    _MatchingService_ApplyTaskQueueUserDataReplicationEvent_Handler,
@@ -186,6 +186,26 @@ func (mr *MockMatchingServiceClientMockRecorder) CreateNexusEndpoint(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNexusEndpoint", reflect.TypeOf((*MockMatchingServiceClient)(nil).CreateNexusEndpoint), varargs...)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) DeleteBacklogTasks(ctx context.Context, in *matchingservice.DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DeleteBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) DeleteBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).DeleteBacklogTasks), varargs...)
+}
+
 // DeleteNexusEndpoint mocks base method.
 func (m *MockMatchingServiceClient) DeleteNexusEndpoint(ctx context.Context, in *matchingservice.DeleteNexusEndpointRequest, opts ...grpc.CallOption) (*matchingservice.DeleteNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +426,26 @@ func (mr *MockMatchingServiceClientMockRecorder) GetWorkerVersioningRules(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetWorkerVersioningRules), varargs...)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) ListBacklogTasks(ctx context.Context, in *matchingservice.ListBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.ListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) ListBacklogTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListBacklogTasks), varargs...)
+}
+
 // ListNexusEndpoints mocks base method.
 func (m *MockMatchingServiceClient) ListNexusEndpoints(ctx context.Context, in *matchingservice.ListNexusEndpointsRequest, opts ...grpc.CallOption) (*matchingservice.ListNexusEndpointsResponse, error) {
 	m.ctrl.T.Helper()
@@ -446,6 +486,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePartitions(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePartitions), varargs...)
 }
 
+// ListTaskQueueWorkers mocks base method.
+func (m *MockMatchingServiceClient) ListTaskQueueWorkers(ctx context.Context, in *matchingservice.ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*matchingservice.ListTaskQueueWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueWorkers", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueueWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueWorkers indicates an expected call of ListTaskQueueWorkers.
+func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueueWorkers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueWorkers", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueueWorkers), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -646,6 +706,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateNexusEndpoint(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpoint", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateNexusEndpoint), varargs...)
 }
 
+// UpdateTaskQueueConfig mocks base method.
+func (m *MockMatchingServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *matchingservice.UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueConfig", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueueConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueConfig indicates an expected call of UpdateTaskQueueConfig.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueConfig), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -820,6 +900,21 @@ func (mr *MockMatchingServiceServerMockRecorder) CreateNexusEndpoint(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNexusEndpoint", reflect.TypeOf((*MockMatchingServiceServer)(nil).CreateNexusEndpoint), arg0, arg1)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) DeleteBacklogTasks(arg0 context.Context, arg1 *matchingservice.DeleteBacklogTasksRequest) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DeleteBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) DeleteBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).DeleteBacklogTasks), arg0, arg1)
+}
+
 // DeleteNexusEndpoint mocks base method.
 func (m *MockMatchingServiceServer) DeleteNexusEndpoint(arg0 context.Context, arg1 *matchingservice.DeleteNexusEndpointRequest) (*matchingservice.DeleteNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -985,6 +1080,21 @@ func (mr *MockMatchingServiceServerMockRecorder) GetWorkerVersioningRules(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetWorkerVersioningRules), arg0, arg1)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) ListBacklogTasks(arg0 context.Context, arg1 *matchingservice.ListBacklogTasksRequest) (*matchingservice.ListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) ListBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListBacklogTasks), arg0, arg1)
+}
+
 // ListNexusEndpoints mocks base method.
 func (m *MockMatchingServiceServer) ListNexusEndpoints(arg0 context.Context, arg1 *matchingservice.ListNexusEndpointsRequest) (*matchingservice.ListNexusEndpointsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1015,6 +1125,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePartitions(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// ListTaskQueueWorkers mocks base method.
+func (m *MockMatchingServiceServer) ListTaskQueueWorkers(arg0 context.Context, arg1 *matchingservice.ListTaskQueueWorkersRequest) (*matchingservice.ListTaskQueueWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueueWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueWorkers indicates an expected call of ListTaskQueueWorkers.
+func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueueWorkers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueWorkers", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueueWorkers), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1165,6 +1290,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateNexusEndpoint(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpoint", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateNexusEndpoint), arg0, arg1)
 }
 
+// UpdateTaskQueueConfig mocks base method.
+func (m *MockMatchingServiceServer) UpdateTaskQueueConfig(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueConfigRequest) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueConfig", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueueConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueConfig indicates an expected call of UpdateTaskQueueConfig.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueConfig), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+
 // Copyright (c) 2019 Temporal Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
@@ -406,15 +407,19 @@ func (x *NexusEndpointTarget_Worker) GetTaskQueue() string {
 }
 
 // Target an external server by URL.
-// At a later point, this will support providing credentials, in the meantime, an http.RoundTripper can be injected
-// into the server to modify the request.
+// Outbound TLS and auth header settings are not part of the public API, they are set with the admin
+// UpdateNexusEndpointOutboundSettings API and carried over when the endpoint is updated via the operator API.
 type NexusEndpointTarget_External struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// URL to call.
 	// (-- api-linter: core::0140::uri=disabled
 	//
 	//	aip.dev/not-precedent: Not following linter rules. --)
-	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Outbound TLS configuration used when calling the URL. Only applicable for https URLs.
+	Tls *NexusEndpointTarget_TLS `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Optional header to inject into every outbound request with a value resolved from a secret provider.
+	AuthHeader    *NexusEndpointTarget_AuthHeader `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -456,6 +461,155 @@ func (x *NexusEndpointTarget_External) GetUrl() string {
 	return ""
 }
 
+func (x *NexusEndpointTarget_External) GetTls() *NexusEndpointTarget_TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *NexusEndpointTarget_External) GetAuthHeader() *NexusEndpointTarget_AuthHeader {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return nil
+}
+
+type NexusEndpointTarget_TLS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path to a PEM encoded client certificate presented to the target, must be set together with client_key_file.
+	ClientCertFile string `protobuf:"bytes,1,opt,name=client_cert_file,json=clientCertFile,proto3" json:"client_cert_file,omitempty"`
+	// Path to the PEM encoded private key of the client certificate.
+	ClientKeyFile string `protobuf:"bytes,2,opt,name=client_key_file,json=clientKeyFile,proto3" json:"client_key_file,omitempty"`
+	// Path to a PEM encoded CA bundle used to verify the target's certificate. The system roots are used if empty.
+	CaFile string `protobuf:"bytes,3,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	// Overrides the server name used for SNI and certificate verification.
+	ServerName    string `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NexusEndpointTarget_TLS) Reset() {
+	*x = NexusEndpointTarget_TLS{}
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointTarget_TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointTarget_TLS) ProtoMessage() {}
+
+func (x *NexusEndpointTarget_TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointTarget_TLS.ProtoReflect.Descriptor instead.
+func (*NexusEndpointTarget_TLS) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *NexusEndpointTarget_TLS) GetClientCertFile() string {
+	if x != nil {
+		return x.ClientCertFile
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_TLS) GetClientKeyFile() string {
+	if x != nil {
+		return x.ClientKeyFile
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_TLS) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_TLS) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type NexusEndpointTarget_AuthHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Header name, e.g. "Authorization".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the secret resolved by the server's secret provider at request time.
+	SecretName string `protobuf:"bytes,2,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	// Optional prefix prepended to the secret value, e.g. "Bearer ".
+	ValuePrefix   string `protobuf:"bytes,3,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NexusEndpointTarget_AuthHeader) Reset() {
+	*x = NexusEndpointTarget_AuthHeader{}
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointTarget_AuthHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointTarget_AuthHeader) ProtoMessage() {}
+
+func (x *NexusEndpointTarget_AuthHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointTarget_AuthHeader.ProtoReflect.Descriptor instead.
+func (*NexusEndpointTarget_AuthHeader) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *NexusEndpointTarget_AuthHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_AuthHeader) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_AuthHeader) GetValuePrefix() string {
+	if x != nil {
+		return x.ValuePrefix
+	}
+	return ""
+}
+
 var File_temporal_server_api_persistence_v1_nexus_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = "" +
@@ -464,16 +618,31 @@ const file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = "" +
 	"\x11NexusEndpointSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
 	"\vdescription\x18\x02 \x01(\v2\x1f.temporal.api.common.v1.PayloadR\vdescription\x12O\n" +
-	"\x06target\x18\x03 \x01(\v27.temporal.server.api.persistence.v1.NexusEndpointTargetR\x06target\"\xc4\x02\n" +
+	"\x06target\x18\x03 \x01(\v27.temporal.server.api.persistence.v1.NexusEndpointTargetR\x06target\"\xf3\x05\n" +
 	"\x13NexusEndpointTarget\x12X\n" +
 	"\x06worker\x18\x01 \x01(\v2>.temporal.server.api.persistence.v1.NexusEndpointTarget.WorkerH\x00R\x06worker\x12^\n" +
 	"\bexternal\x18\x02 \x01(\v2@.temporal.server.api.persistence.v1.NexusEndpointTarget.ExternalH\x00R\bexternal\x1aJ\n" +
 	"\x06Worker\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
 	"\n" +
-	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x1a\x1c\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x1a\xd0\x01\n" +
 	"\bExternal\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03urlB\t\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12M\n" +
+	"\x03tls\x18\x02 \x01(\v2;.temporal.server.api.persistence.v1.NexusEndpointTarget.TLSR\x03tls\x12c\n" +
+	"\vauth_header\x18\x03 \x01(\v2B.temporal.server.api.persistence.v1.NexusEndpointTarget.AuthHeaderR\n" +
+	"authHeader\x1a\x91\x01\n" +
+	"\x03TLS\x12(\n" +
+	"\x10client_cert_file\x18\x01 \x01(\tR\x0eclientCertFile\x12&\n" +
+	"\x0fclient_key_file\x18\x02 \x01(\tR\rclientKeyFile\x12\x17\n" +
+	"\aca_file\x18\x03 \x01(\tR\x06caFile\x12\x1f\n" +
+	"\vserver_name\x18\x04 \x01(\tR\n" +
+	"serverName\x1ad\n" +
+	"\n" +
+	"AuthHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vsecret_name\x18\x02 \x01(\tR\n" +
+	"secretName\x12!\n" +
+	"\fvalue_prefix\x18\x03 \x01(\tR\vvaluePrefixB\t\n" +
 	"\avariant\"\xe1\x01\n" +
 	"\rNexusEndpoint\x12F\n" +
 	"\x05clock\x18\x01 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\x05clock\x12I\n" +
@@ -496,32 +665,36 @@ func file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_temporal_server_api_persistence_v1_nexus_proto_goTypes = []any{
-	(*NexusEndpointSpec)(nil),            // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
-	(*NexusEndpointTarget)(nil),          // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
-	(*NexusEndpoint)(nil),                // 2: temporal.server.api.persistence.v1.NexusEndpoint
-	(*NexusEndpointEntry)(nil),           // 3: temporal.server.api.persistence.v1.NexusEndpointEntry
-	(*NexusEndpointTarget_Worker)(nil),   // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	(*NexusEndpointTarget_External)(nil), // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	(*v1.Payload)(nil),                   // 6: temporal.api.common.v1.Payload
-	(*v11.HybridLogicalClock)(nil),       // 7: temporal.server.api.clock.v1.HybridLogicalClock
-	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
+	(*NexusEndpointSpec)(nil),              // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
+	(*NexusEndpointTarget)(nil),            // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
+	(*NexusEndpoint)(nil),                  // 2: temporal.server.api.persistence.v1.NexusEndpoint
+	(*NexusEndpointEntry)(nil),             // 3: temporal.server.api.persistence.v1.NexusEndpointEntry
+	(*NexusEndpointTarget_Worker)(nil),     // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	(*NexusEndpointTarget_External)(nil),   // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	(*NexusEndpointTarget_TLS)(nil),        // 6: temporal.server.api.persistence.v1.NexusEndpointTarget.TLS
+	(*NexusEndpointTarget_AuthHeader)(nil), // 7: temporal.server.api.persistence.v1.NexusEndpointTarget.AuthHeader
+	(*v1.Payload)(nil),                     // 8: temporal.api.common.v1.Payload
+	(*v11.HybridLogicalClock)(nil),         // 9: temporal.server.api.clock.v1.HybridLogicalClock
+	(*timestamppb.Timestamp)(nil),          // 10: google.protobuf.Timestamp
 }
 var file_temporal_server_api_persistence_v1_nexus_proto_depIdxs = []int32{
-	6, // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
-	1, // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
-	4, // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	5, // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	7, // 4: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	0, // 5: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
-	8, // 6: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
-	2, // 7: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	8,  // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
+	1,  // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
+	4,  // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	5,  // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	9,  // 4: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	0,  // 5: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
+	10, // 6: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
+	2,  // 7: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
+	6,  // 8: temporal.server.api.persistence.v1.NexusEndpointTarget.External.tls:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.TLS
+	7,  // 9: temporal.server.api.persistence.v1.NexusEndpointTarget.External.auth_header:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.AuthHeader
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_nexus_proto_init() }
@@ -539,7 +712,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_nexus_proto_rawDesc), len(file_temporal_server_api_persistence_v1_nexus_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -288,6 +288,80 @@ func (this *TaskQueueTypeUserData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueuePartitionScaling to the protobuf v3 wire format
+func (val *TaskQueuePartitionScaling) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePartitionScaling from the protobuf v3 wire format
+func (val *TaskQueuePartitionScaling) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePartitionScaling) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePartitionScaling values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePartitionScaling) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePartitionScaling
+	switch t := that.(type) {
+	case *TaskQueuePartitionScaling:
+		that1 = t
+	case TaskQueuePartitionScaling:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskTypeRateLimit to the protobuf v3 wire format
+func (val *TaskTypeRateLimit) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskTypeRateLimit from the protobuf v3 wire format
+func (val *TaskTypeRateLimit) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskTypeRateLimit) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskTypeRateLimit values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskTypeRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskTypeRateLimit
+	switch t := that.(type) {
+	case *TaskTypeRateLimit:
+		that1 = t
+	case TaskTypeRateLimit:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueUserData to the protobuf v3 wire format
 func (val *TaskQueueUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -115,8 +115,19 @@ type TaskInfo struct {
 	// TaskVersionDirective, which is unversioned.)
 	VersionDirective *v11.TaskVersionDirective `protobuf:"bytes,8,opt,name=version_directive,json=versionDirective,proto3" json:"version_directive,omitempty"`
 	// Stamp field allows to differentiate between different instances of the same task
-	Stamp         int32         `protobuf:"varint,9,opt,name=stamp,proto3" json:"stamp,omitempty"`
-	Priority      *v12.Priority `protobuf:"bytes,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Stamp    int32         `protobuf:"varint,9,opt,name=stamp,proto3" json:"stamp,omitempty"`
+	Priority *v12.Priority `protobuf:"bytes,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Fairness key used to interleave dispatch across tenants sharing a task queue.
+	FairnessKey string `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Workflow type name for workflow tasks, activity type name for activity tasks. Used for
+	// per-type dispatch rate limits.
+	TypeName string `protobuf:"bytes,12,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// If set, the task is not dispatched before this time. It's written to the backlog
+	// directly and the backlog reader holds it back until then.
+	NotBeforeTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=not_before_time,json=notBeforeTime,proto3" json:"not_before_time,omitempty"`
+	// Context of the trace the task was generated in, in the format of the W3C trace context propagator.
+	// The trace is continued when the task is processed.
+	TraceContext  map[string]string `protobuf:"bytes,14,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,6 +232,34 @@ func (x *TaskInfo) GetPriority() *v12.Priority {
 	return nil
 }
 
+func (x *TaskInfo) GetFairnessKey() string {
+	if x != nil {
+		return x.FairnessKey
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetNotBeforeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBeforeTime
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -244,9 +283,12 @@ type TaskQueueInfo struct {
 	// Each subqueue has its own ack level and approx backlog count, but they share
 	// the range id. For compatibility, ack level and backlog count for subqueue 0
 	// is copied into TaskQueueInfo.
-	Subqueues     []*SubqueueInfo `protobuf:"bytes,9,rep,name=subqueues,proto3" json:"subqueues,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Subqueues []*SubqueueInfo `protobuf:"bytes,9,rep,name=subqueues,proto3" json:"subqueues,omitempty"`
+	// Task ID ranges deleted by an operator. Tasks in these ranges are dropped when read
+	// instead of being dispatched. A range is removed once all ack levels have passed it.
+	DeletedTaskRanges []*TaskIdRange `protobuf:"bytes,10,rep,name=deleted_task_ranges,json=deletedTaskRanges,proto3" json:"deleted_task_ranges,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TaskQueueInfo) Reset() {
@@ -342,6 +384,65 @@ func (x *TaskQueueInfo) GetSubqueues() []*SubqueueInfo {
 	return nil
 }
 
+func (x *TaskQueueInfo) GetDeletedTaskRanges() []*TaskIdRange {
+	if x != nil {
+		return x.DeletedTaskRanges
+	}
+	return nil
+}
+
+type TaskIdRange struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	InclusiveMinTaskId int64                  `protobuf:"varint,1,opt,name=inclusive_min_task_id,json=inclusiveMinTaskId,proto3" json:"inclusive_min_task_id,omitempty"`
+	ExclusiveMaxTaskId int64                  `protobuf:"varint,2,opt,name=exclusive_max_task_id,json=exclusiveMaxTaskId,proto3" json:"exclusive_max_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TaskIdRange) Reset() {
+	*x = TaskIdRange{}
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskIdRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskIdRange) ProtoMessage() {}
+
+func (x *TaskIdRange) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskIdRange.ProtoReflect.Descriptor instead.
+func (*TaskIdRange) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskIdRange) GetInclusiveMinTaskId() int64 {
+	if x != nil {
+		return x.InclusiveMinTaskId
+	}
+	return 0
+}
+
+func (x *TaskIdRange) GetExclusiveMaxTaskId() int64 {
+	if x != nil {
+		return x.ExclusiveMaxTaskId
+	}
+	return 0
+}
+
 type SubqueueInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Key is the information used by a splitting algorithm to decide which tasks should go in
@@ -350,13 +451,17 @@ type SubqueueInfo struct {
 	// The rest are mutable state for the subqueue:
 	AckLevel                int64 `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
 	ApproximateBacklogCount int64 `protobuf:"varint,3,opt,name=approximate_backlog_count,json=approximateBacklogCount,proto3" json:"approximate_backlog_count,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Approximate backlog count per fairness key, for tasks with a fairness key. Keys are removed
+	// once they have no backlog, and keys beyond matching.fairnessMaxKeys are counted under the
+	// empty key.
+	FairnessKeyBacklogCounts map[string]int64 `protobuf:"bytes,4,rep,name=fairness_key_backlog_counts,json=fairnessKeyBacklogCounts,proto3" json:"fairness_key_backlog_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *SubqueueInfo) Reset() {
 	*x = SubqueueInfo{}
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -368,7 +473,7 @@ func (x *SubqueueInfo) String() string {
 func (*SubqueueInfo) ProtoMessage() {}
 
 func (x *SubqueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +486,7 @@ func (x *SubqueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubqueueInfo.ProtoReflect.Descriptor instead.
 func (*SubqueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SubqueueInfo) GetKey() *SubqueueKey {
@@ -405,6 +510,13 @@ func (x *SubqueueInfo) GetApproximateBacklogCount() int64 {
 	return 0
 }
 
+func (x *SubqueueInfo) GetFairnessKeyBacklogCounts() map[string]int64 {
+	if x != nil {
+		return x.FairnessKeyBacklogCounts
+	}
+	return nil
+}
+
 type SubqueueKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Each subqueue contains tasks from only one priority level.
@@ -415,7 +527,7 @@ type SubqueueKey struct {
 
 func (x *SubqueueKey) Reset() {
 	*x = SubqueueKey{}
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +539,7 @@ func (x *SubqueueKey) String() string {
 func (*SubqueueKey) ProtoMessage() {}
 
 func (x *SubqueueKey) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +552,7 @@ func (x *SubqueueKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubqueueKey.ProtoReflect.Descriptor instead.
 func (*SubqueueKey) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SubqueueKey) GetPriority() int32 {
@@ -460,7 +572,7 @@ type TaskKey struct {
 
 func (x *TaskKey) Reset() {
 	*x = TaskKey{}
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +584,7 @@ func (x *TaskKey) String() string {
 func (*TaskKey) ProtoMessage() {}
 
 func (x *TaskKey) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +597,7 @@ func (x *TaskKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskKey.ProtoReflect.Descriptor instead.
 func (*TaskKey) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{5}
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TaskKey) GetFireTime() *timestamppb.Timestamp {
@@ -509,7 +621,7 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	".temporal/server/api/persistence/v1/tasks.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"n\n" +
 	"\x11AllocatedTaskInfo\x12@\n" +
 	"\x04data\x18\x01 \x01(\v2,.temporal.server.api.persistence.v1.TaskInfoR\x04data\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\x87\x04\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\xb1\x06\n" +
 	"\bTaskInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -524,7 +636,14 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"\x11version_directive\x18\b \x01(\v26.temporal.server.api.taskqueue.v1.TaskVersionDirectiveR\x10versionDirective\x12\x14\n" +
 	"\x05stamp\x18\t \x01(\x05R\x05stamp\x12<\n" +
 	"\bpriority\x18\n" +
-	" \x01(\v2 .temporal.api.common.v1.PriorityR\bpriority\"\xef\x03\n" +
+	" \x01(\v2 .temporal.api.common.v1.PriorityR\bpriority\x12!\n" +
+	"\ffairness_key\x18\v \x01(\tR\vfairnessKey\x12\x1b\n" +
+	"\ttype_name\x18\f \x01(\tR\btypeName\x12B\n" +
+	"\x0fnot_before_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rnotBeforeTime\x12c\n" +
+	"\rtrace_context\x18\x0e \x03(\v2>.temporal.server.api.persistence.v1.TaskInfo.TraceContextEntryR\ftraceContext\x1a?\n" +
+	"\x11TraceContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd0\x04\n" +
 	"\rTaskQueueInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
@@ -535,11 +654,20 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"expiryTime\x12D\n" +
 	"\x10last_update_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastUpdateTime\x12:\n" +
 	"\x19approximate_backlog_count\x18\b \x01(\x03R\x17approximateBacklogCount\x12N\n" +
-	"\tsubqueues\x18\t \x03(\v20.temporal.server.api.persistence.v1.SubqueueInfoR\tsubqueues\"\xaa\x01\n" +
+	"\tsubqueues\x18\t \x03(\v20.temporal.server.api.persistence.v1.SubqueueInfoR\tsubqueues\x12_\n" +
+	"\x13deleted_task_ranges\x18\n" +
+	" \x03(\v2/.temporal.server.api.persistence.v1.TaskIdRangeR\x11deletedTaskRanges\"s\n" +
+	"\vTaskIdRange\x121\n" +
+	"\x15inclusive_min_task_id\x18\x01 \x01(\x03R\x12inclusiveMinTaskId\x121\n" +
+	"\x15exclusive_max_task_id\x18\x02 \x01(\x03R\x12exclusiveMaxTaskId\"\x87\x03\n" +
 	"\fSubqueueInfo\x12A\n" +
 	"\x03key\x18\x01 \x01(\v2/.temporal.server.api.persistence.v1.SubqueueKeyR\x03key\x12\x1b\n" +
 	"\tack_level\x18\x02 \x01(\x03R\backLevel\x12:\n" +
-	"\x19approximate_backlog_count\x18\x03 \x01(\x03R\x17approximateBacklogCount\")\n" +
+	"\x19approximate_backlog_count\x18\x03 \x01(\x03R\x17approximateBacklogCount\x12\x8d\x01\n" +
+	"\x1bfairness_key_backlog_counts\x18\x04 \x03(\v2N.temporal.server.api.persistence.v1.SubqueueInfo.FairnessKeyBacklogCountsEntryR\x18fairnessKeyBacklogCounts\x1aK\n" +
+	"\x1dFairnessKeyBacklogCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\")\n" +
 	"\vSubqueueKey\x12\x1a\n" +
 	"\bpriority\x18\x01 \x01(\x05R\bpriority\"[\n" +
 	"\aTaskKey\x127\n" +
@@ -558,40 +686,47 @@ func file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_temporal_server_api_persistence_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_temporal_server_api_persistence_v1_tasks_proto_goTypes = []any{
 	(*AllocatedTaskInfo)(nil),        // 0: temporal.server.api.persistence.v1.AllocatedTaskInfo
 	(*TaskInfo)(nil),                 // 1: temporal.server.api.persistence.v1.TaskInfo
 	(*TaskQueueInfo)(nil),            // 2: temporal.server.api.persistence.v1.TaskQueueInfo
-	(*SubqueueInfo)(nil),             // 3: temporal.server.api.persistence.v1.SubqueueInfo
-	(*SubqueueKey)(nil),              // 4: temporal.server.api.persistence.v1.SubqueueKey
-	(*TaskKey)(nil),                  // 5: temporal.server.api.persistence.v1.TaskKey
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
-	(*v1.VectorClock)(nil),           // 7: temporal.server.api.clock.v1.VectorClock
-	(*v11.TaskVersionDirective)(nil), // 8: temporal.server.api.taskqueue.v1.TaskVersionDirective
-	(*v12.Priority)(nil),             // 9: temporal.api.common.v1.Priority
-	(v13.TaskQueueType)(0),           // 10: temporal.api.enums.v1.TaskQueueType
-	(v13.TaskQueueKind)(0),           // 11: temporal.api.enums.v1.TaskQueueKind
+	(*TaskIdRange)(nil),              // 3: temporal.server.api.persistence.v1.TaskIdRange
+	(*SubqueueInfo)(nil),             // 4: temporal.server.api.persistence.v1.SubqueueInfo
+	(*SubqueueKey)(nil),              // 5: temporal.server.api.persistence.v1.SubqueueKey
+	(*TaskKey)(nil),                  // 6: temporal.server.api.persistence.v1.TaskKey
+	nil,                              // 7: temporal.server.api.persistence.v1.TaskInfo.TraceContextEntry
+	nil,                              // 8: temporal.server.api.persistence.v1.SubqueueInfo.FairnessKeyBacklogCountsEntry
+	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
+	(*v1.VectorClock)(nil),           // 10: temporal.server.api.clock.v1.VectorClock
+	(*v11.TaskVersionDirective)(nil), // 11: temporal.server.api.taskqueue.v1.TaskVersionDirective
+	(*v12.Priority)(nil),             // 12: temporal.api.common.v1.Priority
+	(v13.TaskQueueType)(0),           // 13: temporal.api.enums.v1.TaskQueueType
+	(v13.TaskQueueKind)(0),           // 14: temporal.api.enums.v1.TaskQueueKind
 }
 var file_temporal_server_api_persistence_v1_tasks_proto_depIdxs = []int32{
 	1,  // 0: temporal.server.api.persistence.v1.AllocatedTaskInfo.data:type_name -> temporal.server.api.persistence.v1.TaskInfo
-	6,  // 1: temporal.server.api.persistence.v1.TaskInfo.create_time:type_name -> google.protobuf.Timestamp
-	6,  // 2: temporal.server.api.persistence.v1.TaskInfo.expiry_time:type_name -> google.protobuf.Timestamp
-	7,  // 3: temporal.server.api.persistence.v1.TaskInfo.clock:type_name -> temporal.server.api.clock.v1.VectorClock
-	8,  // 4: temporal.server.api.persistence.v1.TaskInfo.version_directive:type_name -> temporal.server.api.taskqueue.v1.TaskVersionDirective
-	9,  // 5: temporal.server.api.persistence.v1.TaskInfo.priority:type_name -> temporal.api.common.v1.Priority
-	10, // 6: temporal.server.api.persistence.v1.TaskQueueInfo.task_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	11, // 7: temporal.server.api.persistence.v1.TaskQueueInfo.kind:type_name -> temporal.api.enums.v1.TaskQueueKind
-	6,  // 8: temporal.server.api.persistence.v1.TaskQueueInfo.expiry_time:type_name -> google.protobuf.Timestamp
-	6,  // 9: temporal.server.api.persistence.v1.TaskQueueInfo.last_update_time:type_name -> google.protobuf.Timestamp
-	3,  // 10: temporal.server.api.persistence.v1.TaskQueueInfo.subqueues:type_name -> temporal.server.api.persistence.v1.SubqueueInfo
-	4,  // 11: temporal.server.api.persistence.v1.SubqueueInfo.key:type_name -> temporal.server.api.persistence.v1.SubqueueKey
-	6,  // 12: temporal.server.api.persistence.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	9,  // 1: temporal.server.api.persistence.v1.TaskInfo.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 2: temporal.server.api.persistence.v1.TaskInfo.expiry_time:type_name -> google.protobuf.Timestamp
+	10, // 3: temporal.server.api.persistence.v1.TaskInfo.clock:type_name -> temporal.server.api.clock.v1.VectorClock
+	11, // 4: temporal.server.api.persistence.v1.TaskInfo.version_directive:type_name -> temporal.server.api.taskqueue.v1.TaskVersionDirective
+	12, // 5: temporal.server.api.persistence.v1.TaskInfo.priority:type_name -> temporal.api.common.v1.Priority
+	9,  // 6: temporal.server.api.persistence.v1.TaskInfo.not_before_time:type_name -> google.protobuf.Timestamp
+	7,  // 7: temporal.server.api.persistence.v1.TaskInfo.trace_context:type_name -> temporal.server.api.persistence.v1.TaskInfo.TraceContextEntry
+	13, // 8: temporal.server.api.persistence.v1.TaskQueueInfo.task_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	14, // 9: temporal.server.api.persistence.v1.TaskQueueInfo.kind:type_name -> temporal.api.enums.v1.TaskQueueKind
+	9,  // 10: temporal.server.api.persistence.v1.TaskQueueInfo.expiry_time:type_name -> google.protobuf.Timestamp
+	9,  // 11: temporal.server.api.persistence.v1.TaskQueueInfo.last_update_time:type_name -> google.protobuf.Timestamp
+	4,  // 12: temporal.server.api.persistence.v1.TaskQueueInfo.subqueues:type_name -> temporal.server.api.persistence.v1.SubqueueInfo
+	3,  // 13: temporal.server.api.persistence.v1.TaskQueueInfo.deleted_task_ranges:type_name -> temporal.server.api.persistence.v1.TaskIdRange
+	5,  // 14: temporal.server.api.persistence.v1.SubqueueInfo.key:type_name -> temporal.server.api.persistence.v1.SubqueueKey
+	8,  // 15: temporal.server.api.persistence.v1.SubqueueInfo.fairness_key_backlog_counts:type_name -> temporal.server.api.persistence.v1.SubqueueInfo.FairnessKeyBacklogCountsEntry
+	9,  // 16: temporal.server.api.persistence.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_tasks_proto_init() }
@@ -605,7 +740,7 @@ func file_temporal_server_api_persistence_v1_tasks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_tasks_proto_rawDesc), len(file_temporal_server_api_persistence_v1_tasks_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,364 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+
+// Copyright (c) 2024 Temporal Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/usage.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UsageRecord is the resource usage aggregated by a history host over a metering window for a single
+// (namespace, workflow type, cost center) combination.
+type UsageRecord struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId  string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowType string                 `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	// Value of the namespace's cost center search attribute, empty if it isn't configured or not set on the workflow.
+	// Workflows with cost centers beyond the limit of distinct values per namespace and flush are attributed to
+	// "__other__".
+	CostCenter      string                 `protobuf:"bytes,3,opt,name=cost_center,json=costCenter,proto3" json:"cost_center,omitempty"`
+	WindowStartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_start_time,json=windowStartTime,proto3" json:"window_start_time,omitempty"`
+	WindowEndTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=window_end_time,json=windowEndTime,proto3" json:"window_end_time,omitempty"`
+	// Total number of billable actions, i.e. the sum of the action counters below.
+	Actions          int64 `protobuf:"varint,6,opt,name=actions,proto3" json:"actions,omitempty"`
+	WorkflowStarts   int64 `protobuf:"varint,7,opt,name=workflow_starts,json=workflowStarts,proto3" json:"workflow_starts,omitempty"`
+	Signals          int64 `protobuf:"varint,8,opt,name=signals,proto3" json:"signals,omitempty"`
+	ActivityAttempts int64 `protobuf:"varint,9,opt,name=activity_attempts,json=activityAttempts,proto3" json:"activity_attempts,omitempty"`
+	Timers           int64 `protobuf:"varint,10,opt,name=timers,proto3" json:"timers,omitempty"`
+	// Number of history events written.
+	HistoryEvents int64 `protobuf:"varint,11,opt,name=history_events,json=historyEvents,proto3" json:"history_events,omitempty"`
+	// Number of mutable state updates persisted.
+	StateTransitions int64 `protobuf:"varint,12,opt,name=state_transitions,json=stateTransitions,proto3" json:"state_transitions,omitempty"`
+	// Number of history bytes written.
+	HistoryBytes int64 `protobuf:"varint,13,opt,name=history_bytes,json=historyBytes,proto3" json:"history_bytes,omitempty"`
+	// History size of closed workflows multiplied by the time it is retained, i.e. from workflow start until the end
+	// of the namespace retention period. It is charged in full when the workflow closes, running workflows aren't
+	// charged for storage.
+	StorageByteSeconds int64 `protobuf:"varint,14,opt,name=storage_byte_seconds,json=storageByteSeconds,proto3" json:"storage_byte_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_temporal_server_api_persistence_v1_usage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_usage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UsageRecord) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetCostCenter() string {
+	if x != nil {
+		return x.CostCenter
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetWindowStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStartTime
+	}
+	return nil
+}
+
+func (x *UsageRecord) GetWindowEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEndTime
+	}
+	return nil
+}
+
+func (x *UsageRecord) GetActions() int64 {
+	if x != nil {
+		return x.Actions
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetWorkflowStarts() int64 {
+	if x != nil {
+		return x.WorkflowStarts
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetSignals() int64 {
+	if x != nil {
+		return x.Signals
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetActivityAttempts() int64 {
+	if x != nil {
+		return x.ActivityAttempts
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetTimers() int64 {
+	if x != nil {
+		return x.Timers
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetHistoryEvents() int64 {
+	if x != nil {
+		return x.HistoryEvents
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetStateTransitions() int64 {
+	if x != nil {
+		return x.StateTransitions
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetHistoryBytes() int64 {
+	if x != nil {
+		return x.HistoryBytes
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetStorageByteSeconds() int64 {
+	if x != nil {
+		return x.StorageByteSeconds
+	}
+	return 0
+}
+
+// UsageRecordBatch holds the usage records of a namespace written by a single flush of a history host. The batch is
+// stored as a single queue message, so that the records of a flush are written atomically.
+type UsageRecordBatch struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Records []*UsageRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// Unique ID of the flush. A flush whose write failed is retried with the same ID, which is used to find out whether
+	// the failed write was applied after all.
+	FlushId       string `protobuf:"bytes,2,opt,name=flush_id,json=flushId,proto3" json:"flush_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRecordBatch) Reset() {
+	*x = UsageRecordBatch{}
+	mi := &file_temporal_server_api_persistence_v1_usage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecordBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecordBatch) ProtoMessage() {}
+
+func (x *UsageRecordBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_usage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecordBatch.ProtoReflect.Descriptor instead.
+func (*UsageRecordBatch) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_usage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UsageRecordBatch) GetRecords() []*UsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *UsageRecordBatch) GetFlushId() string {
+	if x != nil {
+		return x.FlushId
+	}
+	return ""
+}
+
+var File_temporal_server_api_persistence_v1_usage_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_usage_proto_rawDesc = "" +
+	"\n" +
+	".temporal/server/api/persistence/v1/usage.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x04\n" +
+	"\vUsageRecord\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12#\n" +
+	"\rworkflow_type\x18\x02 \x01(\tR\fworkflowType\x12\x1f\n" +
+	"\vcost_center\x18\x03 \x01(\tR\n" +
+	"costCenter\x12F\n" +
+	"\x11window_start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fwindowStartTime\x12B\n" +
+	"\x0fwindow_end_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rwindowEndTime\x12\x18\n" +
+	"\aactions\x18\x06 \x01(\x03R\aactions\x12'\n" +
+	"\x0fworkflow_starts\x18\a \x01(\x03R\x0eworkflowStarts\x12\x18\n" +
+	"\asignals\x18\b \x01(\x03R\asignals\x12+\n" +
+	"\x11activity_attempts\x18\t \x01(\x03R\x10activityAttempts\x12\x16\n" +
+	"\x06timers\x18\n" +
+	" \x01(\x03R\x06timers\x12%\n" +
+	"\x0ehistory_events\x18\v \x01(\x03R\rhistoryEvents\x12+\n" +
+	"\x11state_transitions\x18\f \x01(\x03R\x10stateTransitions\x12#\n" +
+	"\rhistory_bytes\x18\r \x01(\x03R\fhistoryBytes\x120\n" +
+	"\x14storage_byte_seconds\x18\x0e \x01(\x03R\x12storageByteSeconds\"x\n" +
+	"\x10UsageRecordBatch\x12I\n" +
+	"\arecords\x18\x01 \x03(\v2/.temporal.server.api.persistence.v1.UsageRecordR\arecords\x12\x19\n" +
+	"\bflush_id\x18\x02 \x01(\tR\aflushIdB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_usage_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_usage_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_usage_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_usage_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_usage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_usage_proto_rawDesc), len(file_temporal_server_api_persistence_v1_usage_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_usage_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_temporal_server_api_persistence_v1_usage_proto_goTypes = []any{
+	(*UsageRecord)(nil),           // 0: temporal.server.api.persistence.v1.UsageRecord
+	(*UsageRecordBatch)(nil),      // 1: temporal.server.api.persistence.v1.UsageRecordBatch
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_temporal_server_api_persistence_v1_usage_proto_depIdxs = []int32{
+	2, // 0: temporal.server.api.persistence.v1.UsageRecord.window_start_time:type_name -> google.protobuf.Timestamp
+	2, // 1: temporal.server.api.persistence.v1.UsageRecord.window_end_time:type_name -> google.protobuf.Timestamp
+	0, // 2: temporal.server.api.persistence.v1.UsageRecordBatch.records:type_name -> temporal.server.api.persistence.v1.UsageRecord
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_usage_proto_init() }
+func file_temporal_server_api_persistence_v1_usage_proto_init() {
+	if File_temporal_server_api_persistence_v1_usage_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_usage_proto_rawDesc), len(file_temporal_server_api_persistence_v1_usage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_usage_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_usage_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_usage_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_usage_proto = out.File
+	file_temporal_server_api_persistence_v1_usage_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_usage_proto_depIdxs = nil
+}
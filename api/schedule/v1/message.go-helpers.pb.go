@@ -510,6 +510,43 @@ func (this *SchedulerInternal) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ScheduleUpstream to the protobuf v3 wire format
+func (val *ScheduleUpstream) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleUpstream from the protobuf v3 wire format
+func (val *ScheduleUpstream) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleUpstream) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleUpstream values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleUpstream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleUpstream
+	switch t := that.(type) {
+	case *ScheduleUpstream:
+		that1 = t
+	case ScheduleUpstream:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type GeneratorInternal to the protobuf v3 wire format
 func (val *GeneratorInternal) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -38,7 +38,8 @@ import (
 	v13 "go.temporal.io/api/failure/v1"
 	v11 "go.temporal.io/api/schedule/v1"
 	v14 "go.temporal.io/api/workflowservice/v1"
-	v15 "go.temporal.io/server/api/enums/v1"
+	v16 "go.temporal.io/server/api/enums/v1"
+	v15 "go.temporal.io/server/api/persistence/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -949,8 +950,14 @@ type SchedulerInternal struct {
 	// Implemented as a sequence number. Used for optimistic locking against
 	// update requests.
 	ConflictToken int64 `protobuf:"varint,8,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// When set, each completed action of the upstream schedule triggers an
+	// action on this schedule, in addition to those from the schedule's spec.
+	Upstream *ScheduleUpstream `protobuf:"bytes,9,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// Completion callbacks attached to each workflow started by this schedule.
+	// Used to notify dependent (downstream) schedules of completed actions.
+	DependentCallbacks []*v15.Callback `protobuf:"bytes,10,rep,name=dependent_callbacks,json=dependentCallbacks,proto3" json:"dependent_callbacks,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SchedulerInternal) Reset() {
@@ -1032,6 +1039,87 @@ func (x *SchedulerInternal) GetConflictToken() int64 {
 	return 0
 }
 
+func (x *SchedulerInternal) GetUpstream() *ScheduleUpstream {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetDependentCallbacks() []*v15.Callback {
+	if x != nil {
+		return x.DependentCallbacks
+	}
+	return nil
+}
+
+// Configures a schedule to be triggered by the completion of another
+// schedule's actions.
+type ScheduleUpstream struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the upstream schedule. Must be in the same namespace.
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// When true, upstream actions that did not complete successfully are
+	// skipped instead of triggering an action.
+	SkipOnFailure bool `protobuf:"varint,2,opt,name=skip_on_failure,json=skipOnFailure,proto3" json:"skip_on_failure,omitempty"`
+	// Run IDs of the most recently handled upstream completions, used to
+	// deduplicate retried completion callbacks.
+	RecentRunIds  []string `protobuf:"bytes,3,rep,name=recent_run_ids,json=recentRunIds,proto3" json:"recent_run_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleUpstream) Reset() {
+	*x = ScheduleUpstream{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleUpstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleUpstream) ProtoMessage() {}
+
+func (x *ScheduleUpstream) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleUpstream.ProtoReflect.Descriptor instead.
+func (*ScheduleUpstream) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ScheduleUpstream) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleUpstream) GetSkipOnFailure() bool {
+	if x != nil {
+		return x.SkipOnFailure
+	}
+	return false
+}
+
+func (x *ScheduleUpstream) GetRecentRunIds() []string {
+	if x != nil {
+		return x.RecentRunIds
+	}
+	return nil
+}
+
 // State machine scheduler's Generator internal state.
 type GeneratorInternal struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1045,7 +1133,7 @@ type GeneratorInternal struct {
 
 func (x *GeneratorInternal) Reset() {
 	*x = GeneratorInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1145,7 @@ func (x *GeneratorInternal) String() string {
 func (*GeneratorInternal) ProtoMessage() {}
 
 func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1158,7 @@ func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneratorInternal.ProtoReflect.Descriptor instead.
 func (*GeneratorInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GeneratorInternal) GetNextInvocationTime() *timestamppb.Timestamp {
@@ -1090,7 +1178,7 @@ func (x *GeneratorInternal) GetLastProcessedTime() *timestamppb.Timestamp {
 // State machine scheduler's Executor internal state.
 type ExecutorInternal struct {
 	state protoimpl.MessageState     `protogen:"open.v1"`
-	State v15.SchedulerExecutorState `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.SchedulerExecutorState" json:"state,omitempty"`
+	State v16.SchedulerExecutorState `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.SchedulerExecutorState" json:"state,omitempty"`
 	// Wakes immediately after actions are buffered, or when the deadline from
 	// the BACKING_OFF state has expired.
 	NextInvocationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_invocation_time,json=nextInvocationTime,proto3" json:"next_invocation_time,omitempty"`
@@ -1102,7 +1190,7 @@ type ExecutorInternal struct {
 
 func (x *ExecutorInternal) Reset() {
 	*x = ExecutorInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1202,7 @@ func (x *ExecutorInternal) String() string {
 func (*ExecutorInternal) ProtoMessage() {}
 
 func (x *ExecutorInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,14 +1215,14 @@ func (x *ExecutorInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutorInternal.ProtoReflect.Descriptor instead.
 func (*ExecutorInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ExecutorInternal) GetState() v15.SchedulerExecutorState {
+func (x *ExecutorInternal) GetState() v16.SchedulerExecutorState {
 	if x != nil {
 		return x.State
 	}
-	return v15.SchedulerExecutorState(0)
+	return v16.SchedulerExecutorState(0)
 }
 
 func (x *ExecutorInternal) GetNextInvocationTime() *timestamppb.Timestamp {
@@ -1164,7 +1252,7 @@ type BackfillerInternal struct {
 
 func (x *BackfillerInternal) Reset() {
 	*x = BackfillerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1176,7 +1264,7 @@ func (x *BackfillerInternal) String() string {
 func (*BackfillerInternal) ProtoMessage() {}
 
 func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1277,7 @@ func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackfillerInternal.ProtoReflect.Descriptor instead.
 func (*BackfillerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BackfillerInternal) GetRequest() *v11.BackfillRequest {
@@ -1210,7 +1298,7 @@ var File_temporal_server_api_schedule_v1_message_proto protoreflect.FileDescript
 
 const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"-temporal/server/api/schedule/v1/message.proto\x12\x1ftemporal.server.api.schedule.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/enums/v1/schedule.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/failure/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x02\n" +
+	"-temporal/server/api/schedule/v1/message.proto\x12\x1ftemporal.server.api.schedule.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/enums/v1/schedule.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/failure/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x02\n" +
 	"\rBufferedStart\x12=\n" +
 	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12;\n" +
 	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -1282,7 +1370,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"next_times\x18\x03 \x03(\x03R\tnextTimes\x12#\n" +
 	"\rnominal_times\x18\x04 \x03(\x03R\fnominalTimes\x12\x1c\n" +
-	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\xe6\x02\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\x94\x04\n" +
 	"\x11SchedulerInternal\x12>\n" +
 	"\bschedule\x18\x02 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x03 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
@@ -1291,7 +1379,15 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\fnamespace_id\x18\x06 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vschedule_id\x18\a \x01(\tR\n" +
 	"scheduleId\x12%\n" +
-	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\"\xad\x01\n" +
+	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\x12M\n" +
+	"\bupstream\x18\t \x01(\v21.temporal.server.api.schedule.v1.ScheduleUpstreamR\bupstream\x12]\n" +
+	"\x13dependent_callbacks\x18\n" +
+	" \x03(\v2,.temporal.server.api.persistence.v1.CallbackR\x12dependentCallbacks\"\x81\x01\n" +
+	"\x10ScheduleUpstream\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12&\n" +
+	"\x0fskip_on_failure\x18\x02 \x01(\bR\rskipOnFailure\x12$\n" +
+	"\x0erecent_run_ids\x18\x03 \x03(\tR\frecentRunIds\"\xad\x01\n" +
 	"\x11GeneratorInternal\x12L\n" +
 	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTime\x12J\n" +
 	"\x13last_processed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\x85\x02\n" +
@@ -1315,7 +1411,7 @@ func file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_temporal_server_api_schedule_v1_message_proto_goTypes = []any{
 	(*BufferedStart)(nil),                     // 0: temporal.server.api.schedule.v1.BufferedStart
 	(*InternalState)(nil),                     // 1: temporal.server.api.schedule.v1.InternalState
@@ -1330,66 +1426,70 @@ var file_temporal_server_api_schedule_v1_message_proto_goTypes = []any{
 	(*TerminateWorkflowRequest)(nil),          // 10: temporal.server.api.schedule.v1.TerminateWorkflowRequest
 	(*NextTimeCache)(nil),                     // 11: temporal.server.api.schedule.v1.NextTimeCache
 	(*SchedulerInternal)(nil),                 // 12: temporal.server.api.schedule.v1.SchedulerInternal
-	(*GeneratorInternal)(nil),                 // 13: temporal.server.api.schedule.v1.GeneratorInternal
-	(*ExecutorInternal)(nil),                  // 14: temporal.server.api.schedule.v1.ExecutorInternal
-	(*BackfillerInternal)(nil),                // 15: temporal.server.api.schedule.v1.BackfillerInternal
-	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
-	(v1.ScheduleOverlapPolicy)(0),             // 17: temporal.api.enums.v1.ScheduleOverlapPolicy
-	(*v11.BackfillRequest)(nil),               // 18: temporal.api.schedule.v1.BackfillRequest
-	(*v12.Payloads)(nil),                      // 19: temporal.api.common.v1.Payloads
-	(*v13.Failure)(nil),                       // 20: temporal.api.failure.v1.Failure
-	(*v11.Schedule)(nil),                      // 21: temporal.api.schedule.v1.Schedule
-	(*v11.ScheduleInfo)(nil),                  // 22: temporal.api.schedule.v1.ScheduleInfo
-	(*v11.SchedulePatch)(nil),                 // 23: temporal.api.schedule.v1.SchedulePatch
-	(*v12.SearchAttributes)(nil),              // 24: temporal.api.common.v1.SearchAttributes
-	(*v12.WorkflowExecution)(nil),             // 25: temporal.api.common.v1.WorkflowExecution
-	(v1.WorkflowExecutionStatus)(0),           // 26: temporal.api.enums.v1.WorkflowExecutionStatus
-	(*v14.StartWorkflowExecutionRequest)(nil), // 27: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	(v15.SchedulerExecutorState)(0),           // 28: temporal.server.api.enums.v1.SchedulerExecutorState
+	(*ScheduleUpstream)(nil),                  // 13: temporal.server.api.schedule.v1.ScheduleUpstream
+	(*GeneratorInternal)(nil),                 // 14: temporal.server.api.schedule.v1.GeneratorInternal
+	(*ExecutorInternal)(nil),                  // 15: temporal.server.api.schedule.v1.ExecutorInternal
+	(*BackfillerInternal)(nil),                // 16: temporal.server.api.schedule.v1.BackfillerInternal
+	(*timestamppb.Timestamp)(nil),             // 17: google.protobuf.Timestamp
+	(v1.ScheduleOverlapPolicy)(0),             // 18: temporal.api.enums.v1.ScheduleOverlapPolicy
+	(*v11.BackfillRequest)(nil),               // 19: temporal.api.schedule.v1.BackfillRequest
+	(*v12.Payloads)(nil),                      // 20: temporal.api.common.v1.Payloads
+	(*v13.Failure)(nil),                       // 21: temporal.api.failure.v1.Failure
+	(*v11.Schedule)(nil),                      // 22: temporal.api.schedule.v1.Schedule
+	(*v11.ScheduleInfo)(nil),                  // 23: temporal.api.schedule.v1.ScheduleInfo
+	(*v11.SchedulePatch)(nil),                 // 24: temporal.api.schedule.v1.SchedulePatch
+	(*v12.SearchAttributes)(nil),              // 25: temporal.api.common.v1.SearchAttributes
+	(*v12.WorkflowExecution)(nil),             // 26: temporal.api.common.v1.WorkflowExecution
+	(v1.WorkflowExecutionStatus)(0),           // 27: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v14.StartWorkflowExecutionRequest)(nil), // 28: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	(*v15.Callback)(nil),                      // 29: temporal.server.api.persistence.v1.Callback
+	(v16.SchedulerExecutorState)(0),           // 30: temporal.server.api.enums.v1.SchedulerExecutorState
 }
 var file_temporal_server_api_schedule_v1_message_proto_depIdxs = []int32{
-	16, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
-	16, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
-	16, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
-	17, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
-	16, // 4: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
+	17, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
+	17, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
+	17, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
+	18, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
+	17, // 4: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
 	0,  // 5: temporal.server.api.schedule.v1.InternalState.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	18, // 6: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
-	19, // 7: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
-	20, // 8: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
-	21, // 9: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 10: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 11: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	19, // 6: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
+	20, // 7: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
+	21, // 8: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
+	22, // 9: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	23, // 10: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	24, // 11: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
 	1,  // 12: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
-	21, // 13: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	24, // 14: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
-	21, // 15: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 16: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	25, // 17: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	26, // 18: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
-	19, // 19: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
-	20, // 20: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
-	16, // 21: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
-	27, // 22: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	16, // 23: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
-	25, // 24: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	25, // 25: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	16, // 26: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
-	21, // 27: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 28: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 29: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	16, // 30: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	16, // 31: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	28, // 32: temporal.server.api.schedule.v1.ExecutorInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerExecutorState
-	16, // 33: temporal.server.api.schedule.v1.ExecutorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	0,  // 34: temporal.server.api.schedule.v1.ExecutorInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	18, // 35: temporal.server.api.schedule.v1.BackfillerInternal.request:type_name -> temporal.api.schedule.v1.BackfillRequest
-	16, // 36: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	22, // 13: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	25, // 14: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	22, // 15: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	23, // 16: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	26, // 17: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	27, // 18: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	20, // 19: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
+	21, // 20: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
+	17, // 21: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
+	28, // 22: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	17, // 23: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
+	26, // 24: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	26, // 25: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	17, // 26: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
+	22, // 27: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	23, // 28: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	24, // 29: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	13, // 30: temporal.server.api.schedule.v1.SchedulerInternal.upstream:type_name -> temporal.server.api.schedule.v1.ScheduleUpstream
+	29, // 31: temporal.server.api.schedule.v1.SchedulerInternal.dependent_callbacks:type_name -> temporal.server.api.persistence.v1.Callback
+	17, // 32: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	17, // 33: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	30, // 34: temporal.server.api.schedule.v1.ExecutorInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerExecutorState
+	17, // 35: temporal.server.api.schedule.v1.ExecutorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	0,  // 36: temporal.server.api.schedule.v1.ExecutorInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
+	19, // 37: temporal.server.api.schedule.v1.BackfillerInternal.request:type_name -> temporal.api.schedule.v1.BackfillRequest
+	17, // 38: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_schedule_v1_message_proto_init() }
@@ -1407,7 +1507,7 @@ func file_temporal_server_api_schedule_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_schedule_v1_message_proto_rawDesc), len(file_temporal_server_api_schedule_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -140,6 +140,80 @@ func (this *TaskQueueVersionInfoInternal) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkerMetadata to the protobuf v3 wire format
+func (val *WorkerMetadata) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerMetadata from the protobuf v3 wire format
+func (val *WorkerMetadata) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerMetadata) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerMetadata values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerMetadata
+	switch t := that.(type) {
+	case *WorkerMetadata:
+		that1 = t
+	case WorkerMetadata:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerInfo to the protobuf v3 wire format
+func (val *WorkerInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerInfo from the protobuf v3 wire format
+func (val *WorkerInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerInfo
+	switch t := that.(type) {
+	case *WorkerInfo:
+		that1 = t
+	case WorkerInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type PhysicalTaskQueueInfo to the protobuf v3 wire format
 func (val *PhysicalTaskQueueInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
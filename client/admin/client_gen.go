@@ -63,6 +63,16 @@ func (c *clientImpl) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) BackupPersistence(
+	ctx context.Context,
+	request *adminservice.BackupPersistenceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BackupPersistenceResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.BackupPersistence(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -93,6 +103,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -143,6 +163,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNexusEndpointResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeNexusEndpoint(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -253,6 +283,16 @@ func (c *clientImpl) GetShard(
 	return c.client.GetShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetTaskQueueScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetTaskQueueScalingRecommendation(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetTaskQueueTasks(
 	ctx context.Context,
 	request *adminservice.GetTaskQueueTasksRequest,
@@ -333,6 +373,36 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskQueueBacklog(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueWorkersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskQueueWorkers(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListUsageRecords(
+	ctx context.Context,
+	request *adminservice.ListUsageRecordsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListUsageRecordsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListUsageRecords(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -452,3 +522,23 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateNexusEndpointOutboundSettings(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundSettingsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointOutboundSettingsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointOutboundSettings(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
+}
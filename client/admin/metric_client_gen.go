@@ -75,6 +75,20 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) BackupPersistence(
+	ctx context.Context,
+	request *adminservice.BackupPersistenceRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.BackupPersistenceResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientBackupPersistence")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.BackupPersistence(ctx, request, opts...)
+}
+
 func (c *metricClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -117,6 +131,20 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteTaskQueueBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteTaskQueueBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -187,6 +215,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeNexusEndpointResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeNexusEndpoint")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeNexusEndpoint(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -341,6 +383,20 @@ func (c *metricClient) GetShard(
 	return c.client.GetShard(ctx, request, opts...)
 }
 
+func (c *metricClient) GetTaskQueueScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetTaskQueueScalingRecommendationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetTaskQueueScalingRecommendation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetTaskQueueScalingRecommendation(ctx, request, opts...)
+}
+
 func (c *metricClient) GetTaskQueueTasks(
 	ctx context.Context,
 	request *adminservice.GetTaskQueueTasksRequest,
@@ -453,6 +509,48 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListTaskQueueBacklog")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueBacklog(ctx, request, opts...)
+}
+
+func (c *metricClient) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueWorkersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTaskQueueWorkersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListTaskQueueWorkers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueWorkers(ctx, request, opts...)
+}
+
+func (c *metricClient) ListUsageRecords(
+	ctx context.Context,
+	request *adminservice.ListUsageRecordsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListUsageRecordsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListUsageRecords")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListUsageRecords(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -620,3 +718,31 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpdateNexusEndpointOutboundSettings(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundSettingsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointOutboundSettingsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointOutboundSettings")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointOutboundSettings(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateTaskQueueConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateTaskQueueConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
+}
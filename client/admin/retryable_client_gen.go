@@ -80,6 +80,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) BackupPersistence(
+	ctx context.Context,
+	request *adminservice.BackupPersistenceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BackupPersistenceResponse, error) {
+	var resp *adminservice.BackupPersistenceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.BackupPersistence(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -125,6 +140,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	var resp *adminservice.DeleteTaskQueueBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskQueueBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -200,6 +230,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNexusEndpointResponse, error) {
+	var resp *adminservice.DescribeNexusEndpointResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNexusEndpoint(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -365,6 +410,21 @@ func (c *retryableClient) GetShard(
 	return resp, err
 }
 
+func (c *retryableClient) GetTaskQueueScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+	var resp *adminservice.GetTaskQueueScalingRecommendationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskQueueScalingRecommendation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetTaskQueueTasks(
 	ctx context.Context,
 	request *adminservice.GetTaskQueueTasksRequest,
@@ -485,6 +545,51 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	var resp *adminservice.ListTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueWorkersResponse, error) {
+	var resp *adminservice.ListTaskQueueWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListUsageRecords(
+	ctx context.Context,
+	request *adminservice.ListUsageRecordsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListUsageRecordsResponse, error) {
+	var resp *adminservice.ListUsageRecordsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListUsageRecords(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -664,3 +769,33 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateNexusEndpointOutboundSettings(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundSettingsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointOutboundSettingsResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointOutboundSettingsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointOutboundSettings(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueConfigResponse, error) {
+	var resp *adminservice.UpdateTaskQueueConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -106,6 +106,10 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeNexusEndpoint(ctx context.Context, request *historyservice.DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*historyservice.DescribeNexusEndpointResponse, error) {
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.DescribeNexusEndpoint(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
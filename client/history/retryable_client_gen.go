@@ -170,6 +170,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *historyservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeNexusEndpointResponse, error) {
+	var resp *historyservice.DescribeNexusEndpointResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNexusEndpoint(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -29,6 +29,7 @@ package matching
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -36,11 +37,13 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/tqid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 var _ matchingservice.MatchingServiceClient = (*clientImpl)(nil)
@@ -84,7 +87,7 @@ func (c *clientImpl) AddActivityTask(
 	ctx context.Context,
 	request *matchingservice.AddActivityTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddActivityTaskResponse, error) {
-	client, err := c.pickClientForWrite(
+	client, tq, err := c.pickClientForWrite(
 		request.GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -94,14 +97,17 @@ func (c *clientImpl) AddActivityTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, request, opts...)
+	var header metadata.MD
+	resp, err := client.AddActivityTask(ctx, request, append(opts, grpc.Header(&header))...)
+	c.updatePartitionCounts(tq, header)
+	return resp, err
 }
 
 func (c *clientImpl) AddWorkflowTask(
 	ctx context.Context,
 	request *matchingservice.AddWorkflowTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
-	client, err := c.pickClientForWrite(
+	client, tq, err := c.pickClientForWrite(
 		request.GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -111,14 +117,17 @@ func (c *clientImpl) AddWorkflowTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddWorkflowTask(ctx, request, opts...)
+	var header metadata.MD
+	resp, err := client.AddWorkflowTask(ctx, request, append(opts, grpc.Header(&header))...)
+	c.updatePartitionCounts(tq, header)
+	return resp, err
 }
 
 func (c *clientImpl) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
-	client, release, err := c.pickClientForRead(
+	client, tq, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
@@ -131,14 +140,17 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollActivityTaskQueue(ctx, request, opts...)
+	var header metadata.MD
+	resp, err := client.PollActivityTaskQueue(ctx, request, append(opts, grpc.Header(&header))...)
+	c.updatePartitionCounts(tq, header)
+	return resp, err
 }
 
 func (c *clientImpl) PollWorkflowTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollWorkflowTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollWorkflowTaskQueueResponse, error) {
-	client, release, err := c.pickClientForRead(
+	client, tq, release, err := c.pickClientForRead(
 		request.GetPollRequest().GetTaskQueue(),
 		request.GetNamespaceId(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
@@ -151,11 +163,14 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollWorkflowTaskQueue(ctx, request, opts...)
+	var header metadata.MD
+	resp, err := client.PollWorkflowTaskQueue(ctx, request, append(opts, grpc.Header(&header))...)
+	c.updatePartitionCounts(tq, header)
+	return resp, err
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
-	client, err := c.pickClientForWrite(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo().GetSourcePartition())
+	client, _, err := c.pickClientForWrite(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo().GetSourcePartition())
 	if err != nil {
 		return nil, err
 	}
@@ -188,17 +203,23 @@ func (c *clientImpl) processInputPartition(proto *taskqueuepb.TaskQueue, nsid st
 	}
 }
 
-func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (matchingservice.MatchingServiceClient, error) {
+// pickClientForWrite returns the client for the partition to send a write to. The returned task queue is non-nil
+// when the partition was picked by the load balancer.
+func (c *clientImpl) pickClientForWrite(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (matchingservice.MatchingServiceClient, *tqid.TaskQueue, error) {
 	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		p = c.loadBalancer.PickWritePartition(tq)
 	}
 	proto.Name = p.RpcName()
-	return c.getClientForTaskQueuePartition(p)
+	client, err := c.getClientForTaskQueuePartition(p)
+	return client, tq, err
 }
 
-func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, release func(), err error) {
-	p, tq := c.processInputPartition(proto, nsid, taskType, forwardedFrom)
+// pickClientForRead returns the client for the partition to send a poll to. The returned task queue is non-nil
+// when the partition was picked by the load balancer.
+func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, forwardedFrom string) (client matchingservice.MatchingServiceClient, tq *tqid.TaskQueue, release func(), err error) {
+	var p tqid.Partition
+	p, tq = c.processInputPartition(proto, nsid, taskType, forwardedFrom)
 	if tq != nil {
 		token := c.loadBalancer.PickReadPartition(tq)
 		p = token.TQPartition
@@ -207,7 +228,28 @@ func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string
 
 	proto.Name = p.RpcName()
 	client, err = c.getClientForTaskQueuePartition(p)
-	return client, release, err
+	return client, tq, release, err
+}
+
+// updatePartitionCounts passes the partition counts reported by matching in the response header on to the load
+// balancer, for task queues whose partition was picked by it.
+func (c *clientImpl) updatePartitionCounts(tq *tqid.TaskQueue, header metadata.MD) {
+	if tq == nil {
+		return
+	}
+	read, readErr := strconv.Atoi(firstHeaderValue(header, headers.ReadPartitionsHeaderName))
+	write, writeErr := strconv.Atoi(firstHeaderValue(header, headers.WritePartitionsHeaderName))
+	if readErr != nil || writeErr != nil {
+		return
+	}
+	c.loadBalancer.UpdatePartitionCounts(tq, read, write)
+}
+
+func firstHeaderValue(header metadata.MD, key string) string {
+	if values := header.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
@@ -117,6 +117,23 @@ func (c *clientImpl) CreateNexusEndpoint(
 	return client.CreateNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DeleteBacklogTasksResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.DeleteNexusEndpointRequest,
@@ -328,6 +345,23 @@ func (c *clientImpl) GetWorkerVersioningRules(
 	return client.GetWorkerVersioningRules(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListBacklogTasksResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListNexusEndpoints(
 	ctx context.Context,
 	request *matchingservice.ListNexusEndpointsRequest,
@@ -368,6 +402,23 @@ func (c *clientImpl) ListTaskQueuePartitions(
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueWorkersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueueWorkersResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListTaskQueueWorkers(ctx, request, opts...)
+}
+
 func (c *clientImpl) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -508,6 +559,26 @@ func (c *clientImpl) UpdateNexusEndpoint(
 	return client.UpdateNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueConfigRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskQueueConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -55,6 +55,14 @@ type (
 		PickReadPartition(
 			taskQueue *tqid.TaskQueue,
 		) *pollToken
+
+		// UpdatePartitionCounts records the partition counts that matching reported for a task queue,
+		// e.g. after auto-scaling its partitions. Until updated again, they cap the configured counts.
+		UpdatePartitionCounts(
+			taskQueue *tqid.TaskQueue,
+			read int,
+			write int,
+		)
 	}
 
 	defaultLoadBalancer struct {
@@ -72,6 +80,9 @@ type (
 		taskQueue    *tqid.TaskQueue
 		pollerCounts []int // keep track of poller count of each partition
 		lock         sync.Mutex
+		// partition counts reported by matching, zero if unknown
+		reportedRead  int
+		reportedWrite int
 	}
 
 	pollToken struct {
@@ -110,6 +121,9 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	}
 
 	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
+	if _, reportedWrite := lb.getTaskQueueLoadBalancer(taskQueue).reportedPartitionCounts(); reportedWrite > 0 {
+		n = min(n, reportedWrite)
+	}
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
 
@@ -128,6 +142,9 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	if err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
 	}
+	if reportedRead, _ := tqlb.reportedPartitionCounts(); reportedRead > 0 {
+		partitionCount = min(partitionCount, reportedRead)
+	}
 
 	if n, ok := testhooks.Get[int](lb.testHooks, testhooks.MatchingLBForceWritePartition); ok {
 		return tqlb.forceReadPartition(partitionCount, n)
@@ -136,6 +153,14 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	return tqlb.pickReadPartition(partitionCount)
 }
 
+func (lb *defaultLoadBalancer) UpdatePartitionCounts(
+	taskQueue *tqid.TaskQueue,
+	read int,
+	write int,
+) {
+	lb.getTaskQueueLoadBalancer(taskQueue).setReportedPartitionCounts(read, write)
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	lb.lock.RLock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
@@ -160,6 +185,18 @@ func newTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	}
 }
 
+func (b *tqLoadBalancer) reportedPartitionCounts() (read int, write int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.reportedRead, b.reportedWrite
+}
+
+func (b *tqLoadBalancer) setReportedPartitionCounts(read int, write int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.reportedRead, b.reportedWrite = read, write
+}
+
 func (b *tqLoadBalancer) pickReadPartition(partitionCount int) *pollToken {
 	b.lock.Lock()
 	defer b.lock.Unlock()
@@ -30,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
 
@@ -178,6 +179,34 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancer_ReportedPartitionCounts(t *testing.T) {
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	taskQueue := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(namespace.ID) (namespace.Name, error) { return "fake-namespace", nil },
+		nReadPartitions:   func(string, string, enumspb.TaskQueueType) int { return 4 },
+		nWritePartitions:  func(string, string, enumspb.TaskQueueType) int { return 4 },
+		taskQueueLBs:      make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+
+	// reported counts below the configured ones cap them
+	lb.UpdatePartitionCounts(taskQueue, 2, 1)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, 0, lb.PickWritePartition(taskQueue).PartitionId())
+		lb.PickReadPartition(taskQueue)
+	}
+	assert.Len(t, lb.getTaskQueueLoadBalancer(taskQueue).pollerCounts, 2)
+
+	// reported counts above the configured ones are ignored
+	lb.UpdatePartitionCounts(taskQueue, 8, 8)
+	for i := 0; i < 10; i++ {
+		assert.Less(t, lb.PickWritePartition(taskQueue).PartitionId(), 4)
+		lb.PickReadPartition(taskQueue)
+	}
+	assert.Len(t, lb.getTaskQueueLoadBalancer(taskQueue).pollerCounts, 4)
+}
+
 func maxPollerCount(tqlb *tqLoadBalancer) int {
 	res := -1
 	for _, c := range tqlb.pollerCounts {
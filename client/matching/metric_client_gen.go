@@ -89,6 +89,20 @@ func (c *metricClient) CreateNexusEndpoint(
 	return c.client.CreateNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.DeleteBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientDeleteBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.DeleteNexusEndpointRequest,
@@ -243,6 +257,20 @@ func (c *metricClient) GetWorkerVersioningRules(
 	return c.client.GetWorkerVersioningRules(ctx, request, opts...)
 }
 
+func (c *metricClient) ListBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ListBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientListBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListNexusEndpoints(
 	ctx context.Context,
 	request *matchingservice.ListNexusEndpointsRequest,
@@ -271,6 +299,20 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return c.client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueWorkersRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ListTaskQueueWorkersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientListTaskQueueWorkers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueWorkers(ctx, request, opts...)
+}
+
 func (c *metricClient) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -369,6 +411,20 @@ func (c *metricClient) UpdateNexusEndpoint(
 	return c.client.UpdateNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateTaskQueueConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateTaskQueueConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
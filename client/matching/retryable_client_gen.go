@@ -125,6 +125,21 @@ func (c *retryableClient) CreateNexusEndpoint(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	var resp *matchingservice.DeleteBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.DeleteNexusEndpointRequest,
@@ -290,6 +305,21 @@ func (c *retryableClient) GetWorkerVersioningRules(
 	return resp, err
 }
 
+func (c *retryableClient) ListBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListBacklogTasksResponse, error) {
+	var resp *matchingservice.ListBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListNexusEndpoints(
 	ctx context.Context,
 	request *matchingservice.ListNexusEndpointsRequest,
@@ -320,6 +350,21 @@ func (c *retryableClient) ListTaskQueuePartitions(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueWorkersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueueWorkersResponse, error) {
+	var resp *matchingservice.ListTaskQueueWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
@@ -470,6 +515,21 @@ func (c *retryableClient) UpdateNexusEndpoint(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueConfigRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+	var resp *matchingservice.UpdateTaskQueueConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -224,7 +224,12 @@ func (i *historyIterator) readHistory(ctx context.Context, firstEventID int64) (
 		PageSize:    i.historyPageSize,
 		ShardID:     i.request.ShardID,
 	}
-	historyBatches, _, _, err := persistence.ReadFullPageEventsByBatch(ctx, i.executionManager, req)
+	// History of a closed workflow doesn't change, so it may be read from a read replica. A replica which
+	// is behind returns NotFound before the end of the history, read from the primary in that case.
+	historyBatches, _, _, err := persistence.ReadFullPageEventsByBatch(persistence.WithStaleReadsAllowed(ctx), i.executionManager, req)
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound && firstEventID < i.request.NextEventID {
+		historyBatches, _, _, err = persistence.ReadFullPageEventsByBatch(ctx, i.executionManager, req)
+	}
 	return historyBatches, err
 }
 
@@ -90,6 +90,37 @@ type (
 		// Host defaults to `localhost` but can be overriden
 		// for instance in the case of dual stack IPv4/IPv6
 		Host string `yaml:"host"`
+		// Continuous enables the in-process continuous profiler, which periodically
+		// captures profiles and sends them to a sink. It's disabled if not set.
+		Continuous *ContinuousProfiling `yaml:"continuous"`
+	}
+
+	// ContinuousProfiling contains the config items for the continuous profiler.
+	// Exactly one of Directory and PushURL must be set.
+	ContinuousProfiling struct {
+		// Interval is how often profiles are captured. Defaults to 1 minute.
+		Interval time.Duration `yaml:"interval"`
+		// CPUDuration is how long the CPU profile of each interval runs for.
+		// It must be shorter than Interval. Defaults to 10 seconds.
+		CPUDuration time.Duration `yaml:"cpuDuration"`
+		// Profiles lists the profiles to capture out of "cpu", "heap", "goroutine"
+		// and "mutex". Defaults to all of them.
+		Profiles []string `yaml:"profiles"`
+		// MutexProfileFraction is the fraction of mutex contention events reported
+		// in the mutex profile, see runtime.SetMutexProfileFraction. Defaults to 10.
+		MutexProfileFraction int `yaml:"mutexProfileFraction"`
+		// Directory is a local directory profiles are written to.
+		Directory string `yaml:"directory"`
+		// MaxFiles is the number of files of each profile kept in Directory.
+		// Older files are deleted. Defaults to 10.
+		MaxFiles int `yaml:"maxFiles"`
+		// PushURL is a URL profiles are sent to in the body of a POST request.
+		PushURL string `yaml:"pushURL"`
+		// PushTimeout is the timeout of requests to PushURL. Defaults to 10 seconds.
+		PushTimeout time.Duration `yaml:"pushTimeout"`
+		// Labels are added to the query of requests to PushURL, e.g. to identify
+		// the cluster or host the profiles were captured on.
+		Labels map[string]string `yaml:"labels"`
 	}
 
 	// RPC contains the rpc config items
@@ -398,6 +429,9 @@ type (
 		DisableInitialHostLookup bool `yaml:"disableInitialHostLookup"`
 		// AddressTranslator translates Cassandra IP addresses, used for cases when IP addresses gocql driver returns are not accessible from the server
 		AddressTranslator *CassandraAddressTranslator `yaml:"addressTranslator"`
+		// ScyllaDB enables ScyllaDB specific behavior of the client, such as tuning of lightweight transactions.
+		// Leave unset when connecting to Cassandra.
+		ScyllaDB *ScyllaDB `yaml:"scylladb"`
 	}
 
 	// CassandraStoreConsistency enables you to set the consistency settings for each Cassandra Persistence Store for Temporal
@@ -414,6 +448,31 @@ type (
 		Options map[string]string `yaml:"options"`
 	}
 
+	// ScyllaDB contains the ScyllaDB specific settings of a Cassandra datastore.
+	// The client connects to the regular CQL port and ScyllaDB balances connections over shards. Routing
+	// queries to the shard owning their token requires building with the scylladb/gocql fork, which keeps
+	// the upstream API and dials the shard-aware port on its own.
+	ScyllaDB struct {
+		// LWT tunes all lightweight transactions, unless overridden for the shard or execution stores below
+		LWT *ScyllaDBLWTSettings `yaml:"lwt"`
+		// ShardLWT tunes the lightweight transactions that update the shard range ID
+		ShardLWT *ScyllaDBLWTSettings `yaml:"shardLWT"`
+		// ExecutionLWT tunes the lightweight transactions that create and update workflow executions and
+		// their tasks
+		ExecutionLWT *ScyllaDBLWTSettings `yaml:"executionLWT"`
+	}
+
+	// ScyllaDBLWTSettings overrides the consistency settings of a class of lightweight transactions.
+	// Unset fields fall back to the datastore's consistency configuration.
+	ScyllaDBLWTSettings struct {
+		// Consistency is the consistency level of the commit phase of the transaction
+		Consistency string `yaml:"consistency"`
+		// SerialConsistency is the consistency level of the paxos phase of the transaction
+		SerialConsistency string `yaml:"serialConsistency"`
+		// Timeout bounds the duration of a single transaction, including its paxos rounds
+		Timeout time.Duration `yaml:"timeout"`
+	}
+
 	// CassandraConsistencySettings sets the default consistency level for regular & serial queries to Cassandra.
 	CassandraConsistencySettings struct {
 		// Consistency sets the default consistency level. Values identical to gocql Consistency values. (defaults to LOCAL_QUORUM if not set).
@@ -452,6 +511,25 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// ReadReplicas are replicas of the database which serve reads that tolerate replication lag,
+		// such as visibility list queries and history reads for archival. Only supported by mysql8,
+		// postgres12 and postgres12_pgx plugins.
+		ReadReplicas []SQLReadReplica `yaml:"readReplicas"`
+		// BackupDir is the directory that backups of this datastore requested through the admin
+		// BackupPersistence API are written to, on the frontend host that serves the request.
+		// Backups are rejected if it is not set. Only supported by the sqlite plugin.
+		BackupDir string `yaml:"backupDir"`
+	}
+
+	// SQLReadReplica is the configuration of a read replica of a SQL database. All connection settings
+	// other than the address are taken from the primary.
+	SQLReadReplica struct {
+		// ConnectAddr is the remote addr of the replica
+		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
+		// MaxLag is the replication lag above which reads are sent to the primary instead, defaults to 5s
+		MaxLag time.Duration `yaml:"maxLag"`
+		// LagCheckInterval is the interval at which the replication lag is checked, defaults to 2s
+		LagCheckInterval time.Duration `yaml:"lagCheckInterval"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
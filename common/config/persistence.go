@@ -253,7 +253,40 @@ func ensureStoreConsistencyNotNil(c *CassandraStoreConsistency) *CassandraStoreC
 }
 
 func (c *Cassandra) validate() error {
-	return c.Consistency.validate()
+	if err := c.Consistency.validate(); err != nil {
+		return err
+	}
+	return c.ScyllaDB.validate()
+}
+
+func (c *ScyllaDB) validate() error {
+	if c == nil {
+		return nil
+	}
+	if err := c.LWT.validate(); err != nil {
+		return fmt.Errorf("bad scylladb lwt settings: %w", err)
+	}
+	if err := c.ShardLWT.validate(); err != nil {
+		return fmt.Errorf("bad scylladb shardLWT settings: %w", err)
+	}
+	if err := c.ExecutionLWT.validate(); err != nil {
+		return fmt.Errorf("bad scylladb executionLWT settings: %w", err)
+	}
+	return nil
+}
+
+func (c *ScyllaDBLWTSettings) validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("negative timeout: %v", c.Timeout)
+	}
+	settings := CassandraConsistencySettings{
+		Consistency:       c.Consistency,
+		SerialConsistency: c.SerialConsistency,
+	}
+	return settings.validate()
 }
 
 func (c *CassandraStoreConsistency) validate() error {
@@ -27,6 +27,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 )
@@ -230,3 +231,85 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestScyllaDB_validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings *ScyllaDB
+		wantErr  bool
+	}{
+		{
+			name:     "nil settings",
+			settings: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "empty settings",
+			settings: &ScyllaDB{},
+			wantErr:  false,
+		},
+		{
+			name: "happy path",
+			settings: &ScyllaDB{
+				LWT: &ScyllaDBLWTSettings{
+					Timeout: 5 * time.Second,
+				},
+				ShardLWT: &ScyllaDBLWTSettings{
+					SerialConsistency: "serial",
+					Timeout:           time.Second,
+				},
+				ExecutionLWT: &ScyllaDBLWTSettings{
+					Consistency:       "local_quorum",
+					SerialConsistency: "local_serial",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "bad default serial consistency",
+			settings: &ScyllaDB{
+				LWT: &ScyllaDBLWTSettings{
+					SerialConsistency: "one",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad shard serial consistency",
+			settings: &ScyllaDB{
+				ShardLWT: &ScyllaDBLWTSettings{
+					SerialConsistency: "quorum",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad execution consistency",
+			settings: &ScyllaDB{
+				ExecutionLWT: &ScyllaDBLWTSettings{
+					Consistency: "fake_value",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative timeout",
+			settings: &ScyllaDB{
+				ExecutionLWT: &ScyllaDBLWTSettings{
+					Timeout: -time.Second,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.settings
+			if err := c.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("ScyllaDB.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/retrypolicy"
 )
@@ -903,6 +904,39 @@ used when the first cache layer has a miss. Requires server restart for change t
 		`The TTL of the Nexus endpoint registry's readthrough LRU cache - the cache is a secondary cache and is only
 used when the first cache layer has a miss. Requires server restart for change to be applied.`,
 	)
+	NexusEndpointCircuitBreakerEnabled = NewGlobalBoolSetting(
+		"system.nexusEndpointCircuitBreaker.enabled",
+		true,
+		`NexusEndpointCircuitBreakerEnabled toggles the per endpoint circuit breakers history hosts use to stop sending
+Nexus requests to endpoints that keep failing.`,
+	)
+	NexusEndpointCircuitBreakerSettings = NewGlobalTypedSetting(
+		"system.nexusEndpointCircuitBreaker.settings",
+		CircuitBreakerSettings{},
+		`NexusEndpointCircuitBreakerSettings are the settings of the per endpoint Nexus circuit breakers.
+Fields (see gobreaker reference for more details):
+- MaxRequests: Maximum number of requests allowed to pass through when it is half-open (default 1).
+- Interval (duration): Cyclic period in closed state to clear the internal counts;
+  if interval is 0, then it never clears the internal counts (default 0).
+- Timeout (duration): Period of open state before changing to half-open state (default 60s).`,
+	)
+	NexusEndpointHealthProbeEnabled = NewGlobalBoolSetting(
+		"system.nexusEndpointHealthProbe.enabled",
+		false,
+		`NexusEndpointHealthProbeEnabled enables active health probing of external Nexus endpoints. When enabled, history
+hosts periodically send a probe request to every external endpoint they have dispatched to and feed the result into the
+endpoint's circuit breaker.`,
+	)
+	NexusEndpointHealthProbeInterval = NewGlobalDurationSetting(
+		"system.nexusEndpointHealthProbe.interval",
+		30*time.Second,
+		`NexusEndpointHealthProbeInterval is the interval between active health probes of a Nexus endpoint.`,
+	)
+	NexusEndpointHealthProbeTimeout = NewGlobalDurationSetting(
+		"system.nexusEndpointHealthProbe.timeout",
+		5*time.Second,
+		`NexusEndpointHealthProbeTimeout is the timeout of a single active health probe of a Nexus endpoint.`,
+	)
 	FrontendNexusRequestHeadersBlacklist = NewGlobalTypedSetting(
 		"frontend.nexusRequestHeadersBlacklist",
 		[]string(nil),
@@ -985,6 +1019,24 @@ to allow waiting on the "Accepted" lifecycle stage.`,
 		false,
 		`FrontendEnableWorkerVersioningRuleAPIs enables worker versioning in workflow progress APIs.`,
 	)
+	FrontendEnableTaskQueueScalingMetricsEndpoint = NewGlobalBoolSetting(
+		"frontend.enableTaskQueueScalingMetricsEndpoint",
+		false,
+		`FrontendEnableTaskQueueScalingMetricsEndpoint enables the HTTP endpoint that serves task queue scaling
+recommendations in the Kubernetes external metrics API format.`,
+	)
+	FrontendTaskQueueScalingBacklogDrainTarget = NewNamespaceDurationSetting(
+		"frontend.taskQueueScalingBacklogDrainTarget",
+		time.Minute,
+		`FrontendTaskQueueScalingBacklogDrainTarget is the time within which task queue scaling recommendations
+aim to drain the current backlog, on top of keeping up with the add rate.`,
+	)
+	FrontendTaskQueueScalingMaxRatio = NewNamespaceFloatSetting(
+		"frontend.taskQueueScalingMaxRatio",
+		10,
+		`FrontendTaskQueueScalingMaxRatio caps the scaling ratio returned by task queue scaling recommendations.
+It is also returned when there is a backlog but no tasks are being dispatched.`,
+	)
 
 	DeleteNamespaceDeleteActivityRPS = NewGlobalIntSetting(
 		"frontend.deleteNamespaceDeleteActivityRPS",
@@ -1074,6 +1126,12 @@ See DynamicRateLimitingParams comments for more details.`,
 		100,
 		`Reload a batch of tasks when there are this many remaining. Must be less than MatchingGetTasksBatchSize. (Requires new matcher.)`,
 	)
+	MatchingMaxDelayedBacklogTasks = NewTaskQueueIntSetting(
+		"matching.maxDelayedBacklogTasks",
+		10000,
+		`Maximum number of backlog tasks with a future not-before time that a task queue holds in memory. Delayed
+tasks past this limit are left in persistence and read again once there's room.`,
+	)
 	MatchingLongPollExpirationInterval = NewTaskQueueDurationSetting(
 		"matching.longPollExpirationInterval",
 		time.Minute,
@@ -1148,6 +1206,31 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		defaultNumTaskQueuePartitions,
 		`MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue`,
 	)
+	MatchingEnablePartitionAutoScaling = NewTaskQueueBoolSetting(
+		"matching.enablePartitionAutoScaling",
+		false,
+		`MatchingEnablePartitionAutoScaling enables scaling the partition count of a task queue up and down based on
+its add and dispatch rates. MatchingNumTaskqueueReadPartitions and MatchingNumTaskqueueWritePartitions become the
+upper bounds of the partition counts. The setting is read for the workflow task queue, which decides for all types.`,
+	)
+	MatchingPartitionAutoScalingInterval = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingInterval",
+		time.Minute,
+		`MatchingPartitionAutoScalingInterval is how often the root partition re-evaluates the partition counts when
+partition auto-scaling is enabled`,
+	)
+	MatchingPartitionAutoScalingTargetRate = NewTaskQueueFloatSetting(
+		"matching.partitionAutoScalingTargetRate",
+		100,
+		`MatchingPartitionAutoScalingTargetRate is the add or dispatch rate, in tasks per second, that partition
+auto-scaling aims to handle per write partition`,
+	)
+	MatchingPartitionAutoScalingScaleDownDelay = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingScaleDownDelay",
+		10*time.Minute,
+		`MatchingPartitionAutoScalingScaleDownDelay is the minimum time since the last partition count change before
+partition auto-scaling removes write partitions`,
+	)
 	MetricsBreakdownByTaskQueue = NewTaskQueueBoolSetting(
 		"metrics.breakdownByTaskQueue",
 		true,
@@ -1171,6 +1254,13 @@ contain the actual Build ID or a generic "__versioned__" value. Regardless of th
 queues will be "__unversioned__". Disable this option if the Build ID cardinality is too high for your
 observability stack. Disabling this option will disable all the per-Task Queue gauges such as backlog lag, count, and age
 for VERSIONED queues.`,
+	)
+	MetricsBreakdownByFairnessKey = NewTaskQueueTypedSetting(
+		"metrics.breakdownByFairnessKey",
+		[]string(nil),
+		`MetricsBreakdownByFairnessKey lists the fairness keys that get their own value in the 'fairness_key' tag of
+Matching metrics. Other non-empty keys are reported as a generic __omitted__ value. Fairness keys are set by
+clients, so only list keys whose cardinality your observability stack can handle.`,
 	)
 	MatchingForwarderMaxOutstandingPolls = NewTaskQueueIntSetting(
 		"matching.forwarderMaxOutstandingPolls",
@@ -1310,6 +1400,28 @@ second per poller by one physical queue manager`,
 		5,
 		`Number of simple priority levels (requires new matcher)`,
 	)
+	MatchingEnableFairness = NewTaskQueueBoolSetting(
+		"matching.enableFairness",
+		false,
+		`MatchingEnableFairness enables fairness-key scheduling (requires new matcher). The fairness key of a task comes
+from the temporal-fairness-key field of the header of the workflow start or activity schedule command; activities
+without one inherit the key of their workflow. Within a priority level, dispatch is interleaved across keys according
+to their weights.`,
+	)
+	MatchingFairnessKeyWeights = NewTaskQueueTypedSetting(
+		"matching.fairnessKeyWeights",
+		map[string]float64{},
+		`MatchingFairnessKeyWeights maps fairness keys to their dispatch weight. Keys that are not present, or that have
+a non-positive weight, have weight 1. Within a priority level, a key with weight 2 is dispatched twice as often as a
+key with weight 1 while both have backlog.`,
+	)
+	MatchingFairnessMaxKeys = NewTaskQueueIntSetting(
+		"matching.fairnessMaxKeys",
+		100,
+		`MatchingFairnessMaxKeys is the maximum number of distinct fairness keys that get their own backlog in a task
+queue partition. Backlog counts of additional keys are tracked under the empty key, but their tasks are still interleaved by
+key in memory.`,
+	)
 	MatchingBacklogTaskForwardTimeout = NewTaskQueueDurationSetting(
 		"matching.backlogTaskForwardTimeout",
 		60*time.Second,
@@ -2129,6 +2241,13 @@ When the this config is zero or lower we will only update shard info at most onc
 		retrypolicy.DefaultDefaultRetrySettings,
 		`DefaultActivityRetryPolicy represents the out-of-box retry policy for activities where
 the user has not specified an explicit RetryPolicy`,
+	)
+	ActivityRetryDispatchDelayMax = NewNamespaceDurationSetting(
+		"history.activityRetryDispatchDelayMax",
+		0,
+		`ActivityRetryDispatchDelayMax is the longest retry backoff for which an activity retry is dispatched
+to matching right away, with a not-before time at the end of the backoff, instead of waiting for a
+retry timer in history. Matching holds the task in its backlog until then. 0 disables this.`,
 	)
 	DefaultWorkflowRetryPolicy = NewNamespaceTypedSetting(
 		"history.defaultWorkflowRetryPolicy",
@@ -2446,6 +2565,40 @@ that task will be sent to DLQ.`,
 		false,
 		`SendRawHistoryBetweenInternalServices is whether to send raw history events between internal temporal services`,
 	)
+	MeteringEnabled = NewGlobalBoolSetting(
+		"history.meteringEnabled",
+		false,
+		`MeteringEnabled is whether the history service aggregates the resource usage of workflows (actions, history
+events, state transitions, history bytes and storage) and periodically writes it as usage records, which can be
+listed with the ListUsageRecords admin API. Usage is aggregated in memory until it is written, so usage of the last
+flush interval is lost if a history host crashes. Storage is charged for the whole retention of a workflow when it
+closes, running workflows aren't charged for storage.`,
+	)
+	MeteringFlushInterval = NewGlobalDurationSetting(
+		"history.meteringFlushInterval",
+		time.Minute,
+		`MeteringFlushInterval is how often aggregated usage is written as usage records. It is also the length of the
+metering window of each record.`,
+	)
+	MeteringRetention = NewGlobalDurationSetting(
+		"history.meteringRetention",
+		90*24*time.Hour,
+		`MeteringRetention is how long usage records are kept. Older records are periodically deleted by the history
+hosts writing them. Zero disables the deletion.`,
+	)
+	MeteringCostCenterSearchAttribute = NewNamespaceStringSetting(
+		"history.meteringCostCenterSearchAttribute",
+		"",
+		`MeteringCostCenterSearchAttribute is the name of a Keyword search attribute whose value on a workflow is used as
+the cost center its usage is attributed to. Usage of workflows without the search attribute, or of namespaces where
+this isn't set, is attributed to the empty cost center.`,
+	)
+	MeteringMaxCostCenters = NewGlobalIntSetting(
+		"history.meteringMaxCostCenters",
+		100,
+		`MeteringMaxCostCenters is the maximum number of distinct cost centers a history host aggregates usage by per
+namespace and flush interval. Usage of further cost centers is attributed to the "__other__" cost center.`,
+	)
 
 	// keys for worker
 
@@ -2690,6 +2843,24 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		false,
 		`When set to true, logs all RPC/request errors for the namespace, not just unexpected ones.`,
 	)
+	LogLevelOverrides = NewGlobalTypedSetting(
+		"system.logLevelOverrides",
+		[]log.LevelOverride(nil),
+		`LogLevelOverrides changes the log level and sampling of log entries by their tags without a restart, e.g.
+to enable debug logs for one namespace, workflow or shard during an incident. Each entry has "Tags", a map
+of log tag keys to values which all must be present on an entry (e.g. {"wf-namespace": "my-namespace"},
+{"wf-id": "my-workflow"} or {"shard-id": "42"}), an optional minimum "Level" for matching entries, and an
+optional "SampleRate" between 0 and 1 for the fraction of matching entries to log. The first matching entry
+applies. DPanic, Panic and Fatal entries are always logged.`,
+	)
+	TraceSamplingRate = NewNamespaceFloatSetting(
+		"system.traceSamplingRate",
+		1.0,
+		`TraceSamplingRate is the fraction of traces started in a namespace that are sampled when tracing is
+enabled. It applies to traces started by requests to the namespace and by the server, such as the processing of
+history tasks without a persisted trace context; spans with a parent follow the sampling decision of the parent.
+It has no effect when a sampler is configured with the OTEL_TRACES_SAMPLER environment variable.`,
+	)
 
 	ActivityAPIsEnabled = NewNamespaceBoolSetting(
 		"frontend.activityAPIsEnabled",
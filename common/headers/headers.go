@@ -42,6 +42,15 @@ const (
 	CallerNameHeaderName = "caller-name"
 	CallerTypeHeaderName = "caller-type"
 	CallOriginHeaderName = "call-initiation"
+
+	// Optional headers a worker can set on poll requests to report its host and task slot usage.
+	WorkerHostNameHeaderName       = "worker-host-name"
+	WorkerAvailableSlotsHeaderName = "worker-available-slots"
+	WorkerUsedSlotsHeaderName      = "worker-used-slots"
+
+	// Response headers set by matching to tell its clients the current partition counts of a task queue.
+	ReadPartitionsHeaderName  = "temporal-read-partitions"
+	WritePartitionsHeaderName = "temporal-write-partitions"
 )
 
 var (
@@ -24,6 +24,8 @@
 
 package log
 
+import "time"
+
 type (
 	// Config contains the config items for logger
 	Config struct {
@@ -41,5 +43,33 @@ type (
 		// Production mode.  Default is Production.  Production-stage disables panics from
 		// DPanic logging.
 		Development bool `yaml:"development"`
+		// OTLP, if set, additionally exports logs to an OpenTelemetry collector.
+		OTLP *OTLPConfig `yaml:"otlp"`
+	}
+
+	// OTLPConfig contains the config items for exporting logs with OTLP over gRPC
+	OTLPConfig struct {
+		// Endpoint is the host:port of the OTLP gRPC receiver of the collector
+		Endpoint string `yaml:"endpoint"`
+		// ConnectionName refers to a gRPC connection of the otel config by its name, so that logs are
+		// exported over the same connection as traces. Endpoint and Insecure are ignored if it is set.
+		ConnectionName string `yaml:"connectionName"`
+		// Insecure disables TLS for the connection to the collector
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent as gRPC metadata with every export request
+		Headers map[string]string `yaml:"headers"`
+		// Level is the minimum level of exported logs. Default is the level of the logger.
+		Level string `yaml:"level"`
+		// ResourceAttributes are attached to all exported logs. Default is service.name=temporal.
+		ResourceAttributes map[string]string `yaml:"resourceAttributes"`
+		// BatchSize is the maximum number of log records sent in one export request. Default is 512.
+		BatchSize int `yaml:"batchSize"`
+		// QueueSize is the maximum number of log records waiting to be exported. Log records are
+		// dropped while the queue is full. Default is 2048.
+		QueueSize int `yaml:"queueSize"`
+		// FlushInterval is the maximum time a log record waits in the queue. Default is 1s.
+		FlushInterval time.Duration `yaml:"flushInterval"`
+		// Timeout bounds a single export request. Default is 10s.
+		Timeout time.Duration `yaml:"timeout"`
 	}
 )
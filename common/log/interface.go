@@ -28,6 +28,7 @@ package log
 
 import (
 	"go.temporal.io/server/common/log/tag"
+	"go.uber.org/zap/zapcore"
 )
 
 type (
@@ -69,6 +70,12 @@ type (
 		Skip(extraSkip int) Logger
 	}
 
+	// If logger implements LevelLogger then WithLevel returns a logger which logs entries at or above the given
+	// level, regardless of the level the logger was configured with.
+	LevelLogger interface {
+		WithLevel(level zapcore.Level) Logger
+	}
+
 	// Special logger types for use with fx
 	SnTaggedLogger  Logger
 	ThrottledLogger Logger
@@ -38,6 +38,7 @@ import (
 
 	tag "go.temporal.io/server/common/log/tag"
 	gomock "go.uber.org/mock/gomock"
+	zapcore "go.uber.org/zap/zapcore"
 )
 
 // MockLogger is a mock of Logger interface.
@@ -262,3 +263,41 @@ func (mr *MockSkipLoggerMockRecorder) Skip(extraSkip any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Skip", reflect.TypeOf((*MockSkipLogger)(nil).Skip), extraSkip)
 }
+
+// MockLevelLogger is a mock of LevelLogger interface.
+type MockLevelLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockLevelLoggerMockRecorder
+	isgomock struct{}
+}
+
+// MockLevelLoggerMockRecorder is the mock recorder for MockLevelLogger.
+type MockLevelLoggerMockRecorder struct {
+	mock *MockLevelLogger
+}
+
+// NewMockLevelLogger creates a new mock instance.
+func NewMockLevelLogger(ctrl *gomock.Controller) *MockLevelLogger {
+	mock := &MockLevelLogger{ctrl: ctrl}
+	mock.recorder = &MockLevelLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLevelLogger) EXPECT() *MockLevelLoggerMockRecorder {
+	return m.recorder
+}
+
+// WithLevel mocks base method.
+func (m *MockLevelLogger) WithLevel(level zapcore.Level) Logger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithLevel", level)
+	ret0, _ := ret[0].(Logger)
+	return ret0
+}
+
+// WithLevel indicates an expected call of WithLevel.
+func (mr *MockLevelLoggerMockRecorder) WithLevel(level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithLevel", reflect.TypeOf((*MockLevelLogger)(nil).WithLevel), level)
+}
@@ -0,0 +1,298 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.uber.org/zap/zapcore"
+)
+
+const extraSkipForLevelOverrideLogger = 1
+
+type (
+	// LevelOverride changes the level and the sampling of log entries which have all the tags in Tags, either
+	// passed to the log call or added to the logger with With. Tag values are compared as strings, e.g.
+	// {"wf-namespace": "my-namespace"}, {"wf-id": "my-workflow-id"} or {"shard-id": "42"}.
+	LevelOverride struct {
+		Tags map[string]string
+		// Level is the minimum level of matching entries, e.g. "debug" to enable debug logs for one namespace
+		// or "error" to silence a noisy one. Empty keeps the level of the logger. DPanic, Panic and Fatal
+		// entries are never dropped.
+		Level string
+		// SampleRate is the fraction of matching entries which are logged, between 0 and 1. Zero logs all
+		// matching entries. DPanic, Panic and Fatal entries are never sampled.
+		SampleRate float64
+	}
+
+	// LevelOverrides holds the LevelOverride rules of a logger created by NewLevelOverrideLogger and all
+	// loggers derived from it. The rules can be updated at any time.
+	LevelOverrides struct {
+		rules atomic.Pointer[[]levelOverrideRule]
+	}
+
+	levelOverrideRule struct {
+		tags       map[string]string
+		level      zapcore.Level
+		hasLevel   bool
+		sampleRate float64
+	}
+
+	levelOverrideLogger struct {
+		logger    Logger
+		overrides *LevelOverrides
+		// tags added with With, which are matched against the rules along with the tags of each entry
+		tags []tag.Tag
+		// leveled caches the loggers returned by WithLevel of logger, it is allocated on first use
+		leveled atomic.Pointer[leveledLoggers]
+	}
+
+	// leveledLoggers holds a logger for each level from debug to fatal
+	leveledLoggers [zapcore.FatalLevel - zapcore.DebugLevel + 1]atomic.Pointer[Logger]
+)
+
+var _ Logger = (*levelOverrideLogger)(nil)
+var _ WithLogger = (*levelOverrideLogger)(nil)
+var _ SkipLogger = (*levelOverrideLogger)(nil)
+
+// NewLevelOverrides returns LevelOverrides without any rules
+func NewLevelOverrides() *LevelOverrides {
+	return &LevelOverrides{}
+}
+
+// Update replaces the current rules. Invalid rules are skipped and reported in the returned error.
+func (o *LevelOverrides) Update(overrides []LevelOverride) error {
+	var errs []error
+	rules := make([]levelOverrideRule, 0, len(overrides))
+	for _, override := range overrides {
+		rule := levelOverrideRule{
+			tags:       override.Tags,
+			sampleRate: override.SampleRate,
+		}
+		if len(override.Level) > 0 {
+			level, err := zapcore.ParseLevel(override.Level)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("log level override for tags %v: %w", override.Tags, err))
+				continue
+			}
+			rule.level = level
+			rule.hasLevel = true
+		}
+		if override.SampleRate < 0 || override.SampleRate > 1 {
+			errs = append(errs, fmt.Errorf("log level override for tags %v: sample rate %v is not between 0 and 1",
+				override.Tags, override.SampleRate))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	o.rules.Store(&rules)
+	return errors.Join(errs...)
+}
+
+func (o *LevelOverrides) load() []levelOverrideRule {
+	if rules := o.rules.Load(); rules != nil {
+		return *rules
+	}
+	return nil
+}
+
+// NewLevelOverrideLogger returns a logger which applies the first matching rule of overrides to each log
+// entry. Entries which don't match any rule are passed to logger unchanged. Lowering the level below the
+// level of logger requires logger to implement LevelLogger.
+func NewLevelOverrideLogger(logger Logger, overrides *LevelOverrides) Logger {
+	if sl, ok := logger.(SkipLogger); ok {
+		logger = sl.Skip(extraSkipForLevelOverrideLogger)
+	}
+	return &levelOverrideLogger{
+		logger:    logger,
+		overrides: overrides,
+	}
+}
+
+func (l *levelOverrideLogger) Debug(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.DebugLevel, tags); logger != nil {
+		logger.Debug(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) Info(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.InfoLevel, tags); logger != nil {
+		logger.Info(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) Warn(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.WarnLevel, tags); logger != nil {
+		logger.Warn(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) Error(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.ErrorLevel, tags); logger != nil {
+		logger.Error(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) DPanic(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.DPanicLevel, tags); logger != nil {
+		logger.DPanic(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) Panic(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.PanicLevel, tags); logger != nil {
+		logger.Panic(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) Fatal(msg string, tags ...tag.Tag) {
+	if logger := l.loggerFor(zapcore.FatalLevel, tags); logger != nil {
+		logger.Fatal(msg, tags...)
+	}
+}
+
+func (l *levelOverrideLogger) With(tags ...tag.Tag) Logger {
+	allTags := make([]tag.Tag, len(l.tags)+len(tags))
+	copy(allTags, l.tags)
+	copy(allTags[len(l.tags):], tags)
+	return &levelOverrideLogger{
+		logger:    With(l.logger, tags...),
+		overrides: l.overrides,
+		tags:      allTags,
+	}
+}
+
+func (l *levelOverrideLogger) Skip(extraSkip int) Logger {
+	sl, ok := l.logger.(SkipLogger)
+	if !ok {
+		return l
+	}
+	return &levelOverrideLogger{
+		logger:    sl.Skip(extraSkip),
+		overrides: l.overrides,
+		tags:      l.tags,
+	}
+}
+
+// loggerFor returns the logger to log an entry of the given level and tags with, or nil if the entry
+// must be dropped
+func (l *levelOverrideLogger) loggerFor(level zapcore.Level, tags []tag.Tag) Logger {
+	rules := l.overrides.load()
+	if len(rules) == 0 || level >= zapcore.DPanicLevel {
+		return l.logger
+	}
+	rule := l.match(rules, tags)
+	if rule == nil {
+		return l.logger
+	}
+	if rule.sampleRate > 0 && rand.Float64() >= rule.sampleRate {
+		return nil
+	}
+	if !rule.hasLevel {
+		return l.logger
+	}
+	if level < rule.level {
+		return nil
+	}
+	return l.withLevel(rule.level)
+}
+
+// withLevel returns the logger with the level, creating it only once per level
+func (l *levelOverrideLogger) withLevel(level zapcore.Level) Logger {
+	ll, ok := l.logger.(LevelLogger)
+	if !ok {
+		return l.logger
+	}
+	leveled := l.leveled.Load()
+	if leveled == nil {
+		leveled = &leveledLoggers{}
+		if !l.leveled.CompareAndSwap(nil, leveled) {
+			leveled = l.leveled.Load()
+		}
+	}
+	cached := &leveled[level-zapcore.DebugLevel]
+	if logger := cached.Load(); logger != nil {
+		return *logger
+	}
+	logger := ll.WithLevel(level)
+	cached.Store(&logger)
+	return logger
+}
+
+func (l *levelOverrideLogger) match(rules []levelOverrideRule, tags []tag.Tag) *levelOverrideRule {
+	for i := range rules {
+		if l.matches(&rules[i], tags) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func (l *levelOverrideLogger) matches(rule *levelOverrideRule, tags []tag.Tag) bool {
+	for key, value := range rule.tags {
+		if !hasTag(l.tags, key, value) && !hasTag(tags, key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasTag(tags []tag.Tag, key string, value string) bool {
+	for _, t := range tags {
+		if t.Key() == key && tagValueString(t) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func tagValueString(t tag.Tag) string {
+	zt, ok := t.(tag.ZapTag)
+	if !ok {
+		return fmt.Sprint(t.Value())
+	}
+	// avoid ZapTag.Value, which encodes the field, for the common tag types
+	field := zt.Field()
+	switch field.Type {
+	case zapcore.StringType:
+		return field.String
+	case zapcore.Int64Type, zapcore.Int32Type, zapcore.Int16Type, zapcore.Int8Type:
+		return strconv.FormatInt(field.Integer, 10)
+	case zapcore.Uint64Type, zapcore.Uint32Type, zapcore.Uint16Type, zapcore.Uint8Type:
+		return strconv.FormatUint(uint64(field.Integer), 10)
+	case zapcore.BoolType:
+		return strconv.FormatBool(field.Integer == 1)
+	case zapcore.StringerType:
+		if s, ok := field.Interface.(fmt.Stringer); ok {
+			return s.String()
+		}
+	}
+	return fmt.Sprint(zt.Value())
+}
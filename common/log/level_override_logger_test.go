@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log/tag"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedLevelOverrideLogger(overrides []LevelOverride) (Logger, *observer.ObservedLogs, *LevelOverrides) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	levelOverrides := NewLevelOverrides()
+	if err := levelOverrides.Update(overrides); err != nil {
+		panic(err)
+	}
+	return NewLevelOverrideLogger(NewZapLogger(zap.New(core)), levelOverrides), logs, levelOverrides
+}
+
+func TestLevelOverrideLogger_NoRules(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger(nil)
+
+	logger.Debug("debug", tag.WorkflowNamespace("ns"))
+	logger.Info("info", tag.WorkflowNamespace("ns"))
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, "info", logs.All()[0].Message)
+}
+
+func TestLevelOverrideLogger_LowerLevel(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-namespace": "ns", "shard-id": "7"}, Level: "debug"},
+	})
+
+	logger.Debug("other namespace", tag.WorkflowNamespace("other"), tag.ShardID(7))
+	logger.Debug("other shard", tag.WorkflowNamespace("ns"), tag.ShardID(8))
+	logger.Debug("call tags", tag.WorkflowNamespace("ns"), tag.ShardID(7))
+	With(logger, tag.ShardID(7)).Debug("with and call tags", tag.WorkflowNamespace("ns"))
+	With(With(logger, tag.WorkflowNamespace("ns")), tag.ShardID(7)).Debug("with tags")
+
+	var messages []string
+	for _, entry := range logs.All() {
+		require.Equal(t, zapcore.DebugLevel, entry.Level)
+		messages = append(messages, entry.Message)
+	}
+	require.Equal(t, []string{"call tags", "with and call tags", "with tags"}, messages)
+}
+
+func TestLevelOverrideLogger_RaiseLevel(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-id": "noisy"}, Level: "error"},
+	})
+
+	logger.Warn("warn", tag.WorkflowID("noisy"))
+	logger.Error("error", tag.WorkflowID("noisy"))
+	logger.Warn("other", tag.WorkflowID("quiet"))
+	require.Equal(t, 2, logs.Len())
+	require.Equal(t, "error", logs.All()[0].Message)
+	require.Equal(t, "other", logs.All()[1].Message)
+}
+
+func TestLevelOverrideLogger_NeverDropsPanicOrFatal(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-id": "noisy"}, Level: "fatal", SampleRate: 0.0001},
+	})
+
+	logger.Error("error", tag.WorkflowID("noisy"))
+	logger.DPanic("dpanic", tag.WorkflowID("noisy"))
+	require.Panics(t, func() { logger.Panic("panic", tag.WorkflowID("noisy")) })
+	require.Equal(t, 2, logs.Len())
+	require.Equal(t, "dpanic", logs.All()[0].Message)
+	require.Equal(t, "panic", logs.All()[1].Message)
+}
+
+func TestLevelOverrideLogger_CachesLeveledLogger(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-namespace": "ns"}, Level: "debug"},
+	})
+	l := With(logger, tag.WorkflowNamespace("ns")).(*levelOverrideLogger)
+
+	first := l.loggerFor(zapcore.DebugLevel, nil)
+	require.Same(t, first, l.loggerFor(zapcore.InfoLevel, nil))
+	l.Debug("debug")
+	require.Equal(t, 1, logs.Len())
+}
+
+func TestLevelOverrideLogger_FirstMatchingRuleApplies(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-id": "wf"}, Level: "warn"},
+		{Tags: map[string]string{"wf-namespace": "ns"}, Level: "debug"},
+	})
+
+	logger.Info("info", tag.WorkflowNamespace("ns"), tag.WorkflowID("wf"))
+	logger.Debug("debug", tag.WorkflowNamespace("ns"), tag.WorkflowID("other"))
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, "debug", logs.All()[0].Message)
+}
+
+func TestLevelOverrideLogger_Sampling(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-namespace": "ns"}, SampleRate: 0.1},
+	})
+
+	for i := 0; i < 1000; i++ {
+		logger.Info("sampled", tag.WorkflowNamespace("ns"))
+	}
+	require.Greater(t, logs.Len(), 20)
+	require.Less(t, logs.Len(), 300)
+
+	logs.TakeAll()
+	for i := 0; i < 100; i++ {
+		logger.Info("not sampled", tag.WorkflowNamespace("other"))
+	}
+	require.Equal(t, 100, logs.Len())
+}
+
+func TestLevelOverrideLogger_Update(t *testing.T) {
+	logger, logs, overrides := newObservedLevelOverrideLogger(nil)
+	namespaceLogger := With(logger, tag.WorkflowNamespace("ns"))
+
+	namespaceLogger.Debug("before")
+	require.NoError(t, overrides.Update([]LevelOverride{{Tags: map[string]string{"wf-namespace": "ns"}, Level: "debug"}}))
+	namespaceLogger.Debug("after")
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, "after", logs.All()[0].Message)
+
+	err := overrides.Update([]LevelOverride{
+		{Tags: map[string]string{"wf-namespace": "ns"}, Level: "verbose"},
+		{Tags: map[string]string{"wf-namespace": "ns"}, SampleRate: 2},
+		{Tags: map[string]string{"shard-id": "1"}, Level: "debug"},
+	})
+	require.ErrorContains(t, err, "verbose")
+	require.ErrorContains(t, err, "sample rate")
+	require.Len(t, overrides.load(), 1)
+}
+
+func TestLevelOverrideLogger_CallerSkip(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-namespace": "ns"}, Level: "debug"},
+	})
+
+	logger.Info("passed through")
+	logger.Debug("overridden", tag.WorkflowNamespace("ns"))
+	require.Equal(t, 2, logs.Len())
+	for _, entry := range logs.All() {
+		require.Contains(t, entry.ContextMap()[tag.LoggingCallAtKey], "level_override_logger_test.go")
+	}
+}
+
+func TestLevelOverrideLogger_Skip(t *testing.T) {
+	logger, logs, _ := newObservedLevelOverrideLogger([]LevelOverride{
+		{Tags: map[string]string{"wf-namespace": "ns"}, Level: "debug"},
+	})
+	sl, ok := logger.(SkipLogger)
+	require.True(t, ok)
+	logger = With(sl.Skip(1), tag.WorkflowNamespace("ns"))
+
+	// the entry is attributed to the caller of the helper
+	helper := func() int {
+		_, _, line, _ := runtime.Caller(1)
+		logger.Debug("overridden")
+		return line
+	}
+	line := helper()
+	require.Equal(t, 1, logs.Len())
+	require.True(t, strings.HasSuffix(
+		logs.All()[0].ContextMap()[tag.LoggingCallAtKey].(string),
+		fmt.Sprintf("level_override_logger_test.go:%d", line),
+	))
+}
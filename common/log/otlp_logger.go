@@ -0,0 +1,518 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	otlpcollogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	otlpcommonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	otlplogspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	otlpresourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	otlpDefaultBatchSize     = 512
+	otlpDefaultQueueSize     = 2048
+	otlpDefaultFlushInterval = time.Second
+	otlpDefaultTimeout       = 10 * time.Second
+	otlpDefaultServiceName   = "temporal"
+	otlpScopeName            = "go.temporal.io/server"
+
+	// export failures are reported to stderr at most once per interval, since the logger itself
+	// can't be used to report them
+	otlpErrorReportInterval = time.Minute
+)
+
+type (
+	// OTLPShutdown exports the queued log records and stops the OTLP log exporter of a logger built
+	// by BuildZapLoggerWithOTLP. It is a no-op for loggers without an OTLP log exporter.
+	OTLPShutdown func()
+
+	// OTLPDialer returns the shared gRPC connection with the given name, see OTLPConfig.ConnectionName
+	OTLPDialer func(name string) (*grpc.ClientConn, error)
+
+	// otlpCore is a zapcore.Core which converts entries to OTLP log records and hands them to the
+	// exporter. Trace and span IDs added with tag.TraceContext become the trace context of the record.
+	// Fields added with With are only encoded when an entry is written, since most loggers are
+	// derived for a request and never write an entry at the exported level.
+	otlpCore struct {
+		zapcore.LevelEnabler
+		exporter *otlpExporter
+		fields   []zapcore.Field
+	}
+
+	otlpAttributes struct {
+		values  []*otlpcommonpb.KeyValue
+		traceID []byte
+		spanID  []byte
+	}
+
+	// otlpExporter batches log records from a bounded queue and exports them with the OTLP logs
+	// service. Records are dropped while the queue is full or when an export fails, so logging never
+	// blocks on the collector.
+	otlpExporter struct {
+		client        otlpcollogspb.LogsServiceClient
+		headers       metadata.MD
+		resource      *otlpresourcepb.Resource
+		batchSize     int
+		flushInterval time.Duration
+		timeout       time.Duration
+		errorOutput   zapcore.WriteSyncer
+		// conn is closed when the exporter stops, it is nil if the connection is shared
+		conn io.Closer
+
+		queue    chan *otlplogspb.LogRecord
+		flushCh  chan chan struct{}
+		stopCh   chan struct{}
+		doneCh   chan struct{}
+		stopOnce sync.Once
+		dropped  atomic.Int64
+
+		// only accessed by the export loop
+		lastError      error
+		lastReportTime time.Time
+	}
+)
+
+var _ zapcore.Core = (*otlpCore)(nil)
+
+func buildOTLPCore(cfg Config, dial OTLPDialer) (*otlpCore, error) {
+	var conn *grpc.ClientConn
+	var ownConn io.Closer
+	switch {
+	case cfg.OTLP.ConnectionName != "":
+		if dial == nil {
+			return nil, fmt.Errorf("OTLP log exporter connection %q is not available", cfg.OTLP.ConnectionName)
+		}
+		var err error
+		if conn, err = dial(cfg.OTLP.ConnectionName); err != nil {
+			return nil, err
+		}
+	case cfg.OTLP.Endpoint != "":
+		creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		if cfg.OTLP.Insecure {
+			creds = insecure.NewCredentials()
+		}
+		var err error
+		if conn, err = grpc.NewClient(cfg.OTLP.Endpoint, grpc.WithTransportCredentials(creds)); err != nil {
+			return nil, err
+		}
+		ownConn = conn
+	default:
+		return nil, errors.New("OTLP log exporter endpoint is not set")
+	}
+	level := cfg.Level
+	if cfg.OTLP.Level != "" {
+		level = cfg.OTLP.Level
+	}
+	exporter := newOTLPExporter(otlpcollogspb.NewLogsServiceClient(conn), *cfg.OTLP, zapcore.Lock(os.Stderr))
+	exporter.conn = ownConn
+	exporter.start()
+	return newOTLPCore(parseZapLevel(level), exporter), nil
+}
+
+func newOTLPCore(level zapcore.LevelEnabler, exporter *otlpExporter) *otlpCore {
+	return &otlpCore{
+		LevelEnabler: level,
+		exporter:     exporter,
+	}
+}
+
+func (c *otlpCore) With(fields []zapcore.Field) zapcore.Core {
+	if len(fields) == 0 {
+		return c
+	}
+	clone := *c
+	clone.fields = append(c.fields[:len(c.fields):len(c.fields)], fields...)
+	return &clone
+}
+
+func (c *otlpCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *otlpCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	attrs := newOTLPAttributes(c.fields, fields)
+	if entry.LoggerName != "" {
+		attrs.values = append(attrs.values, otlpKeyValue("logger", entry.LoggerName))
+	}
+	if entry.Stack != "" {
+		attrs.values = append(attrs.values, otlpKeyValue("stacktrace", entry.Stack))
+	}
+	c.exporter.enqueue(&otlplogspb.LogRecord{
+		TimeUnixNano:         uint64(entry.Time.UnixNano()),
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       otlpSeverity(entry.Level),
+		SeverityText:         entry.Level.CapitalString(),
+		Body:                 otlpValue(entry.Message),
+		Attributes:           attrs.values,
+		TraceId:              attrs.traceID,
+		SpanId:               attrs.spanID,
+	})
+	// same as zap's own cores: make sure the entry is out before a panic or exit
+	if entry.Level > zapcore.ErrorLevel {
+		c.exporter.flush()
+	}
+	return nil
+}
+
+func (c *otlpCore) Sync() error {
+	c.exporter.flush()
+	return nil
+}
+
+// newOTLPAttributes encodes the fields of the core and of the entry. The trace-id and span-id fields
+// set the trace context instead of being added as attributes.
+func newOTLPAttributes(coreFields []zapcore.Field, entryFields []zapcore.Field) otlpAttributes {
+	result := otlpAttributes{
+		values: make([]*otlpcommonpb.KeyValue, 0, len(coreFields)+len(entryFields)),
+	}
+	result.add(coreFields)
+	result.add(entryFields)
+	return result
+}
+
+func (a *otlpAttributes) add(fields []zapcore.Field) {
+	for _, field := range fields {
+		enc := zapcore.NewMapObjectEncoder()
+		field.AddTo(enc)
+		keys := make([]string, 0, len(enc.Fields))
+		for key := range enc.Fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := enc.Fields[key]
+			switch key {
+			case tag.TraceIDKey:
+				if id, ok := decodeOTLPID(value, 16); ok {
+					a.traceID = id
+					continue
+				}
+			case tag.SpanIDKey:
+				if id, ok := decodeOTLPID(value, 8); ok {
+					a.spanID = id
+					continue
+				}
+			}
+			a.values = append(a.values, otlpKeyValue(key, value))
+		}
+	}
+}
+
+func decodeOTLPID(value interface{}, size int) ([]byte, bool) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, false
+	}
+	id, err := hex.DecodeString(s)
+	if err != nil || len(id) != size {
+		return nil, false
+	}
+	return id, true
+}
+
+func otlpKeyValue(key string, value interface{}) *otlpcommonpb.KeyValue {
+	return &otlpcommonpb.KeyValue{Key: key, Value: otlpValue(value)}
+}
+
+// otlpValue converts values produced by zapcore.MapObjectEncoder.
+func otlpValue(value interface{}) *otlpcommonpb.AnyValue {
+	switch v := value.(type) {
+	case nil:
+		return &otlpcommonpb.AnyValue{}
+	case string:
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return otlpIntValue(int64(v))
+	case int8:
+		return otlpIntValue(int64(v))
+	case int16:
+		return otlpIntValue(int64(v))
+	case int32:
+		return otlpIntValue(int64(v))
+	case int64:
+		return otlpIntValue(v)
+	case uint:
+		return otlpIntValue(int64(v))
+	case uint8:
+		return otlpIntValue(int64(v))
+	case uint16:
+		return otlpIntValue(int64(v))
+	case uint32:
+		return otlpIntValue(int64(v))
+	case uint64:
+		return otlpIntValue(int64(v))
+	case uintptr:
+		return otlpIntValue(int64(v))
+	case float32:
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_DoubleValue{DoubleValue: float64(v)}}
+	case float64:
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case []byte:
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_BytesValue{BytesValue: v}}
+	case time.Time:
+		return otlpValue(v.Format(time.RFC3339Nano))
+	case time.Duration:
+		return otlpValue(v.String())
+	case []interface{}:
+		values := make([]*otlpcommonpb.AnyValue, len(v))
+		for i, elem := range v {
+			values[i] = otlpValue(elem)
+		}
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_ArrayValue{ArrayValue: &otlpcommonpb.ArrayValue{Values: values}}}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		values := make([]*otlpcommonpb.KeyValue, len(keys))
+		for i, key := range keys {
+			values[i] = otlpKeyValue(key, v[key])
+		}
+		return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_KvlistValue{KvlistValue: &otlpcommonpb.KeyValueList{Values: values}}}
+	case error:
+		return otlpValue(v.Error())
+	case fmt.Stringer:
+		return otlpValue(v.String())
+	default:
+		return otlpValue(fmt.Sprint(v))
+	}
+}
+
+func otlpIntValue(v int64) *otlpcommonpb.AnyValue {
+	return &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_IntValue{IntValue: v}}
+}
+
+func otlpSeverity(level zapcore.Level) otlplogspb.SeverityNumber {
+	switch level {
+	case zapcore.DebugLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case zapcore.InfoLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case zapcore.WarnLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case zapcore.ErrorLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case zapcore.DPanicLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	case zapcore.PanicLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_FATAL2
+	case zapcore.FatalLevel:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_FATAL3
+	default:
+		return otlplogspb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+	}
+}
+
+func newOTLPExporter(
+	client otlpcollogspb.LogsServiceClient,
+	cfg OTLPConfig,
+	errorOutput zapcore.WriteSyncer,
+) *otlpExporter {
+	resourceAttributes := map[string]string{"service.name": otlpDefaultServiceName}
+	for key, value := range cfg.ResourceAttributes {
+		resourceAttributes[key] = value
+	}
+	resource := &otlpresourcepb.Resource{}
+	for key, value := range resourceAttributes {
+		resource.Attributes = append(resource.Attributes, otlpKeyValue(key, value))
+	}
+	sort.Slice(resource.Attributes, func(i, j int) bool {
+		return resource.Attributes[i].Key < resource.Attributes[j].Key
+	})
+
+	batchSize := otlpDefaultBatchSize
+	if cfg.BatchSize > 0 {
+		batchSize = cfg.BatchSize
+	}
+	queueSize := otlpDefaultQueueSize
+	if cfg.QueueSize > 0 {
+		queueSize = cfg.QueueSize
+	}
+	flushInterval := otlpDefaultFlushInterval
+	if cfg.FlushInterval > 0 {
+		flushInterval = cfg.FlushInterval
+	}
+	timeout := otlpDefaultTimeout
+	if cfg.Timeout > 0 {
+		timeout = cfg.Timeout
+	}
+	return &otlpExporter{
+		client:        client,
+		headers:       metadata.New(cfg.Headers),
+		resource:      resource,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		timeout:       timeout,
+		errorOutput:   errorOutput,
+		queue:         make(chan *otlplogspb.LogRecord, queueSize),
+		flushCh:       make(chan chan struct{}),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+func (e *otlpExporter) start() {
+	go e.exportLoop()
+}
+
+// stop exports the queued records, stops the export loop and closes the connection unless it is shared.
+func (e *otlpExporter) stop() {
+	e.stopOnce.Do(func() {
+		close(e.stopCh)
+		<-e.doneCh
+		if e.conn != nil {
+			_ = e.conn.Close()
+		}
+	})
+}
+
+func (e *otlpExporter) enqueue(record *otlplogspb.LogRecord) {
+	select {
+	case e.queue <- record:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// flush blocks until the records queued before the call are exported.
+func (e *otlpExporter) flush() {
+	ack := make(chan struct{})
+	select {
+	case e.flushCh <- ack:
+	case <-e.doneCh:
+		return
+	}
+	select {
+	case <-ack:
+	case <-e.doneCh:
+	}
+}
+
+func (e *otlpExporter) exportLoop() {
+	defer close(e.doneCh)
+
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*otlplogspb.LogRecord, 0, e.batchSize)
+	for {
+		select {
+		case record := <-e.queue:
+			batch = append(batch, record)
+			if len(batch) >= e.batchSize {
+				batch = e.export(batch)
+			}
+		case <-ticker.C:
+			batch = e.export(batch)
+		case ack := <-e.flushCh:
+			batch = e.drain(batch)
+			close(ack)
+		case <-e.stopCh:
+			e.drain(batch)
+			return
+		}
+	}
+}
+
+// drain exports the batch and all records currently in the queue.
+func (e *otlpExporter) drain(batch []*otlplogspb.LogRecord) []*otlplogspb.LogRecord {
+	for {
+		select {
+		case record := <-e.queue:
+			batch = append(batch, record)
+			if len(batch) >= e.batchSize {
+				batch = e.export(batch)
+			}
+		default:
+			return e.export(batch)
+		}
+	}
+}
+
+// export sends the batch and returns a new empty batch.
+func (e *otlpExporter) export(batch []*otlplogspb.LogRecord) []*otlplogspb.LogRecord {
+	if len(batch) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+		ctx = metadata.NewOutgoingContext(ctx, e.headers)
+		_, err := e.client.Export(ctx, &otlpcollogspb.ExportLogsServiceRequest{
+			ResourceLogs: []*otlplogspb.ResourceLogs{{
+				Resource: e.resource,
+				ScopeLogs: []*otlplogspb.ScopeLogs{{
+					Scope:      &otlpcommonpb.InstrumentationScope{Name: otlpScopeName},
+					LogRecords: batch,
+				}},
+			}},
+		})
+		cancel()
+		if err != nil {
+			e.lastError = err
+			e.dropped.Add(int64(len(batch)))
+		}
+		// the request may be retained by the client, so the batch isn't reused
+		batch = make([]*otlplogspb.LogRecord, 0, e.batchSize)
+	}
+	e.reportErrors()
+	return batch
+}
+
+func (e *otlpExporter) reportErrors() {
+	if e.dropped.Load() == 0 || time.Since(e.lastReportTime) < otlpErrorReportInterval {
+		return
+	}
+	e.lastReportTime = time.Now()
+	dropped := e.dropped.Swap(0)
+	if e.lastError != nil {
+		fmt.Fprintf(e.errorOutput, "%v OTLP log export error: %v, dropped %d log records\n", e.lastReportTime, e.lastError, dropped)
+		e.lastError = nil
+	} else {
+		fmt.Fprintf(e.errorOutput, "%v OTLP log export queue is full, dropped %d log records\n", e.lastReportTime, dropped)
+	}
+	_ = e.errorOutput.Sync()
+}
@@ -0,0 +1,313 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	otlpcollogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	otlplogspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeLogsService struct {
+	otlpcollogspb.UnimplementedLogsServiceServer
+
+	mu       sync.Mutex
+	err      error
+	requests []*otlpcollogspb.ExportLogsServiceRequest
+	headers  []metadata.MD
+}
+
+func (s *fakeLogsService) Export(
+	ctx context.Context,
+	request *otlpcollogspb.ExportLogsServiceRequest,
+	_ ...grpc.CallOption,
+) (*otlpcollogspb.ExportLogsServiceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if incoming, ok := metadata.FromIncomingContext(ctx); ok {
+		md = incoming
+	}
+	s.requests = append(s.requests, request)
+	s.headers = append(s.headers, md)
+	return &otlpcollogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (s *fakeLogsService) records() []*otlplogspb.LogRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var records []*otlplogspb.LogRecord
+	for _, request := range s.requests {
+		for _, resourceLogs := range request.ResourceLogs {
+			for _, scopeLogs := range resourceLogs.ScopeLogs {
+				records = append(records, scopeLogs.LogRecords...)
+			}
+		}
+	}
+	return records
+}
+
+func (s *fakeLogsService) batchSizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sizes []int
+	for _, request := range s.requests {
+		sizes = append(sizes, len(request.ResourceLogs[0].ScopeLogs[0].LogRecords))
+	}
+	return sizes
+}
+
+type grpcLogsService struct {
+	otlpcollogspb.UnimplementedLogsServiceServer
+	fake *fakeLogsService
+}
+
+func (s grpcLogsService) Export(
+	ctx context.Context,
+	request *otlpcollogspb.ExportLogsServiceRequest,
+) (*otlpcollogspb.ExportLogsServiceResponse, error) {
+	return s.fake.Export(ctx, request)
+}
+
+func newTestOTLPExporter(service *fakeLogsService, cfg OTLPConfig) (*otlpExporter, *bytes.Buffer) {
+	var errorOutput bytes.Buffer
+	return newOTLPExporter(service, cfg, zapcore.AddSync(&errorOutput)), &errorOutput
+}
+
+func TestOTLPCore_Record(t *testing.T) {
+	service := &fakeLogsService{}
+	exporter, _ := newTestOTLPExporter(service, OTLPConfig{
+		ResourceAttributes: map[string]string{"deployment.environment": "test"},
+	})
+	exporter.start()
+	defer exporter.stop()
+	zl := zap.New(newOTLPCore(zapcore.InfoLevel, exporter))
+	var logger Logger = NewZapLogger(zl)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	logger = With(logger, tag.WorkflowNamespace("ns"))
+	logger.Debug("dropped by level")
+	logger.Warn("hello", tag.ShardID(7), tag.Error(errors.New("boom")), tag.TraceContext(ctx))
+	logger.Info("no span", tag.TraceContext(context.Background()))
+	require.NoError(t, zl.Sync())
+
+	records := service.records()
+	require.Len(t, records, 2)
+
+	record := records[0]
+	require.Equal(t, otlplogspb.SeverityNumber_SEVERITY_NUMBER_WARN, record.SeverityNumber)
+	require.Equal(t, "WARN", record.SeverityText)
+	require.Equal(t, "hello", record.Body.GetStringValue())
+	require.Equal(t, spanContext.TraceID().String(), trace.TraceID(record.TraceId).String())
+	require.Equal(t, spanContext.SpanID().String(), trace.SpanID(record.SpanId).String())
+	require.NotZero(t, record.TimeUnixNano)
+
+	attributes := make(map[string]interface{})
+	for _, kv := range record.Attributes {
+		switch {
+		case kv.Value.GetStringValue() != "":
+			attributes[kv.Key] = kv.Value.GetStringValue()
+		default:
+			attributes[kv.Key] = kv.Value.GetIntValue()
+		}
+	}
+	require.Equal(t, "ns", attributes["wf-namespace"])
+	require.Equal(t, int64(7), attributes["shard-id"])
+	require.Equal(t, "boom", attributes["error"])
+	require.Contains(t, attributes, tag.LoggingCallAtKey)
+	require.NotContains(t, attributes, tag.TraceIDKey)
+	require.NotContains(t, attributes, tag.SpanIDKey)
+
+	require.Equal(t, "no span", records[1].Body.GetStringValue())
+	require.Empty(t, records[1].TraceId)
+	require.Empty(t, records[1].SpanId)
+
+	resource := service.requests[0].ResourceLogs[0].Resource
+	require.Len(t, resource.Attributes, 2)
+	require.Equal(t, "deployment.environment", resource.Attributes[0].Key)
+	require.Equal(t, "service.name", resource.Attributes[1].Key)
+	require.Equal(t, "temporal", resource.Attributes[1].Value.GetStringValue())
+}
+
+type countingMarshaler struct {
+	count *int
+}
+
+func (m countingMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	*m.count++
+	enc.AddString("key", "value")
+	return nil
+}
+
+func TestOTLPCore_WithEncodesLazily(t *testing.T) {
+	service := &fakeLogsService{}
+	exporter, _ := newTestOTLPExporter(service, OTLPConfig{})
+	exporter.start()
+	defer exporter.stop()
+	zl := zap.New(newOTLPCore(zapcore.InfoLevel, exporter))
+
+	var count int
+	logger := zl.With(zap.Object("object", countingMarshaler{count: &count}))
+	logger.Debug("not exported")
+	require.Zero(t, count)
+
+	logger.With(zap.Int("n", 1)).Info("exported")
+	require.Equal(t, 1, count)
+	require.NoError(t, zl.Sync())
+	records := service.records()
+	require.Len(t, records, 1)
+	require.Len(t, records[0].Attributes, 2)
+	require.Equal(t, "object", records[0].Attributes[0].Key)
+	require.Equal(t, "n", records[0].Attributes[1].Key)
+}
+
+func TestOTLPExporter_Batching(t *testing.T) {
+	service := &fakeLogsService{}
+	exporter, _ := newTestOTLPExporter(service, OTLPConfig{BatchSize: 2})
+	for i := 0; i < 5; i++ {
+		exporter.enqueue(&otlplogspb.LogRecord{})
+	}
+	exporter.start()
+	exporter.stop()
+
+	require.Equal(t, []int{2, 2, 1}, service.batchSizes())
+}
+
+func TestOTLPExporter_QueueFull(t *testing.T) {
+	service := &fakeLogsService{}
+	exporter, errorOutput := newTestOTLPExporter(service, OTLPConfig{QueueSize: 2})
+	for i := 0; i < 3; i++ {
+		exporter.enqueue(&otlplogspb.LogRecord{})
+	}
+	exporter.start()
+	exporter.stop()
+
+	require.Len(t, service.records(), 2)
+	require.Contains(t, errorOutput.String(), "queue is full, dropped 1 log records")
+	require.Zero(t, exporter.dropped.Load())
+}
+
+func TestOTLPExporter_ExportError(t *testing.T) {
+	service := &fakeLogsService{err: errors.New("unavailable")}
+	exporter, errorOutput := newTestOTLPExporter(service, OTLPConfig{})
+	exporter.enqueue(&otlplogspb.LogRecord{})
+	exporter.start()
+	exporter.flush()
+
+	// errors are reported at most once per interval
+	exporter.enqueue(&otlplogspb.LogRecord{})
+	exporter.stop()
+
+	require.Contains(t, errorOutput.String(), "OTLP log export error: unavailable, dropped 1 log records\n")
+	require.Equal(t, 1, bytes.Count(errorOutput.Bytes(), []byte("\n")))
+	require.Equal(t, int64(1), exporter.dropped.Load())
+}
+
+func TestBuildZapLogger_OTLP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	service := &fakeLogsService{}
+	server := grpc.NewServer()
+	otlpcollogspb.RegisterLogsServiceServer(server, grpcLogsService{fake: service})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	zl := BuildZapLogger(Config{
+		Level: "error",
+		OTLP: &OTLPConfig{
+			Endpoint: listener.Addr().String(),
+			Insecure: true,
+			Level:    "info",
+			Headers:  map[string]string{"authorization": "token"},
+		},
+	})
+	logger := NewZapLogger(zl)
+	logger.Info("exported but not written to stderr")
+	logger.Debug("not exported")
+	// syncing stderr fails when it isn't a file, which doesn't matter here
+	_ = zl.Sync()
+
+	records := service.records()
+	require.Len(t, records, 1)
+	require.Equal(t, "exported but not written to stderr", records[0].Body.GetStringValue())
+	require.Equal(t, []string{"token"}, service.headers[0].Get("authorization"))
+}
+
+func TestBuildZapLoggerWithOTLP_SharedConnection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	service := &fakeLogsService{}
+	server := grpc.NewServer()
+	otlpcollogspb.RegisterLogsServiceServer(server, grpcLogsService{fake: service})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	dial := func(name string) (*grpc.ClientConn, error) {
+		if name != "collector" {
+			return nil, errors.New("unknown connection")
+		}
+		return conn, nil
+	}
+
+	zl, shutdown := BuildZapLoggerWithOTLP(Config{
+		Level: "error",
+		OTLP:  &OTLPConfig{ConnectionName: "collector", Level: "info", FlushInterval: time.Hour},
+	}, dial)
+	NewZapLogger(zl).Info("exported on shutdown")
+	shutdown()
+
+	records := service.records()
+	require.Len(t, records, 1)
+	require.Equal(t, "exported on shutdown", records[0].Body.GetStringValue())
+	// the shared connection stays open for the other exporters
+	require.NotEqual(t, connectivity.Shutdown, conn.GetState())
+}
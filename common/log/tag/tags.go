@@ -25,15 +25,18 @@
 package tag
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	deploymentpb "go.temporal.io/api/deployment/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/util"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -49,8 +52,12 @@ const (
 	LoggingCallAtKey = "logging-call-at"
 	WorkflowIDKey    = "wf-id"
 	WorkflowRunIDKey = "wf-run-id"
+	TraceIDKey       = "trace-id"
+	SpanIDKey        = "span-id"
 )
 
+type spanContextMarshaler trace.SpanContext
+
 // ==========  Common tags defined here ==========
 
 // Operation returns tag for Operation
@@ -66,6 +73,22 @@ func Error(err error) ZapTag {
 	}
 }
 
+// TraceContext returns tag for the trace and span IDs of the span in the context, which correlates the log
+// with the trace. The tag is empty if the context has no valid span.
+func TraceContext(ctx context.Context) ZapTag {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ZapTag{field: zap.Skip()}
+	}
+	return ZapTag{field: zap.Inline(spanContextMarshaler(sc))}
+}
+
+func (sc spanContextMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString(TraceIDKey, trace.SpanContext(sc).TraceID().String())
+	enc.AddString(SpanIDKey, trace.SpanContext(sc).SpanID().String())
+	return nil
+}
+
 // ServiceErrorType returns tag for ServiceErrorType
 func ServiceErrorType(err error) ZapTag {
 	return NewStringTag("service-error-type", util.ErrorType(err))
@@ -50,9 +50,17 @@ type (
 		zl   *zap.Logger
 		skip int
 	}
+
+	// levelCore replaces the level of the wrapped core. Write is passed through to the wrapped core,
+	// which doesn't check the level again.
+	levelCore struct {
+		zapcore.Core
+		level zapcore.Level
+	}
 )
 
 var _ Logger = (*zapLogger)(nil)
+var _ LevelLogger = (*zapLogger)(nil)
 
 // NewTestLogger returns a logger for tests
 // Deprecated: Use testlogger.TestLogger instead.
@@ -89,7 +97,15 @@ func NewZapLogger(zl *zap.Logger) *zapLogger {
 
 // BuildZapLogger builds and returns a new zap.Logger for this logging configuration
 func BuildZapLogger(cfg Config) *zap.Logger {
-	return buildZapLogger(cfg, true)
+	zl, _ := buildZapLogger(cfg, true, nil)
+	return zl
+}
+
+// BuildZapLoggerWithOTLP builds a zap.Logger like BuildZapLogger and returns the shutdown of its OTLP
+// log exporter, which should run when the process stops so that the queued records are exported.
+// dial provides the connection if the OTLP config refers to a shared connection by name.
+func BuildZapLoggerWithOTLP(cfg Config, dial OTLPDialer) (*zap.Logger, OTLPShutdown) {
+	return buildZapLogger(cfg, true, dial)
 }
 
 func caller(skip int) string {
@@ -191,6 +207,18 @@ func (l *zapLogger) With(tags ...tag.Tag) Logger {
 	}
 }
 
+// WithLevel returns a logger which logs entries at or above the given level, even if it is below the
+// configured level of the logger.
+func (l *zapLogger) WithLevel(level zapcore.Level) Logger {
+	zl := l.zl.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &levelCore{Core: core, level: level}
+	}))
+	return &zapLogger{
+		zl:   zl,
+		skip: l.skip,
+	}
+}
+
 func (l *zapLogger) Skip(extraSkip int) Logger {
 	return &zapLogger{
 		zl:   l.zl,
@@ -198,7 +226,7 @@ func (l *zapLogger) Skip(extraSkip int) Logger {
 	}
 }
 
-func buildZapLogger(cfg Config, disableCaller bool) *zap.Logger {
+func buildZapLogger(cfg Config, disableCaller bool, dial OTLPDialer) (*zap.Logger, OTLPShutdown) {
 	encodeConfig := zapcore.EncoderConfig{
 		TimeKey:        "ts",
 		LevelKey:       "level",
@@ -240,7 +268,18 @@ func buildZapLogger(cfg Config, disableCaller bool) *zap.Logger {
 		DisableCaller:    disableCaller,
 	}
 	logger, _ := config.Build()
-	return logger
+	if cfg.OTLP == nil {
+		return logger, func() {}
+	}
+	otlpCore, err := buildOTLPCore(cfg, dial)
+	if err != nil {
+		logger.Error("Unable to create OTLP log exporter.", zap.Error(err))
+		return logger, func() {}
+	}
+	logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, otlpCore)
+	}))
+	return logger, otlpCore.exporter.stop
 }
 
 func buildCLIZapLogger() *zap.Logger {
@@ -294,3 +333,18 @@ func parseZapLevel(level string) zapcore.Level {
 		return zap.InfoLevel
 	}
 }
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return level >= c.level
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
@@ -0,0 +1,322 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const defaultCardinalityExpireAfter = time.Hour
+
+type (
+	// CardinalityLimit restricts the values of a tag reported with metrics. Values which are denied or
+	// exceed the limit are reported as "__other__".
+	CardinalityLimit struct {
+		// Tag is the name of the limited tag, e.g. "taskqueue".
+		Tag string `yaml:"tag"`
+		// Metrics are the names of the metrics the limit applies to. Default is all metrics.
+		Metrics []string `yaml:"metrics"`
+		// Namespaces are the namespaces the limit applies to. Default is all namespaces.
+		Namespaces []string `yaml:"namespaces"`
+		// MaxValues is the maximum number of distinct values reported per metric and namespace. Values
+		// beyond the limit are reported as "__other__". Default of 0 means no limit.
+		MaxValues int `yaml:"maxValues"`
+		// Allow lists values which are always reported and don't count towards MaxValues. If set
+		// without MaxValues, only allowed values are reported. A trailing "*" matches any suffix.
+		Allow []string `yaml:"allow"`
+		// Deny lists values which are always reported as "__other__". A trailing "*" matches any
+		// suffix.
+		Deny []string `yaml:"deny"`
+		// ExpireAfter is the time after which a value that wasn't reported no longer counts towards
+		// MaxValues, so that new values are reported once old ones are gone, e.g. after a task
+		// queue was deleted. Default is 1h.
+		ExpireAfter time.Duration `yaml:"expireAfter"`
+	}
+
+	// cardinalityLimiter applies the configured CardinalityLimits. The first limit matching the tag,
+	// metric and namespace applies.
+	cardinalityLimiter struct {
+		limits map[string][]*cardinalityLimitState // tag name -> limits
+		// onOverflow is called when a tag value is replaced with tagOverflowValue
+		onOverflow func(metricName string, tagName string)
+	}
+
+	cardinalityLimitState struct {
+		CardinalityLimit
+		metrics    map[string]struct{}
+		namespaces map[string]struct{}
+		allow      valueMatcher
+		deny       valueMatcher
+		seen       sync.Map // cardinalityKey -> *cardinalityValues
+		now        func() time.Time
+	}
+
+	cardinalityKey struct {
+		metricName string
+		namespace  string
+	}
+
+	cardinalityValues struct {
+		sync.RWMutex
+		values map[string]*atomic.Int64 // value -> time it was last reported in unix nanos
+		// nextExpiry is the earliest time a value can expire, values are only expired once the
+		// limit is reached
+		nextExpiry time.Time
+	}
+
+	valueMatcher struct {
+		exact    map[string]struct{}
+		prefixes []string
+	}
+)
+
+func validateCardinalityLimits(limits []CardinalityLimit) error {
+	for i, limit := range limits {
+		if limit.Tag == "" {
+			return fmt.Errorf("cardinality limit %d: tag is required", i)
+		}
+		if limit.MaxValues < 0 {
+			return fmt.Errorf("cardinality limit %d: maxValues must not be negative", i)
+		}
+		if limit.ExpireAfter < 0 {
+			return fmt.Errorf("cardinality limit %d: expireAfter must not be negative", i)
+		}
+		if limit.Tag == namespace && len(limit.Namespaces) > 0 {
+			return fmt.Errorf("cardinality limit %d: namespaces can't be set for the %s tag", i, namespace)
+		}
+	}
+	return nil
+}
+
+// newCardinalityLimiter returns nil if there are no limits. The limits must have been validated
+// with validateCardinalityLimits.
+func newCardinalityLimiter(limits []CardinalityLimit) *cardinalityLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	l := &cardinalityLimiter{
+		limits:     make(map[string][]*cardinalityLimitState),
+		onOverflow: func(string, string) {},
+	}
+	for _, limit := range limits {
+		if limit.ExpireAfter == 0 {
+			limit.ExpireAfter = defaultCardinalityExpireAfter
+		}
+		l.limits[limit.Tag] = append(l.limits[limit.Tag], &cardinalityLimitState{
+			CardinalityLimit: limit,
+			metrics:          toSet(limit.Metrics),
+			namespaces:       toSet(limit.Namespaces),
+			allow:            newValueMatcher(limit.Allow),
+			deny:             newValueMatcher(limit.Deny),
+			now:              time.Now,
+		})
+	}
+	return l
+}
+
+// mayLimit returns whether a limit may apply to the metric with the tags.
+func (l *cardinalityLimiter) mayLimit(metricName string, tagLists ...[]Tag) bool {
+	for _, tags := range tagLists {
+		for _, tag := range tags {
+			for _, limit := range l.limits[tag.Key()] {
+				if len(limit.metrics) == 0 {
+					return true
+				}
+				if _, ok := limit.metrics[metricName]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// limitSet returns the set with the values of limited tags replaced by tagOverflowValue.
+func (l *cardinalityLimiter) limitSet(metricName string, set attribute.Set) attribute.Set {
+	ns, _ := set.Value(namespace)
+	var attrs []attribute.KeyValue
+	for i := set.Iter(); i.Next(); {
+		idx, kv := i.IndexedAttribute()
+		value, limited := l.limitValue(metricName, ns.AsString(), string(kv.Key), kv.Value.AsString())
+		if !limited {
+			continue
+		}
+		if attrs == nil {
+			attrs = set.ToSlice()
+		}
+		attrs[idx] = attribute.String(string(kv.Key), value)
+	}
+	if attrs == nil {
+		return set
+	}
+	return attribute.NewSet(attrs...)
+}
+
+// limitMap replaces the values of limited tags in the map with tagOverflowValue.
+func (l *cardinalityLimiter) limitMap(metricName string, tags map[string]string) {
+	ns := tags[namespace]
+	for key, value := range tags {
+		if value, limited := l.limitValue(metricName, ns, key, value); limited {
+			tags[key] = value
+		}
+	}
+}
+
+// limitValue returns the value to report for the tag and whether it was replaced.
+func (l *cardinalityLimiter) limitValue(metricName, ns, tagName, value string) (string, bool) {
+	limits, ok := l.limits[tagName]
+	if !ok || value == tagOverflowValue {
+		return value, false
+	}
+	for _, limit := range limits {
+		if !limit.appliesTo(metricName, ns) {
+			continue
+		}
+		if limit.allowed(metricName, ns, value) {
+			return value, false
+		}
+		l.onOverflow(metricName, tagName)
+		return tagOverflowValue, true
+	}
+	return value, false
+}
+
+func (s *cardinalityLimitState) appliesTo(metricName, ns string) bool {
+	if len(s.metrics) > 0 {
+		if _, ok := s.metrics[metricName]; !ok {
+			return false
+		}
+	}
+	if len(s.namespaces) > 0 {
+		if _, ok := s.namespaces[ns]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *cardinalityLimitState) allowed(metricName, ns, value string) bool {
+	if s.deny.match(value) {
+		return false
+	}
+	if s.allow.match(value) {
+		return true
+	}
+	if s.MaxValues == 0 {
+		return len(s.Allow) == 0
+	}
+
+	key := cardinalityKey{metricName: metricName, namespace: ns}
+	v, ok := s.seen.Load(key)
+	if !ok {
+		v, _ = s.seen.LoadOrStore(key, &cardinalityValues{values: make(map[string]*atomic.Int64)})
+	}
+	values := v.(*cardinalityValues)
+	now := s.now()
+
+	values.RLock()
+	lastReported, seen := values.values[value]
+	full := len(values.values) >= s.MaxValues && now.Before(values.nextExpiry)
+	values.RUnlock()
+	if seen {
+		lastReported.Store(now.UnixNano())
+		return true
+	}
+	if full {
+		return false
+	}
+
+	values.Lock()
+	defer values.Unlock()
+	if lastReported, seen := values.values[value]; seen {
+		lastReported.Store(now.UnixNano())
+		return true
+	}
+	if len(values.values) >= s.MaxValues {
+		if now.Before(values.nextExpiry) {
+			return false
+		}
+		values.expire(now, s.ExpireAfter)
+		if len(values.values) >= s.MaxValues {
+			return false
+		}
+	}
+	lastReported = &atomic.Int64{}
+	lastReported.Store(now.UnixNano())
+	values.values[value] = lastReported
+	return true
+}
+
+// expire removes the values which weren't reported for expireAfter and updates nextExpiry.
+func (v *cardinalityValues) expire(now time.Time, expireAfter time.Duration) {
+	oldest := now.UnixNano()
+	for value, lastReported := range v.values {
+		last := lastReported.Load()
+		if now.Sub(time.Unix(0, last)) >= expireAfter {
+			delete(v.values, value)
+		} else {
+			oldest = min(oldest, last)
+		}
+	}
+	v.nextExpiry = time.Unix(0, oldest).Add(expireAfter)
+}
+
+func newValueMatcher(patterns []string) valueMatcher {
+	m := valueMatcher{exact: make(map[string]struct{})}
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			m.prefixes = append(m.prefixes, prefix)
+		} else {
+			m.exact[p] = struct{}{}
+		}
+	}
+	return m
+}
+
+func (m valueMatcher) match(value string) bool {
+	if _, ok := m.exact[value]; ok {
+		return true
+	}
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func limitValues(l *cardinalityLimiter, metricName string, tags map[string]string) map[string]string {
+	l.limitMap(metricName, tags)
+	return tags
+}
+
+func TestCardinalityLimiter_MaxValuesPerNamespace(t *testing.T) {
+	l := newCardinalityLimiter([]CardinalityLimit{{Tag: taskQueue, MaxValues: 2}})
+	overflows := map[string]int{}
+	l.onOverflow = func(metricName string, tagName string) { overflows[metricName+"/"+tagName]++ }
+
+	for _, tq := range []string{"a", "b", "c", "a", "d"} {
+		limitValues(l, "m", map[string]string{namespace: "ns1", taskQueue: tq})
+	}
+	assert.Equal(t, "b", limitValues(l, "m", map[string]string{namespace: "ns1", taskQueue: "b"})[taskQueue])
+	assert.Equal(t, tagOverflowValue, limitValues(l, "m", map[string]string{namespace: "ns1", taskQueue: "c"})[taskQueue])
+	assert.Equal(t, map[string]int{"m/taskqueue": 3}, overflows)
+
+	// the limit is per namespace and metric
+	assert.Equal(t, "c", limitValues(l, "m", map[string]string{namespace: "ns2", taskQueue: "c"})[taskQueue])
+	assert.Equal(t, "c", limitValues(l, "other", map[string]string{namespace: "ns1", taskQueue: "c"})[taskQueue])
+	// other tags are untouched
+	assert.Equal(t, "wf", limitValues(l, "m", map[string]string{namespace: "ns1", workflowType: "wf"})[workflowType])
+}
+
+func TestCardinalityLimiter_ExpireAfter(t *testing.T) {
+	l := newCardinalityLimiter([]CardinalityLimit{{Tag: taskQueue, MaxValues: 2, ExpireAfter: time.Minute}})
+	now := time.Unix(1000, 0)
+	l.limits[taskQueue][0].now = func() time.Time { return now }
+	tq := func(value string) string {
+		return limitValues(l, "m", map[string]string{namespace: "ns", taskQueue: value})[taskQueue]
+	}
+
+	assert.Equal(t, "a", tq("a"))
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, "b", tq("b"))
+	assert.Equal(t, tagOverflowValue, tq("c"))
+
+	// a wasn't reported for a minute, so c takes its place
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, "c", tq("c"))
+	assert.Equal(t, tagOverflowValue, tq("a"))
+
+	// b is kept as long as it is reported
+	now = now.Add(20 * time.Second)
+	assert.Equal(t, "b", tq("b"))
+	now = now.Add(50 * time.Second)
+	assert.Equal(t, "a", tq("a"))
+	assert.Equal(t, "b", tq("b"))
+	assert.Equal(t, tagOverflowValue, tq("c"))
+}
+
+func TestCardinalityLimiter_AllowDeny(t *testing.T) {
+	l := newCardinalityLimiter([]CardinalityLimit{
+		{Tag: taskQueue, MaxValues: 1, Allow: []string{"important", "/_sys/*"}, Deny: []string{"/_sys/noisy"}},
+		{Tag: workflowType, Allow: []string{"wf-*"}},
+	})
+
+	tags := limitValues(l, "m", map[string]string{taskQueue: "important", workflowType: "wf-a"})
+	assert.Equal(t, map[string]string{taskQueue: "important", workflowType: "wf-a"}, tags)
+	tags = limitValues(l, "m", map[string]string{taskQueue: "/_sys/tq", workflowType: "other"})
+	assert.Equal(t, map[string]string{taskQueue: "/_sys/tq", workflowType: tagOverflowValue}, tags)
+	tags = limitValues(l, "m", map[string]string{taskQueue: "/_sys/noisy"})
+	assert.Equal(t, map[string]string{taskQueue: tagOverflowValue}, tags)
+
+	// allowed and denied values don't count towards the limit
+	assert.Equal(t, "first", limitValues(l, "m", map[string]string{taskQueue: "first"})[taskQueue])
+	assert.Equal(t, tagOverflowValue, limitValues(l, "m", map[string]string{taskQueue: "second"})[taskQueue])
+}
+
+func TestCardinalityLimiter_Scope(t *testing.T) {
+	l := newCardinalityLimiter([]CardinalityLimit{
+		{Tag: taskQueue, Metrics: []string{"limited"}, Namespaces: []string{"ns1"}, Deny: []string{"*"}},
+		{Tag: taskQueue, Metrics: []string{"limited"}, MaxValues: 1},
+	})
+
+	// the first matching limit applies
+	assert.Equal(t, tagOverflowValue, limitValues(l, "limited", map[string]string{namespace: "ns1", taskQueue: "a"})[taskQueue])
+	assert.Equal(t, "a", limitValues(l, "limited", map[string]string{namespace: "ns2", taskQueue: "a"})[taskQueue])
+	assert.Equal(t, tagOverflowValue, limitValues(l, "limited", map[string]string{namespace: "ns2", taskQueue: "b"})[taskQueue])
+	assert.Equal(t, "b", limitValues(l, "unlimited", map[string]string{namespace: "ns1", taskQueue: "b"})[taskQueue])
+}
+
+func TestCardinalityLimiter_LimitSet(t *testing.T) {
+	l := newCardinalityLimiter([]CardinalityLimit{{Tag: taskQueue, Deny: []string{"b"}}})
+
+	set := attribute.NewSet(attribute.String(namespace, "ns"), attribute.String(taskQueue, "a"))
+	assert.Equal(t, set, l.limitSet("m", set))
+
+	set = attribute.NewSet(attribute.String(namespace, "ns"), attribute.String(taskQueue, "b"))
+	assert.Equal(t, attribute.NewSet(
+		attribute.String(namespace, "ns"),
+		attribute.String(taskQueue, tagOverflowValue),
+	), l.limitSet("m", set))
+}
+
+func TestValidateCardinalityLimits(t *testing.T) {
+	require.NoError(t, validateCardinalityLimits(nil))
+	require.NoError(t, validateCardinalityLimits([]CardinalityLimit{{Tag: taskQueue, MaxValues: 10}}))
+	require.Error(t, validateCardinalityLimits([]CardinalityLimit{{MaxValues: 10}}))
+	require.Error(t, validateCardinalityLimits([]CardinalityLimit{{Tag: taskQueue, MaxValues: -1}}))
+	require.Error(t, validateCardinalityLimits([]CardinalityLimit{{Tag: taskQueue, ExpireAfter: -time.Second}}))
+	require.Error(t, validateCardinalityLimits([]CardinalityLimit{{Tag: namespace, Namespaces: []string{"ns"}}}))
+	assert.Nil(t, newCardinalityLimiter(nil))
+}
@@ -60,6 +60,9 @@ type (
 		// Each value present in keys will have relevant tag value replaced with "_tag_excluded_"
 		// Each value in values list will white-list tag values to be reported as usual.
 		ExcludeTags map[string][]string `yaml:"excludeTags"`
+		// CardinalityLimits restricts the values of tags per metric and namespace. Values which are denied
+		// or exceed a limit are reported as "__other__", and counted by the metric_cardinality_overflow metric.
+		CardinalityLimits []CardinalityLimit `yaml:"cardinalityLimits"`
 		// Prefix sets the prefix to all outgoing metrics
 		// When migrating from tally to opentelemetry and to be backward compatible with the existing metric names,
 		// if the prefix has a "_" suffix, add an additional "_" at the end.
@@ -130,6 +133,11 @@ type (
 		// LoggerRPS sets the RPS of the logger provided to prometheus. Default of 0 means no limit.
 		LoggerRPS float64 `yaml:"loggerRPS"`
 
+		// Exemplars enables trace exemplars on histograms, which link an observation to the sampled trace
+		// it was recorded in. Exemplars are only served to scrapers which accept the OpenMetrics format.
+		// This config only takes effect when using opentelemetry framework.
+		Exemplars bool `yaml:"exemplars"`
+
 		// Configs below are kept for backwards compatibility with previously exposed tally prometheus.Configuration.
 
 		// Deprecated. ListenNetwork if specified will be used instead of using tcp network.
@@ -486,6 +494,10 @@ func MetricsHandlerFromConfig(logger log.Logger, c *Config) (Handler, error) {
 
 	setDefaultPerUnitHistogramBoundaries(&c.ClientConfig)
 
+	if err := validateCardinalityLimits(c.CardinalityLimits); err != nil {
+		return nil, err
+	}
+
 	if c.Prometheus != nil && c.Prometheus.Framework == FrameworkOpentelemetry {
 		fatalOnListenerError := true
 		otelProvider, err := NewOpenTelemetryProvider(logger, c.Prometheus, &c.ClientConfig, fatalOnListenerError)
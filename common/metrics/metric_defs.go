@@ -52,6 +52,7 @@ const (
 	resourceExhaustedScopeTag   = "resource_exhausted_scope"
 	PartitionTagName            = "partition"
 	PriorityTagName             = "priority"
+	FairnessKeyTagName          = "fairness_key"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -703,6 +704,18 @@ var (
 		"nexus_completion_request_preprocess_errors",
 		WithDescription("The number of Nexus completion requests for which pre-processing failed."),
 	)
+	NexusEndpointCircuitBreakerRequests = NewCounterDef(
+		"nexus_endpoint_circuit_breaker_requests",
+		WithDescription("The number of outbound Nexus requests that went through an endpoint circuit breaker, by outcome."),
+	)
+	NexusEndpointCircuitBreakerStateTransitions = NewCounterDef(
+		"nexus_endpoint_circuit_breaker_state_transitions",
+		WithDescription("The number of state transitions of Nexus endpoint circuit breakers, tagged with the new state."),
+	)
+	NexusEndpointHealthProbes = NewCounterDef(
+		"nexus_endpoint_health_probes",
+		WithDescription("The number of active health probes sent to Nexus endpoints, by outcome."),
+	)
 	HostRPSLimit          = NewGaugeDef("host_rps_limit")
 	NamespaceHostRPSLimit = NewGaugeDef("namespace_host_rps_limit")
 	HandoverWaitLatency   = NewTimerDef("handover_wait_latency")
@@ -1055,7 +1068,15 @@ var (
 	TaskDispatchLatencyPerTaskQueue        = NewTimerDef("task_dispatch_latency")
 	ApproximateBacklogCount                = NewGaugeDef("approximate_backlog_count")
 	ApproximateBacklogAgeSeconds           = NewGaugeDef("approximate_backlog_age_seconds")
-	NonRetryableTasks                      = NewCounterDef(
+	ApproximateBacklogCountPerFairnessKey  = NewGaugeDef(
+		"approximate_backlog_count_per_fairness_key",
+		WithDescription("Approximate backlog count of a task queue partition broken down by fairness key"),
+	)
+	TaskDispatchedPerFairnessKey = NewCounterDef(
+		"task_dispatched_per_fairness_key",
+		WithDescription("Number of tasks dispatched to pollers broken down by fairness key"),
+	)
+	NonRetryableTasks = NewCounterDef(
 		"non_retryable_tasks",
 		WithDescription("The number of non-retryable matching tasks which are dropped due to specific errors"))
 
@@ -1194,6 +1215,10 @@ var (
 		"schedule_action_delay",
 		WithDescription("Delay between when scheduled actions should/actually happen"),
 	)
+	ScheduleUpstreamFailureSkipped = NewCounterDef(
+		"schedule_upstream_failure_skipped",
+		WithDescription("The number of schedule actions that were skipped because the upstream schedule's action failed"),
+	)
 
 	// Force replication
 	EncounterZombieWorkflowCount        = NewCounterDef("encounter_zombie_workflow_count")
@@ -1236,6 +1261,19 @@ var (
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
+	PersistenceReadReplicaRequests         = NewCounterDef(
+		"persistence_read_replica_requests",
+		WithDescription("Reads which tolerate replication lag, keyed by the `target` that served them (replica address or primary)"),
+	)
+	PersistenceReadReplicaLag = NewGaugeDef(
+		"persistence_read_replica_lag_seconds",
+		WithDescription("Replication lag of a SQL read replica, keyed by `target`"),
+	)
+
+	MetricCardinalityOverflow = NewCounterDef(
+		"metric_cardinality_overflow",
+		WithDescription("Number of times a tag value was reported as __other__ by a cardinality limit, keyed by `metric_name` and `tag_name`"),
+	)
 
 	// Common service base metrics
 	RestartCount           = NewCounterDef("restarts")
@@ -27,6 +27,7 @@
 package metrics
 
 import (
+	"context"
 	"io"
 	"time"
 
@@ -88,6 +89,9 @@ type (
 		// Record sets the timer value.
 		// Tags provided are merged with the source MetricsHandler
 		Record(time.Duration, ...Tag)
+		// RecordContext is like Record. Handlers which support exemplars attach the trace of the sampled
+		// span in the context to the value.
+		RecordContext(context.Context, time.Duration, ...Tag)
 	}
 
 	// HistogramIface records a distribution of values.
@@ -95,6 +99,9 @@ type (
 		// Record adds a value to the distribution
 		// Tags provided are merged with the source MetricsHandler
 		Record(int64, ...Tag)
+		// RecordContext is like Record. Handlers which support exemplars attach the trace of the sampled
+		// span in the context to the value.
+		RecordContext(context.Context, int64, ...Tag)
 	}
 
 	CounterFunc   func(int64, ...Tag)
@@ -107,3 +114,6 @@ func (c CounterFunc) Record(v int64, tags ...Tag)       { c(v, tags...) }
 func (c GaugeFunc) Record(v float64, tags ...Tag)       { c(v, tags...) }
 func (c TimerFunc) Record(v time.Duration, tags ...Tag) { c(v, tags...) }
 func (c HistogramFunc) Record(v int64, tags ...Tag)     { c(v, tags...) }
+
+func (c TimerFunc) RecordContext(_ context.Context, v time.Duration, tags ...Tag) { c(v, tags...) }
+func (c HistogramFunc) RecordContext(_ context.Context, v int64, tags ...Tag)     { c(v, tags...) }
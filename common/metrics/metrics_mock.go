@@ -34,6 +34,7 @@
 package metrics
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -426,6 +427,23 @@ func (mr *MockTimerIfaceMockRecorder) Record(arg0 any, arg1 ...any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockTimerIface)(nil).Record), varargs...)
 }
 
+// RecordContext mocks base method.
+func (m *MockTimerIface) RecordContext(arg0 context.Context, arg1 time.Duration, arg2 ...Tag) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "RecordContext", varargs...)
+}
+
+// RecordContext indicates an expected call of RecordContext.
+func (mr *MockTimerIfaceMockRecorder) RecordContext(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordContext", reflect.TypeOf((*MockTimerIface)(nil).RecordContext), varargs...)
+}
+
 // MockHistogramIface is a mock of HistogramIface interface.
 type MockHistogramIface struct {
 	ctrl     *gomock.Controller
@@ -466,3 +484,20 @@ func (mr *MockHistogramIfaceMockRecorder) Record(arg0 any, arg1 ...any) *gomock.
 	varargs := append([]any{arg0}, arg1...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockHistogramIface)(nil).Record), varargs...)
 }
+
+// RecordContext mocks base method.
+func (m *MockHistogramIface) RecordContext(arg0 context.Context, arg1 int64, arg2 ...Tag) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "RecordContext", varargs...)
+}
+
+// RecordContext indicates an expected call of RecordContext.
+func (mr *MockHistogramIfaceMockRecorder) RecordContext(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordContext", reflect.TypeOf((*MockHistogramIface)(nil).RecordContext), varargs...)
+}
@@ -34,6 +34,7 @@ import (
 	exporters "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 )
@@ -90,9 +91,15 @@ func NewOpenTelemetryProvider(
 			},
 		))
 	}
+	exemplarFilter := exemplar.AlwaysOffFilter
+	if prometheusConfig.Exemplars {
+		// only spans which are sampled, so their trace can be looked up, are used as exemplars
+		exemplarFilter = exemplar.TraceBasedFilter
+	}
 	provider := sdkmetrics.NewMeterProvider(
 		sdkmetrics.WithReader(exporter),
 		sdkmetrics.WithView(views...),
+		sdkmetrics.WithExemplarFilter(exemplarFilter),
 	)
 	metricServer := initPrometheusListener(prometheusConfig, reg, logger, fatalOnListenerError)
 	meter := provider.Meter("temporal")
@@ -117,7 +124,11 @@ func initPrometheusListener(
 	}
 
 	handler := http.NewServeMux()
-	handler.HandleFunc(handlerPath, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}).ServeHTTP)
+	handler.HandleFunc(handlerPath, promhttp.HandlerFor(reg, promhttp.HandlerOpts{
+		Registry: reg,
+		// exemplars are only supported by the OpenMetrics format
+		EnableOpenMetrics: config.Exemplars,
+	}).ServeHTTP)
 
 	if config.ListenAddress == "" {
 		logger.Fatal("Listen address must be specified.", tag.Address(config.ListenAddress))
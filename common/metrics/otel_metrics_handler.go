@@ -43,6 +43,7 @@ type (
 		set                  attribute.Set
 		provider             OpenTelemetryProvider
 		excludeTags          map[string]map[string]struct{}
+		limiter              *cardinalityLimiter
 		catalog              catalog
 		gauges               *sync.Map // string -> *gaugeAdapter. note: shared between multiple otelMetricsHandlers
 		recordTimerInSeconds bool
@@ -63,8 +64,13 @@ type (
 		// Set back from a Distinct, so we have to store the set here also.
 		set attribute.Set
 	}
+	// otelTimer and otelHistogram pass the context to the instrument, which samples exemplars from it
+	otelTimer     func(context.Context, time.Duration, ...Tag)
+	otelHistogram func(context.Context, int64, ...Tag)
+
 	gaugeAdapterGauge struct {
 		omp     *otelMetricsHandler
+		name    string
 		adapter *gaugeAdapter
 	}
 )
@@ -86,7 +92,11 @@ func NewOtelMetricsHandler(
 		return nil, fmt.Errorf("failed to build metrics catalog: %w", err)
 	}
 
-	return &otelMetricsHandler{
+	if err := validateCardinalityLimits(cfg.CardinalityLimits); err != nil {
+		return nil, err
+	}
+
+	omp := &otelMetricsHandler{
 		l:                    l,
 		set:                  makeInitialSet(cfg.Tags),
 		provider:             o,
@@ -94,7 +104,17 @@ func NewOtelMetricsHandler(
 		catalog:              c,
 		gauges:               new(sync.Map),
 		recordTimerInSeconds: cfg.RecordTimerInSeconds,
-	}, nil
+	}
+	if limiter := newCardinalityLimiter(cfg.CardinalityLimits); limiter != nil {
+		// the overflow metric is reported without limits, its tags are bounded by the config
+		unlimited := *omp
+		overflow := MetricCardinalityOverflow.With(&unlimited)
+		limiter.onOverflow = func(metricName string, tagName string) {
+			overflow.Record(1, StringTag("metric_name", metricName), StringTag("tag_name", tagName))
+		}
+		omp.limiter = limiter
+	}
+	return omp, nil
 }
 
 // WithTags creates a new Handler with the provided Tag list.
@@ -115,7 +135,7 @@ func (omp *otelMetricsHandler) Counter(counter string) CounterIface {
 	}
 
 	return CounterFunc(func(i int64, t ...Tag) {
-		option := metric.WithAttributeSet(omp.makeSet(t))
+		option := metric.WithAttributeSet(omp.metricSet(counter, t))
 		c.Add(context.Background(), i, option)
 	})
 }
@@ -153,6 +173,7 @@ func (omp *otelMetricsHandler) Gauge(gauge string) GaugeIface {
 	}
 	return &gaugeAdapterGauge{
 		omp:     omp,
+		name:    gauge,
 		adapter: adapter,
 	}
 }
@@ -166,8 +187,24 @@ func (a *gaugeAdapter) callback(ctx context.Context, o metric.Float64Observer) e
 	return nil
 }
 
+func (t otelTimer) Record(v time.Duration, tags ...Tag) {
+	t(context.Background(), v, tags...)
+}
+
+func (t otelTimer) RecordContext(ctx context.Context, v time.Duration, tags ...Tag) {
+	t(ctx, v, tags...)
+}
+
+func (h otelHistogram) Record(v int64, tags ...Tag) {
+	h(context.Background(), v, tags...)
+}
+
+func (h otelHistogram) RecordContext(ctx context.Context, v int64, tags ...Tag) {
+	h(ctx, v, tags...)
+}
+
 func (g *gaugeAdapterGauge) Record(v float64, tags ...Tag) {
-	set := g.omp.makeSet(tags)
+	set := g.omp.metricSet(g.name, tags)
 	g.adapter.lock.Lock()
 	defer g.adapter.lock.Unlock()
 	g.adapter.values[set.Equivalent()] = gaugeValue{value: v, set: set}
@@ -189,9 +226,9 @@ func (omp *otelMetricsHandler) timerInMilliseconds(timer string) TimerIface {
 		return TimerFunc(func(i time.Duration, t ...Tag) {})
 	}
 
-	return TimerFunc(func(i time.Duration, t ...Tag) {
-		option := metric.WithAttributeSet(omp.makeSet(t))
-		c.Record(context.Background(), i.Milliseconds(), option)
+	return otelTimer(func(ctx context.Context, i time.Duration, t ...Tag) {
+		option := metric.WithAttributeSet(omp.metricSet(timer, t))
+		c.Record(ctx, i.Milliseconds(), option)
 	})
 }
 
@@ -203,9 +240,9 @@ func (omp *otelMetricsHandler) timerInSeconds(timer string) TimerIface {
 		return TimerFunc(func(i time.Duration, t ...Tag) {})
 	}
 
-	return TimerFunc(func(i time.Duration, t ...Tag) {
-		option := metric.WithAttributeSet(omp.makeSet(t))
-		c.Record(context.Background(), i.Seconds(), option)
+	return otelTimer(func(ctx context.Context, i time.Duration, t ...Tag) {
+		option := metric.WithAttributeSet(omp.metricSet(timer, t))
+		c.Record(ctx, i.Seconds(), option)
 	})
 }
 
@@ -218,9 +255,9 @@ func (omp *otelMetricsHandler) Histogram(histogram string, unit MetricUnit) Hist
 		return HistogramFunc(func(i int64, t ...Tag) {})
 	}
 
-	return HistogramFunc(func(i int64, t ...Tag) {
-		option := metric.WithAttributeSet(omp.makeSet(t))
-		c.Record(context.Background(), i, option)
+	return otelHistogram(func(ctx context.Context, i int64, t ...Tag) {
+		option := metric.WithAttributeSet(omp.metricSet(histogram, t))
+		c.Record(ctx, i, option)
 	})
 }
 
@@ -252,6 +289,16 @@ func (omp *otelMetricsHandler) makeSet(tags []Tag) attribute.Set {
 	return attribute.NewSet(attrs...)
 }
 
+// metricSet returns the attribute set for recording the metric, which is the set returned by makeSet
+// with cardinality limits applied.
+func (omp *otelMetricsHandler) metricSet(metricName string, tags []Tag) attribute.Set {
+	set := omp.makeSet(tags)
+	if omp.limiter == nil {
+		return set
+	}
+	return omp.limiter.limitSet(metricName, set)
+}
+
 func (omp *otelMetricsHandler) convertTag(tag Tag) attribute.KeyValue {
 	if vals, ok := omp.excludeTags[tag.Key()]; ok {
 		if _, ok := vals[tag.Value()]; !ok {
@@ -37,7 +37,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	sdkmetrics "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.uber.org/mock/gomock"
@@ -332,6 +334,101 @@ func (t erroneousMeter) Float64ObservableGauge(string, ...metric.Float64Observab
 
 var testErr = errors.New("test error")
 
+func TestMeter_CardinalityLimits(t *testing.T) {
+	ctx := context.Background()
+	rdr := sdkmetrics.NewManualReader()
+	provider := sdkmetrics.NewMeterProvider(sdkmetrics.WithReader(rdr))
+
+	cfg := ClientConfig{
+		CardinalityLimits: []CardinalityLimit{{Tag: taskQueue, MaxValues: 1, Allow: []string{"allowed"}}},
+	}
+	p, err := NewOtelMetricsHandler(
+		log.NewTestLogger(),
+		&testProvider{meter: provider.Meter("test")},
+		cfg,
+	)
+	require.NoError(t, err)
+
+	nsHandler := p.WithTags(NamespaceTag("ns"))
+	nsHandler.Counter("hits").Record(1, UnsafeTaskQueueTag("a"))
+	nsHandler.WithTags(UnsafeTaskQueueTag("b")).Counter("hits").Record(2)
+	nsHandler.Counter("hits").Record(3, UnsafeTaskQueueTag("allowed"))
+	nsHandler.Gauge("depth").Record(4, UnsafeTaskQueueTag("b"))
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &got))
+
+	values := make(map[string]map[string]float64)
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		values[m.Name] = make(map[string]float64)
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			for _, dp := range data.DataPoints {
+				values[m.Name][dp.Attributes.Encoded(attribute.DefaultEncoder())] = float64(dp.Value)
+			}
+		case metricdata.Gauge[float64]:
+			for _, dp := range data.DataPoints {
+				values[m.Name][dp.Attributes.Encoded(attribute.DefaultEncoder())] = dp.Value
+			}
+		}
+	}
+	assert.Equal(t, map[string]map[string]float64{
+		"hits": {
+			"namespace=ns,taskqueue=a":         1,
+			"namespace=ns,taskqueue=__other__": 2,
+			"namespace=ns,taskqueue=allowed":   3,
+		},
+		"depth": {
+			"namespace=ns,taskqueue=b": 4,
+		},
+		"metric_cardinality_overflow": {
+			"metric_name=hits,tag_name=taskqueue": 1,
+		},
+	}, values)
+}
+
+func TestMeter_Exemplars(t *testing.T) {
+	ctx := context.Background()
+	rdr := sdkmetrics.NewManualReader()
+	provider := sdkmetrics.NewMeterProvider(
+		sdkmetrics.WithReader(rdr),
+		sdkmetrics.WithExemplarFilter(exemplar.TraceBasedFilter),
+	)
+	p, err := NewOtelMetricsHandler(
+		log.NewTestLogger(),
+		&testProvider{meter: provider.Meter("test")},
+		ClientConfig{},
+	)
+	require.NoError(t, err)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	sampledCtx := trace.ContextWithSpanContext(ctx, spanContext)
+	p.Timer("latency").RecordContext(sampledCtx, time.Second)
+	p.Histogram("size", Bytes).RecordContext(sampledCtx, 10)
+	// values without a sampled span have no exemplar
+	p.Timer("unsampled_latency").RecordContext(ctx, time.Second)
+	p.Timer("unsampled_latency").Record(time.Second)
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &got))
+
+	exemplars := make(map[string][]metricdata.Exemplar[int64])
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		exemplars[m.Name] = m.Data.(metricdata.Histogram[int64]).DataPoints[0].Exemplars
+	}
+	require.Len(t, exemplars["latency"], 1)
+	assert.Equal(t, int64(1000), exemplars["latency"][0].Value)
+	assert.Equal(t, spanContext.TraceID().String(), trace.TraceID(exemplars["latency"][0].TraceID).String())
+	assert.Equal(t, spanContext.SpanID().String(), trace.SpanID(exemplars["latency"][0].SpanID).String())
+	require.Len(t, exemplars["size"], 1)
+	assert.Equal(t, int64(10), exemplars["size"][0].Value)
+	assert.Empty(t, exemplars["unsampled_latency"])
+}
+
 func TestOtelMetricsHandler_Error(t *testing.T) {
 	t.Parallel()
 
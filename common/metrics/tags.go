@@ -69,6 +69,7 @@ const (
 	unknownValue      = "_unknown_"
 	totalMetricSuffix = "_total"
 	tagExcludedValue  = "_tag_excluded_"
+	tagOverflowValue  = "__other__"
 
 	errorPrefix = "*"
 )
@@ -279,6 +280,10 @@ func TaskPriorityTag(value string) Tag {
 	return &tagImpl{key: TaskPriorityTagName, value: value}
 }
 
+func FairnessKeyTag(value string) Tag {
+	return &tagImpl{key: FairnessKeyTagName, value: value}
+}
+
 func QueueReaderIDTag(readerID int64) Tag {
 	return &tagImpl{key: QueueReaderIDTagName, value: strconv.Itoa(int(readerID))}
 }
@@ -25,6 +25,7 @@
 package metrics
 
 import (
+	"slices"
 	"time"
 
 	"github.com/uber-go/tally/v4"
@@ -45,6 +46,11 @@ type (
 		scope          tally.Scope
 		perUnitBuckets map[MetricUnit]tally.Buckets
 		excludeTags    excludeTags
+		limiter        *cardinalityLimiter
+		// tags added with WithTags while limits are configured. The scope is tagged with them as
+		// well, but limits are applied when a metric is recorded, so they are needed to tag the
+		// limited metrics again.
+		tags []Tag
 	}
 )
 
@@ -57,64 +63,64 @@ func NewTallyMetricsHandler(cfg ClientConfig, scope tally.Scope) *tallyMetricsHa
 		perUnitBuckets[MetricUnit(unit)] = tally.ValueBuckets(boundariesList)
 	}
 
-	return &tallyMetricsHandler{
+	tmh := &tallyMetricsHandler{
 		scope:          scope,
 		perUnitBuckets: perUnitBuckets,
 		excludeTags:    configExcludeTags(cfg),
 	}
+	// limits are validated by MetricsHandlerFromConfig
+	if limiter := newCardinalityLimiter(cfg.CardinalityLimits); limiter != nil {
+		// the overflow metric is reported without limits, its tags are bounded by the config
+		unlimited := *tmh
+		overflow := MetricCardinalityOverflow.With(&unlimited)
+		limiter.onOverflow = func(metricName string, tagName string) {
+			overflow.Record(1, StringTag("metric_name", metricName), StringTag("tag_name", tagName))
+		}
+		tmh.limiter = limiter
+	}
+	return tmh
 }
 
 // WithTags creates a new MetricProvder with provided []Tag
 // Tags are merged with registered Tags from the source MetricsHandler
 func (tmh *tallyMetricsHandler) WithTags(tags ...Tag) Handler {
-	return &tallyMetricsHandler{
+	newHandler := &tallyMetricsHandler{
 		scope:          tmh.scope.Tagged(tagsToMap(tags, tmh.excludeTags)),
 		perUnitBuckets: tmh.perUnitBuckets,
 		excludeTags:    tmh.excludeTags,
+		limiter:        tmh.limiter,
 	}
+	if tmh.limiter != nil {
+		newHandler.tags = append(slices.Clip(tmh.tags), tags...)
+	}
+	return newHandler
 }
 
 // Counter obtains a counter for the given name.
 func (tmh *tallyMetricsHandler) Counter(counter string) CounterIface {
 	return CounterFunc(func(i int64, t ...Tag) {
-		scope := tmh.scope
-		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
-		}
-		scope.Counter(counter).Inc(i)
+		tmh.scopeFor(counter, t).Counter(counter).Inc(i)
 	})
 }
 
 // Gauge obtains a gauge for the given name.
 func (tmh *tallyMetricsHandler) Gauge(gauge string) GaugeIface {
 	return GaugeFunc(func(f float64, t ...Tag) {
-		scope := tmh.scope
-		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
-		}
-		scope.Gauge(gauge).Update(f)
+		tmh.scopeFor(gauge, t).Gauge(gauge).Update(f)
 	})
 }
 
 // Timer obtains a timer for the given name.
 func (tmh *tallyMetricsHandler) Timer(timer string) TimerIface {
 	return TimerFunc(func(d time.Duration, t ...Tag) {
-		scope := tmh.scope
-		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
-		}
-		scope.Timer(timer).Record(d)
+		tmh.scopeFor(timer, t).Timer(timer).Record(d)
 	})
 }
 
 // Histogram obtains a histogram for the given name.
 func (tmh *tallyMetricsHandler) Histogram(histogram string, unit MetricUnit) HistogramIface {
 	return HistogramFunc(func(i int64, t ...Tag) {
-		scope := tmh.scope
-		if len(t) > 0 {
-			scope = tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
-		}
-		scope.Histogram(histogram, tmh.perUnitBuckets[unit]).RecordValue(float64(i))
+		tmh.scopeFor(histogram, t).Histogram(histogram, tmh.perUnitBuckets[unit]).RecordValue(float64(i))
 	})
 }
 
@@ -128,6 +134,20 @@ func (tmh *tallyMetricsHandler) StartBatch(_ string) BatchHandler {
 	return tmh
 }
 
+// scopeFor returns the scope for recording the metric with the tags.
+func (tmh *tallyMetricsHandler) scopeFor(metricName string, t []Tag) tally.Scope {
+	if tmh.limiter == nil || !tmh.limiter.mayLimit(metricName, tmh.tags, t) {
+		if len(t) == 0 {
+			return tmh.scope
+		}
+		return tmh.scope.Tagged(tagsToMap(t, tmh.excludeTags))
+	}
+	// the tags of a subscope replace the ones of its parent with the same key
+	tags := tagsToMap(append(slices.Clip(tmh.tags), t...), tmh.excludeTags)
+	tmh.limiter.limitMap(metricName, tags)
+	return tmh.scope.Tagged(tags)
+}
+
 func tagsToMap(t1 []Tag, e excludeTags) map[string]string {
 	if len(t1) == 0 {
 		return nil
@@ -95,6 +95,27 @@ func TestTallyScope(t *testing.T) {
 	assert.EqualValues(t, map[string]string{"taskqueue": "__sticky__"}, counters["test.hits-tagged+taskqueue=__sticky__"].Tags())
 }
 
+func TestTallyScope_CardinalityLimits(t *testing.T) {
+	scope := tally.NewTestScope("test", map[string]string{})
+	cfg := ClientConfig{
+		CardinalityLimits: []CardinalityLimit{{Tag: taskQueue, Metrics: []string{"hits"}, MaxValues: 1}},
+	}
+	mp := NewTallyMetricsHandler(cfg, scope)
+
+	// tags added with WithTags are limited as well
+	nsHandler := mp.WithTags(NamespaceTag("ns"))
+	nsHandler.Counter("hits").Record(1, UnsafeTaskQueueTag("a"))
+	nsHandler.WithTags(UnsafeTaskQueueTag("b")).Counter("hits").Record(2)
+	nsHandler.Counter("hits").Record(3, UnsafeTaskQueueTag("c"))
+	nsHandler.Counter("other").Record(4, UnsafeTaskQueueTag("c"))
+
+	counters := scope.Snapshot().Counters()
+	assert.EqualValues(t, 1, counters["test.hits+namespace=ns,taskqueue=a"].Value())
+	assert.EqualValues(t, 5, counters["test.hits+namespace=ns,taskqueue=__other__"].Value())
+	assert.EqualValues(t, 4, counters["test.other+namespace=ns,taskqueue=c"].Value())
+	assert.EqualValues(t, 2, counters["test.metric_cardinality_overflow+metric_name=hits,tag_name=taskqueue"].Value())
+}
+
 func recordTallyMetrics(h Handler) {
 	hitsCounter := h.Counter("hits")
 	gauge := h.Gauge("temp")
@@ -25,6 +25,7 @@
 package metrics
 
 import (
+	"slices"
 	"strconv"
 
 	"go.temporal.io/server/common/tqid"
@@ -54,6 +55,15 @@ func GetPerTaskQueueFamilyScope(
 	return handler.WithTags(tags...)
 }
 
+// GetFairnessKeyTagValue returns the value for the "fairness_key" tag: the key itself if it's empty or listed in
+// breakdownKeys, "__omitted__" otherwise. Fairness keys are user-supplied, so they're only broken down on request.
+func GetFairnessKeyTagValue(fairnessKey string, breakdownKeys []string) string {
+	if fairnessKey == "" || slices.Contains(breakdownKeys, fairnessKey) {
+		return fairnessKey
+	}
+	return omitted
+}
+
 // GetPerTaskQueueScope returns GetPerTaskQueueFamilyScope plus the "task_type" tag.
 func GetPerTaskQueueScope(
 	handler Handler,
@@ -0,0 +1,364 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.temporal.io/api/serviceerror"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	EndpointCircuitBreakerConfig struct {
+		enabled       dynamicconfig.BoolPropertyFn
+		settings      dynamicconfig.TypedSubscribable[dynamicconfig.CircuitBreakerSettings]
+		probeEnabled  dynamicconfig.BoolPropertyFn
+		probeInterval dynamicconfig.DurationPropertyFn
+		probeTimeout  dynamicconfig.DurationPropertyFn
+	}
+
+	// ProbeCallerProvider returns the HTTP caller used to send active health probes to the given endpoint. Callers
+	// should be configured the same way as the ones used for Nexus requests to the endpoint.
+	ProbeCallerProvider func(entry *persistencespb.NexusEndpointEntry) (func(*http.Request) (*http.Response, error), error)
+
+	// EndpointCircuitBreakers maintains a circuit breaker per Nexus endpoint. Breakers are shared by all namespaces
+	// calling an endpoint and are local to the host; they are created lazily on the first request to an endpoint and
+	// removed once the endpoint is deleted.
+	// When active health probing is enabled, external endpoints with a tripped breaker are periodically probed. While
+	// half-open, probe results are recorded in the endpoint's breaker, allowing it to recover without risking real
+	// requests. Probes are never sent to endpoints with a closed breaker.
+	EndpointCircuitBreakers struct {
+		config              *EndpointCircuitBreakerConfig
+		endpointRegistry    EndpointRegistry
+		probeCallerProvider ProbeCallerProvider
+		metricsHandler      metrics.Handler
+		logger              log.Logger
+
+		breakersLock sync.RWMutex
+		breakers     map[string]*endpointCircuitBreaker // Mapping of endpoint ID -> circuit breaker.
+
+		probeLoop *goro.Handle
+	}
+
+	endpointCircuitBreaker struct {
+		*circuitbreaker.TwoStepCircuitBreakerWithDynamicSettings
+		// Most recent endpoint entry seen for this breaker, used for probing and describing the breaker.
+		entry     atomic.Pointer[persistencespb.NexusEndpointEntry]
+		lastProbe atomic.Pointer[probeResult]
+		// Unsubscribes the breaker from settings updates.
+		cancelSettings func()
+	}
+
+	probeResult struct {
+		time time.Time
+		err  error
+	}
+)
+
+// ErrEndpointCircuitBreakerOpen is returned by EndpointCircuitBreakers.Allow when requests to an endpoint are rejected.
+var ErrEndpointCircuitBreakerOpen = errors.New("nexus endpoint circuit breaker is open")
+
+// minProbeInterval bounds how often the probe loop runs, regardless of the configured interval.
+const minProbeInterval = time.Second
+
+func NewEndpointCircuitBreakerConfig(dc *dynamicconfig.Collection) *EndpointCircuitBreakerConfig {
+	return &EndpointCircuitBreakerConfig{
+		enabled:       dynamicconfig.NexusEndpointCircuitBreakerEnabled.Get(dc),
+		settings:      dynamicconfig.NexusEndpointCircuitBreakerSettings.Subscribe(dc),
+		probeEnabled:  dynamicconfig.NexusEndpointHealthProbeEnabled.Get(dc),
+		probeInterval: dynamicconfig.NexusEndpointHealthProbeInterval.Get(dc),
+		probeTimeout:  dynamicconfig.NexusEndpointHealthProbeTimeout.Get(dc),
+	}
+}
+
+func NewEndpointCircuitBreakers(
+	config *EndpointCircuitBreakerConfig,
+	endpointRegistry EndpointRegistry,
+	probeCallerProvider ProbeCallerProvider,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *EndpointCircuitBreakers {
+	return &EndpointCircuitBreakers{
+		config:              config,
+		endpointRegistry:    endpointRegistry,
+		probeCallerProvider: probeCallerProvider,
+		metricsHandler:      metricsHandler,
+		logger:              logger,
+		breakers:            make(map[string]*endpointCircuitBreaker),
+	}
+}
+
+// StartLifecycle starts the loop that prunes breakers of deleted endpoints and actively probes endpoints. It should only be invoked by an fx lifecycle hook.
+func (b *EndpointCircuitBreakers) StartLifecycle() {
+	ctx := headers.SetCallerInfo(context.Background(), headers.SystemBackgroundCallerInfo)
+	b.probeLoop = goro.NewHandle(ctx).Go(b.probeLoopFn)
+}
+
+// StopLifecycle stops the loop started by StartLifecycle. It should only be invoked by an fx lifecycle hook.
+func (b *EndpointCircuitBreakers) StopLifecycle() {
+	b.probeLoop.Cancel()
+	<-b.probeLoop.Done()
+}
+
+// Allow checks whether a request may be sent to the given endpoint. If allowed, the caller must invoke the returned
+// done function with the outcome of the request. Returns an error wrapping ErrEndpointCircuitBreakerOpen if the
+// request is rejected.
+func (b *EndpointCircuitBreakers) Allow(entry *persistencespb.NexusEndpointEntry) (done func(success bool), err error) {
+	if !b.config.enabled() {
+		return func(bool) {}, nil
+	}
+	endpointTag := metrics.NexusEndpointTag(entry.GetEndpoint().GetSpec().GetName())
+	breakerDone, err := b.getOrCreate(entry).Allow()
+	if err != nil {
+		metrics.NexusEndpointCircuitBreakerRequests.With(b.metricsHandler).Record(1, endpointTag, metrics.OutcomeTag("rejected"))
+		return nil, fmt.Errorf("%w: %w", ErrEndpointCircuitBreakerOpen, err)
+	}
+	return func(success bool) {
+		breakerDone(success)
+		outcome := "success"
+		if !success {
+			outcome = "failure"
+		}
+		metrics.NexusEndpointCircuitBreakerRequests.With(b.metricsHandler).Record(1, endpointTag, metrics.OutcomeTag(outcome))
+	}, nil
+}
+
+// Describe returns the state of the circuit breaker for the given endpoint ID. Endpoints that this host never
+// dispatched to are reported as closed.
+func (b *EndpointCircuitBreakers) Describe(endpointID string) *commonspb.NexusEndpointCircuitBreakerInfo {
+	b.breakersLock.RLock()
+	cb, ok := b.breakers[endpointID]
+	b.breakersLock.RUnlock()
+
+	info := &commonspb.NexusEndpointCircuitBreakerInfo{
+		EndpointId: endpointID,
+		State:      enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED,
+	}
+	if !ok {
+		return info
+	}
+	counts := cb.Counts()
+	info.EndpointName = cb.entry.Load().GetEndpoint().GetSpec().GetName()
+	info.State = circuitBreakerStateToProto(cb.State())
+	info.Requests = counts.Requests
+	info.TotalSuccesses = counts.TotalSuccesses
+	info.TotalFailures = counts.TotalFailures
+	info.ConsecutiveSuccesses = counts.ConsecutiveSuccesses
+	info.ConsecutiveFailures = counts.ConsecutiveFailures
+	if probe := cb.lastProbe.Load(); probe != nil {
+		info.LastProbeTime = timestamppb.New(probe.time)
+		if probe.err != nil {
+			info.LastProbeFailure = probe.err.Error()
+		}
+	}
+	return info
+}
+
+func (b *EndpointCircuitBreakers) getOrCreate(entry *persistencespb.NexusEndpointEntry) *endpointCircuitBreaker {
+	b.breakersLock.RLock()
+	cb, ok := b.breakers[entry.GetId()]
+	b.breakersLock.RUnlock()
+	if !ok {
+		b.breakersLock.Lock()
+		if cb, ok = b.breakers[entry.GetId()]; !ok {
+			cb = b.newCircuitBreaker(entry)
+			b.breakers[entry.GetId()] = cb
+		}
+		b.breakersLock.Unlock()
+	}
+	// Keep the latest version of the entry around in case the endpoint was updated.
+	if cb.entry.Load().GetVersion() < entry.GetVersion() {
+		cb.entry.Store(entry)
+	}
+	return cb
+}
+
+func (b *EndpointCircuitBreakers) newCircuitBreaker(entry *persistencespb.NexusEndpointEntry) *endpointCircuitBreaker {
+	cb := &endpointCircuitBreaker{}
+	cb.entry.Store(entry)
+	cb.TwoStepCircuitBreakerWithDynamicSettings = circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(
+		circuitbreaker.Settings{
+			Name: fmt.Sprintf("nexus_endpoint:%s", entry.GetId()),
+			OnStateChange: func(_ string, from gobreaker.State, to gobreaker.State) {
+				endpointName := cb.entry.Load().GetEndpoint().GetSpec().GetName()
+				metrics.NexusEndpointCircuitBreakerStateTransitions.With(b.metricsHandler).Record(
+					1,
+					metrics.NexusEndpointTag(endpointName),
+					metrics.OutcomeTag(to.String()),
+				)
+				b.logger.Info("Nexus endpoint circuit breaker changed state",
+					tag.Endpoint(endpointName),
+					tag.NewStringTag("from", from.String()),
+					tag.NewStringTag("to", to.String()),
+				)
+			},
+		},
+	)
+	initial, cancel := b.config.settings(cb.UpdateSettings)
+	cb.UpdateSettings(initial)
+	cb.cancelSettings = cancel
+	return cb
+}
+
+func (b *EndpointCircuitBreakers) probeLoopFn(ctx context.Context) error {
+	for {
+		timer := time.NewTimer(max(b.config.probeInterval(), minProbeInterval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		b.pruneDeleted(ctx)
+		if b.config.enabled() && b.config.probeEnabled() {
+			b.probeAll(ctx)
+		}
+	}
+}
+
+func (b *EndpointCircuitBreakers) snapshot() map[string]*endpointCircuitBreaker {
+	b.breakersLock.RLock()
+	defer b.breakersLock.RUnlock()
+	breakers := make(map[string]*endpointCircuitBreaker, len(b.breakers))
+	for id, cb := range b.breakers {
+		breakers[id] = cb
+	}
+	return breakers
+}
+
+// pruneDeleted removes the breakers of endpoints that no longer exist.
+func (b *EndpointCircuitBreakers) pruneDeleted(ctx context.Context) {
+	for id, cb := range b.snapshot() {
+		var notFound *serviceerror.NotFound
+		if _, err := b.endpointRegistry.GetByID(ctx, id); !errors.As(err, &notFound) {
+			continue
+		}
+		b.breakersLock.Lock()
+		if b.breakers[id] == cb {
+			delete(b.breakers, id)
+			cb.cancelSettings()
+		}
+		b.breakersLock.Unlock()
+	}
+}
+
+func (b *EndpointCircuitBreakers) probeAll(ctx context.Context) {
+	for _, cb := range b.snapshot() {
+		// Only external endpoints can be probed, worker targets are dispatched through the frontend.
+		entry := cb.entry.Load()
+		url := entry.GetEndpoint().GetSpec().GetTarget().GetExternal().GetUrl()
+		if url == "" {
+			continue
+		}
+		var probeErr error
+		switch cb.State() {
+		case gobreaker.StateOpen:
+			// Probe results only inform the breaker's description while it's open, the breaker's timeout decides
+			// when it becomes half-open.
+			probeErr = b.probe(ctx, entry, url)
+		case gobreaker.StateHalfOpen:
+			// While half-open, probes go through the breaker like any other request, a successful probe helps
+			// close it. Skip probing if the breaker already has as many requests in flight as it allows.
+			done, err := cb.Allow()
+			if err != nil {
+				continue
+			}
+			probeErr = b.probe(ctx, entry, url)
+			done(probeErr == nil)
+		default:
+			// Probes are never recorded in a closed breaker, its counts only reflect real requests.
+			continue
+		}
+		cb.lastProbe.Store(&probeResult{time: time.Now(), err: probeErr})
+
+		outcome := "success"
+		if probeErr != nil {
+			outcome = "failure"
+		}
+		endpointName := cb.entry.Load().GetEndpoint().GetSpec().GetName()
+		metrics.NexusEndpointHealthProbes.With(b.metricsHandler).Record(
+			1,
+			metrics.NexusEndpointTag(endpointName),
+			metrics.OutcomeTag(outcome),
+		)
+	}
+}
+
+// probe sends a HEAD request to the endpoint URL. The endpoint does not need to implement a dedicated health route,
+// any response means the endpoint is reachable, unless it indicates that the endpoint is overloaded or failing.
+func (b *EndpointCircuitBreakers) probe(ctx context.Context, entry *persistencespb.NexusEndpointEntry, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, b.config.probeTimeout())
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return err
+	}
+	caller, err := b.probeCallerProvider(entry)
+	if err != nil {
+		return err
+	}
+	response, err := caller(request)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("probe failed with: %v", response.Status)
+	}
+	return nil
+}
+
+func circuitBreakerStateToProto(state gobreaker.State) enumsspb.NexusEndpointCircuitBreakerState {
+	switch state {
+	case gobreaker.StateClosed:
+		return enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED
+	case gobreaker.StateHalfOpen:
+		return enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN
+	case gobreaker.StateOpen:
+		return enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN
+	default:
+		return enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+	}
+}
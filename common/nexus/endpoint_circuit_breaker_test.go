@@ -0,0 +1,209 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+var testCircuitBreakerEndpoint = &persistencespb.NexusEndpointEntry{
+	Id:      "endpoint-id",
+	Version: 1,
+	Endpoint: &persistencespb.NexusEndpoint{
+		Spec: &persistencespb.NexusEndpointSpec{
+			Name: "endpoint",
+			Target: &persistencespb.NexusEndpointTarget{
+				Variant: &persistencespb.NexusEndpointTarget_External_{
+					External: &persistencespb.NexusEndpointTarget_External{Url: "http://localhost/nexus"},
+				},
+			},
+		},
+	},
+}
+
+// fakeEndpointRegistry only implements GetByID, endpoints are found unless marked as deleted.
+type fakeEndpointRegistry struct {
+	EndpointRegistry
+	deleted map[string]bool
+}
+
+func (r *fakeEndpointRegistry) GetByID(_ context.Context, endpointID string) (*persistencespb.NexusEndpointEntry, error) {
+	if r.deleted[endpointID] {
+		return nil, serviceerror.NewNotFound("endpoint not found")
+	}
+	return testCircuitBreakerEndpoint, nil
+}
+
+func newTestEndpointCircuitBreakers(
+	t *testing.T,
+	settings map[dynamicconfig.Key]any,
+	probeCaller func(*http.Request) (*http.Response, error),
+) *EndpointCircuitBreakers {
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient(settings), log.NewTestLogger())
+	return NewEndpointCircuitBreakers(
+		NewEndpointCircuitBreakerConfig(dc),
+		&fakeEndpointRegistry{deleted: map[string]bool{}},
+		func(entry *persistencespb.NexusEndpointEntry) (func(*http.Request) (*http.Response, error), error) {
+			require.Equal(t, testCircuitBreakerEndpoint.Id, entry.GetId())
+			return probeCaller, nil
+		},
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+}
+
+func TestEndpointCircuitBreakers_TripsAfterConsecutiveFailures(t *testing.T) {
+	breakers := newTestEndpointCircuitBreakers(t, nil, nil)
+
+	info := breakers.Describe(testCircuitBreakerEndpoint.Id)
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED, info.State)
+	require.Empty(t, info.EndpointName)
+
+	// The default gobreaker settings trip after more than 5 consecutive failures.
+	for range 6 {
+		done, err := breakers.Allow(testCircuitBreakerEndpoint)
+		require.NoError(t, err)
+		done(false)
+	}
+
+	_, err := breakers.Allow(testCircuitBreakerEndpoint)
+	require.ErrorIs(t, err, ErrEndpointCircuitBreakerOpen)
+
+	info = breakers.Describe(testCircuitBreakerEndpoint.Id)
+	require.Equal(t, "endpoint", info.EndpointName)
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN, info.State)
+}
+
+func TestEndpointCircuitBreakers_Disabled(t *testing.T) {
+	breakers := newTestEndpointCircuitBreakers(t, map[dynamicconfig.Key]any{
+		dynamicconfig.NexusEndpointCircuitBreakerEnabled.Key(): false,
+	}, nil)
+
+	for range 10 {
+		done, err := breakers.Allow(testCircuitBreakerEndpoint)
+		require.NoError(t, err)
+		done(false)
+	}
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED, breakers.Describe(testCircuitBreakerEndpoint.Id).State)
+}
+
+func TestEndpointCircuitBreakers_ProbeClosesHalfOpenBreaker(t *testing.T) {
+	var probed int
+	breakers := newTestEndpointCircuitBreakers(t, map[dynamicconfig.Key]any{
+		dynamicconfig.NexusEndpointCircuitBreakerSettings.Key(): dynamicconfig.CircuitBreakerSettings{
+			Timeout: time.Millisecond,
+		},
+	}, func(r *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodHead, r.Method)
+		require.Equal(t, "http://localhost/nexus", r.URL.String())
+		probed++
+		return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+	})
+
+	for range 6 {
+		done, err := breakers.Allow(testCircuitBreakerEndpoint)
+		require.NoError(t, err)
+		done(false)
+	}
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN, breakers.Describe(testCircuitBreakerEndpoint.Id).State)
+
+	// Wait for the breaker to become half-open, a successful probe should close it.
+	require.Eventually(t, func() bool {
+		return breakers.Describe(testCircuitBreakerEndpoint.Id).State == enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_HALF_OPEN
+	}, time.Second, time.Millisecond)
+	breakers.probeAll(context.Background())
+
+	info := breakers.Describe(testCircuitBreakerEndpoint.Id)
+	require.Equal(t, 1, probed)
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED, info.State)
+	require.NotNil(t, info.LastProbeTime)
+	require.Empty(t, info.LastProbeFailure)
+}
+
+func TestEndpointCircuitBreakers_ProbeOnlyTrippedBreakers(t *testing.T) {
+	var probed int
+	status := http.StatusTooManyRequests
+	breakers := newTestEndpointCircuitBreakers(t, nil, func(r *http.Request) (*http.Response, error) {
+		probed++
+		return &http.Response{StatusCode: status, Body: http.NoBody}, nil
+	})
+
+	// Closed breakers are not probed.
+	done, err := breakers.Allow(testCircuitBreakerEndpoint)
+	require.NoError(t, err)
+	done(true)
+	breakers.probeAll(context.Background())
+	require.Equal(t, 0, probed)
+	require.Equal(t, uint32(1), breakers.Describe(testCircuitBreakerEndpoint.Id).Requests)
+
+	for range 6 {
+		done, err := breakers.Allow(testCircuitBreakerEndpoint)
+		require.NoError(t, err)
+		done(false)
+	}
+
+	// Open breakers are probed, but the probe results are only reported.
+	breakers.probeAll(context.Background())
+	info := breakers.Describe(testCircuitBreakerEndpoint.Id)
+	require.Equal(t, 1, probed)
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN, info.State)
+	require.Equal(t, uint32(0), info.Requests)
+	require.NotEmpty(t, info.LastProbeFailure)
+
+	status = http.StatusOK
+	breakers.probeAll(context.Background())
+	info = breakers.Describe(testCircuitBreakerEndpoint.Id)
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN, info.State)
+	require.Empty(t, info.LastProbeFailure)
+}
+
+func TestEndpointCircuitBreakers_PruneDeleted(t *testing.T) {
+	breakers := newTestEndpointCircuitBreakers(t, nil, nil)
+	for range 6 {
+		done, err := breakers.Allow(testCircuitBreakerEndpoint)
+		require.NoError(t, err)
+		done(false)
+	}
+
+	breakers.pruneDeleted(context.Background())
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_OPEN, breakers.Describe(testCircuitBreakerEndpoint.Id).State)
+
+	breakers.endpointRegistry.(*fakeEndpointRegistry).deleted[testCircuitBreakerEndpoint.Id] = true
+	breakers.pruneDeleted(context.Background())
+	info := breakers.Describe(testCircuitBreakerEndpoint.Id)
+	require.Equal(t, enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED, info.State)
+	require.Empty(t, info.EndpointName)
+}
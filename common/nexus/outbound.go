@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// ErrSecretNotFound is returned by a SecretProvider when a secret does not exist.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// reservedAuthHeaders may not be overridden by an endpoint's auth header since they are set by the Nexus client.
+var reservedAuthHeaders = map[string]struct{}{
+	"Host":            {},
+	"Content-Type":    {},
+	"Content-Length":  {},
+	"Request-Timeout": {},
+}
+
+// SecretProvider resolves named secrets used to authenticate outbound Nexus requests to external endpoints.
+type SecretProvider interface {
+	GetSecret(ctx context.Context, name string) (string, error)
+}
+
+// EnvSecretProvider is a SecretProvider that resolves secrets from environment variables.
+type EnvSecretProvider struct{}
+
+func (EnvSecretProvider) GetSecret(_ context.Context, name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrSecretNotFound, name)
+	}
+	return value, nil
+}
+
+// ValidateExternalTarget validates the shape of the outbound TLS and auth header settings of an external target.
+// Certificate and CA files are not read and secrets are not resolved since they are only required to be available to
+// the service making the outbound requests.
+func ValidateExternalTarget(target *persistencespb.NexusEndpointTarget_External) error {
+	var errs []error
+	if tlsSettings := target.GetTls(); tlsSettings != nil {
+		if u, err := url.Parse(target.GetUrl()); err == nil && u.Scheme != "https" {
+			errs = append(errs, fmt.Errorf("TLS settings require an https URL, got scheme %q", u.Scheme))
+		}
+		if strings.ContainsAny(tlsSettings.GetServerName(), ":/ ") {
+			errs = append(errs, fmt.Errorf("invalid TLS server name: %q", tlsSettings.GetServerName()))
+		}
+		if (tlsSettings.GetClientCertFile() == "") != (tlsSettings.GetClientKeyFile() == "") {
+			errs = append(errs, errors.New("TLS client certificate and key files must be set together"))
+		}
+	}
+	if auth := target.GetAuthHeader(); auth != nil {
+		if !isValidHeaderName(auth.GetName()) {
+			errs = append(errs, fmt.Errorf("invalid auth header name: %q", auth.GetName()))
+		} else if _, ok := reservedAuthHeaders[http.CanonicalHeaderKey(auth.GetName())]; ok ||
+			strings.HasPrefix(strings.ToLower(auth.GetName()), "nexus-") {
+			errs = append(errs, fmt.Errorf("auth header name is reserved: %q", auth.GetName()))
+		}
+		if auth.GetSecretName() == "" {
+			errs = append(errs, errors.New("auth header secret name not set"))
+		}
+		if strings.ContainsAny(auth.GetValuePrefix(), "\r\n") {
+			errs = append(errs, errors.New("auth header value prefix contains invalid characters"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewExternalTargetTLSConfig builds a client TLS config from an external target's TLS settings, verifying the server
+// against the CA file or the system roots if no CA file is set. The client certificate is read when a connection is
+// established and read again once its files are modified.
+func NewExternalTargetTLSConfig(settings *persistencespb.NexusEndpointTarget_TLS) (*tls.Config, error) {
+	tlsConfig := newClientTLSConfig(settings)
+	if settings.GetCaFile() != "" {
+		rootCAs, err := loadCertPool(settings.GetCaFile())
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	return tlsConfig, nil
+}
+
+// NewExternalTargetTransport applies an external target's outbound settings to a base transport.
+// TLS settings require the base transport to be an *http.Transport. A rotated CA file is picked up by new
+// connections. The auth header secret is resolved on every request to support secret rotation.
+func NewExternalTargetTransport(
+	base http.RoundTripper,
+	target *persistencespb.NexusEndpointTarget_External,
+	secretProvider SecretProvider,
+) (http.RoundTripper, error) {
+	if err := ValidateExternalTarget(target); err != nil {
+		return nil, err
+	}
+	transport := base
+	if tlsSettings := target.GetTls(); tlsSettings != nil {
+		httpTransport, ok := base.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("cannot apply endpoint TLS settings to transport of type %T", base)
+		}
+		httpTransport = httpTransport.Clone()
+		httpTransport.TLSClientConfig = newClientTLSConfig(tlsSettings)
+		transport = httpTransport
+		if caFile := tlsSettings.GetCaFile(); caFile != "" {
+			transport = &rootCAsTransport{
+				base: httpTransport,
+				rootCAs: newFileReloader(func() (*x509.CertPool, error) {
+					return loadCertPool(caFile)
+				}, caFile),
+			}
+		}
+	}
+	if auth := target.GetAuthHeader(); auth != nil {
+		transport = &authHeaderTransport{
+			base:           transport,
+			name:           auth.GetName(),
+			secretName:     auth.GetSecretName(),
+			valuePrefix:    auth.GetValuePrefix(),
+			secretProvider: secretProvider,
+		}
+	}
+	return transport, nil
+}
+
+func newClientTLSConfig(settings *persistencespb.NexusEndpointTarget_TLS) *tls.Config {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: settings.GetServerName(),
+	}
+	if certFile, keyFile := settings.GetClientCertFile(), settings.GetClientKeyFile(); certFile != "" {
+		cert := newFileReloader(func() (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load TLS client certificate: %w", err)
+			}
+			return &cert, nil
+		}, certFile, keyFile)
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cert.get()
+		}
+	}
+	return tlsConfig
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read TLS CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificates found in TLS CA file %q", caFile)
+	}
+	return pool, nil
+}
+
+// rootCAsTransport sends requests with a clone of base that verifies servers against the current CA pool. The clone
+// is replaced once the CA file is modified since the RootCAs of a TLS config must not change while it's in use.
+type rootCAsTransport struct {
+	base    *http.Transport
+	rootCAs *fileReloader[*x509.CertPool]
+
+	mu        sync.Mutex
+	pool      *x509.CertPool
+	transport *http.Transport
+}
+
+func (t *rootCAsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	transport, err := t.current()
+	if err != nil {
+		return nil, err
+	}
+	return transport.RoundTrip(r)
+}
+
+func (t *rootCAsTransport) current() (*http.Transport, error) {
+	pool, err := t.rootCAs.get()
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if pool != t.pool {
+		transport := t.base.Clone()
+		transport.TLSClientConfig.RootCAs = pool
+		if t.transport != nil {
+			t.transport.CloseIdleConnections()
+		}
+		t.pool, t.transport = pool, transport
+	}
+	return t.transport, nil
+}
+
+// fileReloader caches a value loaded from files and loads it again once any of the files is modified.
+type fileReloader[T any] struct {
+	load  func() (T, error)
+	paths []string
+
+	mu       sync.Mutex
+	value    T
+	modTimes []time.Time
+}
+
+func newFileReloader[T any](load func() (T, error), paths ...string) *fileReloader[T] {
+	return &fileReloader[T]{load: load, paths: paths}
+}
+
+func (r *fileReloader[T]) get() (T, error) {
+	var zero T
+	modTimes := make([]time.Time, len(r.paths))
+	for i, path := range r.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return zero, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.modTimes != nil && slices.EqualFunc(r.modTimes, modTimes, time.Time.Equal) {
+		return r.value, nil
+	}
+	value, err := r.load()
+	if err != nil {
+		return zero, err
+	}
+	r.value, r.modTimes = value, modTimes
+	return value, nil
+}
+
+type authHeaderTransport struct {
+	base                          http.RoundTripper
+	name, secretName, valuePrefix string
+	secretProvider                SecretProvider
+}
+
+func (t *authHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	secret, err := t.secretProvider.GetSecret(r.Context(), t.secretName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve auth header secret: %w", err)
+	}
+	// RoundTrippers must not modify the original request.
+	r = r.Clone(r.Context())
+	r.Header.Set(t.name, t.valuePrefix+secret)
+	return t.base.RoundTrip(r)
+}
+
+// isValidHeaderName reports whether name is a valid HTTP header field name (an RFC 7230 token).
+func isValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r >= 0x80 || r <= ' ' || strings.ContainsRune("\"(),/:;<=>?@[\\]{}", r) || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
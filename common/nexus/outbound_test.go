@@ -0,0 +1,308 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nexus
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type staticSecretProvider map[string]string
+
+func (p staticSecretProvider) GetSecret(_ context.Context, name string) (string, error) {
+	if v, ok := p[name]; ok {
+		return v, nil
+	}
+	return "", ErrSecretNotFound
+}
+
+func writeClientKeyPair(t *testing.T, dir string) (certFile, keyFile string, cert *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "nexus-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "client.pem")
+	keyFile = filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile, cert
+}
+
+func TestValidateExternalTarget(t *testing.T) {
+	cases := []struct {
+		name   string
+		target *persistencespb.NexusEndpointTarget_External
+		errMsg string
+	}{
+		{
+			name:   "no outbound settings",
+			target: &persistencespb.NexusEndpointTarget_External{Url: "http://example.com"},
+		},
+		{
+			name: "valid",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url: "https://example.com",
+				Tls: &persistencespb.NexusEndpointTarget_TLS{
+					// Files are only read by the service making the outbound requests.
+					ClientCertFile: "/does/not/exist/client.pem",
+					ClientKeyFile:  "/does/not/exist/client.key",
+					CaFile:         "/does/not/exist/ca.pem",
+					ServerName:     "internal.example.com",
+				},
+				AuthHeader: &persistencespb.NexusEndpointTarget_AuthHeader{
+					Name:        "Authorization",
+					SecretName:  "TOKEN",
+					ValuePrefix: "Bearer ",
+				},
+			},
+		},
+		{
+			name: "TLS with http URL",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url: "http://example.com",
+				Tls: &persistencespb.NexusEndpointTarget_TLS{ServerName: "example.com"},
+			},
+			errMsg: "TLS settings require an https URL",
+		},
+		{
+			name: "cert without key",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url: "https://example.com",
+				Tls: &persistencespb.NexusEndpointTarget_TLS{ClientCertFile: "client.pem"},
+			},
+			errMsg: "must be set together",
+		},
+		{
+			name: "invalid server name",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url: "https://example.com",
+				Tls: &persistencespb.NexusEndpointTarget_TLS{ServerName: "example.com:443"},
+			},
+			errMsg: "invalid TLS server name",
+		},
+		{
+			name: "invalid header name",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url:        "https://example.com",
+				AuthHeader: &persistencespb.NexusEndpointTarget_AuthHeader{Name: "Bad Header", SecretName: "TOKEN"},
+			},
+			errMsg: "invalid auth header name",
+		},
+		{
+			name: "reserved header name",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url:        "https://example.com",
+				AuthHeader: &persistencespb.NexusEndpointTarget_AuthHeader{Name: "nexus-link", SecretName: "TOKEN"},
+			},
+			errMsg: "auth header name is reserved",
+		},
+		{
+			name: "missing secret name",
+			target: &persistencespb.NexusEndpointTarget_External{
+				Url:        "https://example.com",
+				AuthHeader: &persistencespb.NexusEndpointTarget_AuthHeader{Name: "Authorization"},
+			},
+			errMsg: "secret name not set",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateExternalTarget(tc.target)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestNewExternalTargetTransport_MutualTLSAndAuthHeader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, clientCert := writeClientKeyPair(t, dir)
+
+	var gotAuth string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	defer srv.Close()
+
+	serverCAFile := filepath.Join(dir, "server-ca.pem")
+	require.NoError(t, os.WriteFile(serverCAFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+
+	target := &persistencespb.NexusEndpointTarget_External{
+		Url: srv.URL,
+		Tls: &persistencespb.NexusEndpointTarget_TLS{
+			ClientCertFile: certFile,
+			ClientKeyFile:  keyFile,
+			CaFile:         serverCAFile,
+			// The httptest certificate is issued for example.com.
+			ServerName: "example.com",
+		},
+		AuthHeader: &persistencespb.NexusEndpointTarget_AuthHeader{
+			Name:        "Authorization",
+			SecretName:  "TOKEN",
+			ValuePrefix: "Bearer ",
+		},
+	}
+
+	transport, err := NewExternalTargetTransport(http.DefaultTransport, target, staticSecretProvider{"TOKEN": "secret"})
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "Bearer secret", gotAuth)
+
+	// Without a client certificate the handshake is rejected.
+	target.Tls.ClientCertFile, target.Tls.ClientKeyFile = "", ""
+	transport, err = NewExternalTargetTransport(http.DefaultTransport, target, staticSecretProvider{"TOKEN": "secret"})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(srv.URL) //nolint:bodyclose
+	require.Error(t, err)
+
+	// Unresolvable secrets fail the request.
+	target.Tls = nil
+	transport, err = NewExternalTargetTransport(http.DefaultTransport, target, staticSecretProvider{})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(srv.URL) //nolint:bodyclose
+	require.ErrorIs(t, err, ErrSecretNotFound)
+}
+
+func TestNewExternalTargetTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	_, err := NewExternalTargetTLSConfig(&persistencespb.NexusEndpointTarget_TLS{CaFile: filepath.Join(dir, "missing.pem")})
+	require.ErrorContains(t, err, "cannot read TLS CA file")
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+	tlsConfig, err := NewExternalTargetTLSConfig(&persistencespb.NexusEndpointTarget_TLS{
+		CaFile:     caFile,
+		ServerName: "example.com",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig.RootCAs)
+	require.False(t, tlsConfig.InsecureSkipVerify)
+
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	httpTransport.TLSClientConfig = tlsConfig
+	resp, err := (&http.Client{Transport: httpTransport}).Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewExternalTargetTransport_ReloadsRotatedCAFile(t *testing.T) {
+	dir := t.TempDir()
+	otherCertFile, _, _ := writeClientKeyPair(t, dir)
+	otherCA, err := os.ReadFile(otherCertFile)
+	require.NoError(t, err)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, otherCA, 0600))
+	transport, err := NewExternalTargetTransport(http.DefaultTransport, &persistencespb.NexusEndpointTarget_External{
+		Url: srv.URL,
+		Tls: &persistencespb.NexusEndpointTarget_TLS{
+			CaFile:     caFile,
+			ServerName: "example.com",
+		},
+	}, EnvSecretProvider{})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	_, err = client.Get(srv.URL) //nolint:bodyclose
+	require.ErrorContains(t, err, "certificate signed by unknown authority")
+
+	// Rotate the CA file, new connections use it without recreating the transport.
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(caFile, modTime, modTime))
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewExternalTargetTransport_UnsupportedBaseTransport(t *testing.T) {
+	target := &persistencespb.NexusEndpointTarget_External{
+		Url: "https://example.com",
+		Tls: &persistencespb.NexusEndpointTarget_TLS{ServerName: "example.com"},
+	}
+	_, err := NewExternalTargetTransport(roundTripperFunc(http.DefaultTransport.RoundTrip), target, EnvSecretProvider{})
+	require.ErrorContains(t, err, "cannot apply endpoint TLS settings")
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -25,6 +25,8 @@
 package cassandra
 
 import (
+	"cmp"
+	"context"
 	"sync"
 
 	"github.com/gocql/gocql"
@@ -45,6 +47,10 @@ type (
 		clusterName string
 		logger      log.Logger
 		session     commongocql.Session
+
+		lwt          *LWTOptions
+		shardLWT     *LWTOptions
+		executionLWT *LWTOptions
 	}
 )
 
@@ -67,6 +73,11 @@ func NewFactory(
 	if err != nil {
 		logger.Fatal("unable to initialize cassandra session", tag.Error(err))
 	}
+	if cfg.ScyllaDB != nil {
+		if err := commongocql.CheckScyllaDBTablets(context.Background(), session, cfg.Keyspace); err != nil {
+			logger.Fatal("unable to use scylladb keyspace", tag.Error(err))
+		}
+	}
 	return NewFactoryFromSession(cfg, clusterName, logger, session)
 }
 
@@ -77,53 +88,66 @@ func NewFactoryFromSession(
 	logger log.Logger,
 	session commongocql.Session,
 ) *Factory {
-	return &Factory{
+	f := &Factory{
 		cfg:         cfg,
 		clusterName: clusterName,
 		logger:      logger,
 		session:     session,
 	}
+	if cfg.ScyllaDB != nil {
+		var err error
+		if f.lwt, err = NewLWTOptions(cfg.ScyllaDB.LWT); err != nil {
+			logger.Fatal("invalid scylladb LWT settings", tag.Error(err))
+		}
+		if f.shardLWT, err = NewLWTOptions(cmp.Or(cfg.ScyllaDB.ShardLWT, cfg.ScyllaDB.LWT)); err != nil {
+			logger.Fatal("invalid scylladb shard LWT settings", tag.Error(err))
+		}
+		if f.executionLWT, err = NewLWTOptions(cmp.Or(cfg.ScyllaDB.ExecutionLWT, cfg.ScyllaDB.LWT)); err != nil {
+			logger.Fatal("invalid scylladb execution LWT settings", tag.Error(err))
+		}
+	}
+	return f
 }
 
 // NewTaskStore returns a new task store
 func (f *Factory) NewTaskStore() (p.TaskStore, error) {
-	return NewMatchingTaskStore(f.session, f.logger), nil
+	return NewMatchingTaskStore(newLWTSession(f.session, f.lwt), f.logger), nil
 }
 
 // NewShardStore returns a new shard store
 func (f *Factory) NewShardStore() (p.ShardStore, error) {
-	return NewShardStore(f.clusterName, f.session, f.logger), nil
+	return NewShardStore(f.clusterName, newLWTSession(f.session, f.shardLWT), f.logger), nil
 }
 
 // NewMetadataStore returns a metadata store
 func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
-	return NewMetadataStore(f.clusterName, f.session, f.logger)
+	return NewMetadataStore(f.clusterName, newLWTSession(f.session, f.lwt), f.logger)
 }
 
 // NewClusterMetadataStore returns a metadata store
 func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
-	return NewClusterMetadataStore(f.session, f.logger)
+	return NewClusterMetadataStore(newLWTSession(f.session, f.lwt), f.logger)
 }
 
 // NewExecutionStore returns a new ExecutionStore.
 func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
-	return NewExecutionStore(f.session), nil
+	return NewExecutionStore(newLWTSession(f.session, f.executionLWT)), nil
 }
 
 // NewQueue returns a new queue backed by cassandra
 func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
-	return NewQueueStore(queueType, f.session, f.logger)
+	return NewQueueStore(queueType, newLWTSession(f.session, f.lwt), f.logger)
 }
 
 // NewQueueV2 returns a new data-access object for queues and messages stored in Cassandra. It will never return an
 // error.
 func (f *Factory) NewQueueV2() (p.QueueV2, error) {
-	return NewQueueV2Store(f.session, f.logger), nil
+	return NewQueueV2Store(newLWTSession(f.session, f.lwt), f.logger), nil
 }
 
 // NewNexusEndpointStore returns a new NexusEndpointStore
 func (f *Factory) NewNexusEndpointStore() (p.NexusEndpointStore, error) {
-	return NewNexusEndpointStore(f.session, f.logger), nil
+	return NewNexusEndpointStore(newLWTSession(f.session, f.lwt), f.logger), nil
 }
 
 // Close closes the factory
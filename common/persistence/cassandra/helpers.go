@@ -36,6 +36,16 @@ const cassandraPersistenceName = "cassandra"
 
 // CreateCassandraKeyspace creates the keyspace using this session for given replica count
 func CreateCassandraKeyspace(s gocql.Session, keyspace string, replicas int, overwrite bool, logger log.Logger) (err error) {
+	return createKeyspace(s, keyspace, replicas, overwrite, "", logger)
+}
+
+// CreateScyllaDBKeyspace creates the keyspace like CreateCassandraKeyspace, but with tablets disabled since
+// ScyllaDB doesn't support lightweight transactions on tablets
+func CreateScyllaDBKeyspace(s gocql.Session, keyspace string, replicas int, overwrite bool, logger log.Logger) (err error) {
+	return createKeyspace(s, keyspace, replicas, overwrite, ` AND tablets = {'enabled': false}`, logger)
+}
+
+func createKeyspace(s gocql.Session, keyspace string, replicas int, overwrite bool, options string, logger log.Logger) (err error) {
 	// if overwrite flag is set, drop the keyspace and create a new one
 	if overwrite {
 		err = DropCassandraKeyspace(s, keyspace, logger)
@@ -45,7 +55,7 @@ func CreateCassandraKeyspace(s gocql.Session, keyspace string, replicas int, ove
 		}
 	}
 	err = s.Query(fmt.Sprintf(`CREATE KEYSPACE IF NOT EXISTS %s WITH replication = {
-		'class' : 'SimpleStrategy', 'replication_factor' : %d}`, keyspace, replicas)).Exec()
+		'class' : 'SimpleStrategy', 'replication_factor' : %d}%s`, keyspace, replicas, options)).Exec()
 	if err != nil {
 		logger.Error("create keyspace error", tag.Error(err))
 		return
@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+type (
+	// LWTOptions tunes the lightweight transactions (compare-and-set) issued by a store.
+	// A nil *LWTOptions keeps the session defaults.
+	LWTOptions struct {
+		Consistency       *gocql.Consistency
+		SerialConsistency *gocql.SerialConsistency
+		Timeout           time.Duration
+	}
+
+	// lwtSession applies LWTOptions to the lightweight transactions issued through it, leaving all other
+	// queries and batches untouched
+	lwtSession struct {
+		gocql.Session
+		opts *LWTOptions
+	}
+
+	lwtQuery struct {
+		gocql.Query
+		ctx  context.Context
+		opts *LWTOptions
+	}
+
+	lwtIter struct {
+		gocql.Iter
+		cancel context.CancelFunc
+	}
+)
+
+var _ gocql.Session = (*lwtSession)(nil)
+var _ gocql.Query = (*lwtQuery)(nil)
+
+// NewLWTOptions converts the LWT settings of the configuration, returning nil if cfg is nil.
+func NewLWTOptions(cfg *config.ScyllaDBLWTSettings) (*LWTOptions, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	opts := &LWTOptions{Timeout: cfg.Timeout}
+	if cfg.Consistency != "" {
+		c, err := gocql.ParseConsistency(cfg.Consistency)
+		if err != nil {
+			return nil, err
+		}
+		opts.Consistency = &c
+	}
+	if cfg.SerialConsistency != "" {
+		c, err := gocql.ParseSerialConsistency(cfg.SerialConsistency)
+		if err != nil {
+			return nil, err
+		}
+		opts.SerialConsistency = &c
+	}
+	return opts, nil
+}
+
+// newLWTSession returns a session which applies opts to all lightweight transactions, or session itself if
+// opts is nil
+func newLWTSession(session gocql.Session, opts *LWTOptions) gocql.Session {
+	if opts == nil {
+		return session
+	}
+	return &lwtSession{Session: session, opts: opts}
+}
+
+func (o *LWTOptions) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if o.Timeout == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, o.Timeout)
+}
+
+func (s *lwtSession) Query(stmt string, values ...interface{}) gocql.Query {
+	return &lwtQuery{Query: s.Session.Query(stmt, values...), opts: s.opts}
+}
+
+func (s *lwtSession) MapExecuteBatchCAS(batch *gocql.Batch, previous map[string]interface{}) (bool, gocql.Iter, error) {
+	ctx, cancel := s.opts.withTimeout(batch.Context())
+	batch = batch.WithContext(ctx)
+	if s.opts.Consistency != nil {
+		batch = batch.Consistency(*s.opts.Consistency)
+	}
+	if s.opts.SerialConsistency != nil {
+		batch = batch.SerialConsistency(*s.opts.SerialConsistency)
+	}
+	applied, iter, err := s.Session.MapExecuteBatchCAS(batch, previous)
+	if iter == nil {
+		cancel()
+		return applied, iter, err
+	}
+	// the conflicting rows are read after the batch returns, so the timeout ends with the iterator
+	return applied, &lwtIter{Iter: iter, cancel: cancel}, err
+}
+
+func (q *lwtQuery) ScanCAS(dest ...interface{}) (bool, error) {
+	ctx, cancel := q.opts.withTimeout(q.ctx)
+	defer cancel()
+	return q.cas(ctx).ScanCAS(dest...)
+}
+
+func (q *lwtQuery) MapScanCAS(dest map[string]interface{}) (bool, error) {
+	ctx, cancel := q.opts.withTimeout(q.ctx)
+	defer cancel()
+	return q.cas(ctx).MapScanCAS(dest)
+}
+
+func (q *lwtQuery) cas(ctx context.Context) gocql.Query {
+	query := q.Query.WithContext(ctx)
+	if q.opts.Consistency != nil {
+		query = query.Consistency(*q.opts.Consistency)
+	}
+	if q.opts.SerialConsistency != nil {
+		query = query.SerialConsistency(*q.opts.SerialConsistency)
+	}
+	return query
+}
+
+func (q *lwtQuery) PageSize(n int) gocql.Query {
+	return &lwtQuery{Query: q.Query.PageSize(n), ctx: q.ctx, opts: q.opts}
+}
+
+func (q *lwtQuery) PageState(state []byte) gocql.Query {
+	return &lwtQuery{Query: q.Query.PageState(state), ctx: q.ctx, opts: q.opts}
+}
+
+func (q *lwtQuery) WithContext(ctx context.Context) gocql.Query {
+	return &lwtQuery{Query: q.Query.WithContext(ctx), ctx: ctx, opts: q.opts}
+}
+
+func (q *lwtQuery) WithTimestamp(timestamp int64) gocql.Query {
+	return &lwtQuery{Query: q.Query.WithTimestamp(timestamp), ctx: q.ctx, opts: q.opts}
+}
+
+func (q *lwtQuery) Consistency(c gocql.Consistency) gocql.Query {
+	return &lwtQuery{Query: q.Query.Consistency(c), ctx: q.ctx, opts: q.opts}
+}
+
+func (q *lwtQuery) SerialConsistency(c gocql.SerialConsistency) gocql.Query {
+	return &lwtQuery{Query: q.Query.SerialConsistency(c), ctx: q.ctx, opts: q.opts}
+}
+
+func (q *lwtQuery) Bind(values ...interface{}) gocql.Query {
+	return &lwtQuery{Query: q.Query.Bind(values...), ctx: q.ctx, opts: q.opts}
+}
+
+func (i *lwtIter) Close() error {
+	defer i.cancel()
+	return i.Iter.Close()
+}
@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+func TestNewLWTOptions(t *testing.T) {
+	opts, err := NewLWTOptions(nil)
+	require.NoError(t, err)
+	require.Nil(t, opts)
+
+	opts, err = NewLWTOptions(&config.ScyllaDBLWTSettings{Timeout: time.Second})
+	require.NoError(t, err)
+	require.Nil(t, opts.Consistency)
+	require.Nil(t, opts.SerialConsistency)
+	require.Equal(t, time.Second, opts.Timeout)
+
+	opts, err = NewLWTOptions(&config.ScyllaDBLWTSettings{
+		Consistency:       "local_quorum",
+		SerialConsistency: "SERIAL",
+	})
+	require.NoError(t, err)
+	require.Equal(t, gocql.LocalQuorum, *opts.Consistency)
+	require.Equal(t, gocql.Serial, *opts.SerialConsistency)
+
+	_, err = NewLWTOptions(&config.ScyllaDBLWTSettings{SerialConsistency: "quorum"})
+	require.Error(t, err)
+}
+
+type fakeQuery struct {
+	gocql.Query
+	ctx               context.Context
+	consistency       *gocql.Consistency
+	serialConsistency *gocql.SerialConsistency
+}
+
+func (q *fakeQuery) WithContext(ctx context.Context) gocql.Query {
+	q.ctx = ctx
+	return q
+}
+
+func (q *fakeQuery) Consistency(c gocql.Consistency) gocql.Query {
+	q.consistency = &c
+	return q
+}
+
+func (q *fakeQuery) SerialConsistency(c gocql.SerialConsistency) gocql.Query {
+	q.serialConsistency = &c
+	return q
+}
+
+func (q *fakeQuery) MapScanCAS(map[string]interface{}) (bool, error) {
+	_, ok := q.ctx.Deadline()
+	return ok, nil
+}
+
+type fakeSession struct {
+	gocql.Session
+	query *fakeQuery
+}
+
+func (s *fakeSession) Query(string, ...interface{}) gocql.Query {
+	return s.query
+}
+
+func TestLWTSession(t *testing.T) {
+	session := &fakeSession{query: &fakeQuery{}}
+	require.Same(t, session, newLWTSession(session, nil))
+
+	serial := gocql.LocalSerial
+	lwtSession := newLWTSession(session, &LWTOptions{SerialConsistency: &serial, Timeout: time.Second})
+
+	// only compare-and-set operations are tuned
+	query := lwtSession.Query("UPDATE ... IF range_id = ?").WithContext(context.Background()).Consistency(gocql.One)
+	require.Nil(t, session.query.serialConsistency)
+	require.Equal(t, gocql.One, *session.query.consistency)
+
+	hasDeadline, err := query.MapScanCAS(nil)
+	require.NoError(t, err)
+	require.True(t, hasDeadline)
+	require.Equal(t, gocql.LocalSerial, *session.query.serialConsistency)
+	require.Equal(t, gocql.One, *session.query.consistency)
+}
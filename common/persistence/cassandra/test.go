@@ -83,6 +83,9 @@ func NewTestCluster(keyspace, username, password, host string, port int, schemaD
 		ConnectTimeout: 30 * time.Second * debug.TimeoutMultiplier,
 		Keyspace:       keyspace,
 	}
+	if environment.GetCassandraScyllaDB() {
+		result.cfg.ScyllaDB = &config.ScyllaDB{}
+	}
 	result.faultInjection = faultInjection
 	return &result
 }
@@ -150,6 +153,7 @@ func (s *TestCluster) CreateSession(
 							},
 						},
 						ConnectTimeout: s.cfg.ConnectTimeout,
+						ScyllaDB:       s.cfg.ScyllaDB,
 					},
 					resolver.NewNoopResolver(),
 				)
@@ -175,7 +179,11 @@ func (s *TestCluster) CreateSession(
 
 // CreateDatabase from PersistenceTestCluster interface
 func (s *TestCluster) CreateDatabase() {
-	err := CreateCassandraKeyspace(s.session, s.DatabaseName(), 1, true, s.logger)
+	createKeyspace := CreateCassandraKeyspace
+	if s.cfg.ScyllaDB != nil {
+		createKeyspace = CreateScyllaDBKeyspace
+	}
+	err := createKeyspace(s.session, s.DatabaseName(), 1, true, s.logger)
 	if err != nil {
 		s.logger.Fatal("CreateCassandraKeyspace", tag.Error(err))
 	}
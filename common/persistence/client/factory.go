@@ -64,6 +64,8 @@ type (
 		NewClusterMetadataManager() (persistence.ClusterMetadataManager, error)
 		// NewHistoryTaskQueueManager returns a new manager for history task queues
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
+		// NewUsageRecordManager returns a new manager for metered usage records
+		NewUsageRecordManager() (persistence.UsageRecordManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
 	}
@@ -234,6 +236,14 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, serialization.NewSerializer()), nil
 }
 
+func (f *factoryImpl) NewUsageRecordManager() (persistence.UsageRecordManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewUsageRecordManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
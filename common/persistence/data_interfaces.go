@@ -1331,6 +1331,49 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	// UsageRecordManager stores the resource usage metered by the history service. Records are appended to a queue per
+	// namespace and are read back in the order in which they were written. The records of a single append are written
+	// atomically and share the same window end time.
+	UsageRecordManager interface {
+		Closeable
+		AppendUsageRecords(ctx context.Context, request *AppendUsageRecordsRequest) error
+		ListUsageRecords(ctx context.Context, request *ListUsageRecordsRequest) (*ListUsageRecordsResponse, error)
+		// TrimUsageRecords deletes the records of a namespace whose windows ended before OlderThan.
+		TrimUsageRecords(ctx context.Context, request *TrimUsageRecordsRequest) error
+	}
+
+	AppendUsageRecordsRequest struct {
+		NamespaceID string
+		// FlushID uniquely identifies the records of the append.
+		FlushID string
+		Records []*persistencespb.UsageRecord
+		// Retry is set when an earlier append with the same FlushID and records failed. It may have been applied
+		// nonetheless, e.g. if it timed out, so the records are only appended if they can't be found.
+		Retry bool
+	}
+
+	ListUsageRecordsRequest struct {
+		NamespaceID string
+		// PageSize is the number of appends to read. Each may hold several records.
+		PageSize      int
+		NextPageToken []byte
+		// Only records whose windows overlap [StartTime, EndTime) are returned. A zero time leaves that end of the
+		// range unbounded. When StartTime is set, the first page starts at the records written around StartTime
+		// instead of at the oldest record.
+		StartTime time.Time
+		EndTime   time.Time
+	}
+
+	ListUsageRecordsResponse struct {
+		Records       []*persistencespb.UsageRecord
+		NextPageToken []byte
+	}
+
+	TrimUsageRecordsRequest struct {
+		NamespaceID string
+		OlderThan   time.Time
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
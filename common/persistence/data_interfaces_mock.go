@@ -1469,3 +1469,82 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockUsageRecordManager is a mock of UsageRecordManager interface.
+type MockUsageRecordManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageRecordManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageRecordManagerMockRecorder is the mock recorder for MockUsageRecordManager.
+type MockUsageRecordManagerMockRecorder struct {
+	mock *MockUsageRecordManager
+}
+
+// NewMockUsageRecordManager creates a new mock instance.
+func NewMockUsageRecordManager(ctrl *gomock.Controller) *MockUsageRecordManager {
+	mock := &MockUsageRecordManager{ctrl: ctrl}
+	mock.recorder = &MockUsageRecordManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageRecordManager) EXPECT() *MockUsageRecordManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendUsageRecords mocks base method.
+func (m *MockUsageRecordManager) AppendUsageRecords(ctx context.Context, request *AppendUsageRecordsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendUsageRecords", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendUsageRecords indicates an expected call of AppendUsageRecords.
+func (mr *MockUsageRecordManagerMockRecorder) AppendUsageRecords(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendUsageRecords", reflect.TypeOf((*MockUsageRecordManager)(nil).AppendUsageRecords), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockUsageRecordManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockUsageRecordManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockUsageRecordManager)(nil).Close))
+}
+
+// ListUsageRecords mocks base method.
+func (m *MockUsageRecordManager) ListUsageRecords(ctx context.Context, request *ListUsageRecordsRequest) (*ListUsageRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsageRecords", ctx, request)
+	ret0, _ := ret[0].(*ListUsageRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageRecords indicates an expected call of ListUsageRecords.
+func (mr *MockUsageRecordManagerMockRecorder) ListUsageRecords(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecords", reflect.TypeOf((*MockUsageRecordManager)(nil).ListUsageRecords), ctx, request)
+}
+
+// TrimUsageRecords mocks base method.
+func (m *MockUsageRecordManager) TrimUsageRecords(ctx context.Context, request *TrimUsageRecordsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimUsageRecords", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrimUsageRecords indicates an expected call of TrimUsageRecords.
+func (mr *MockUsageRecordManagerMockRecorder) TrimUsageRecords(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimUsageRecords", reflect.TypeOf((*MockUsageRecordManager)(nil).TrimUsageRecords), ctx, request)
+}
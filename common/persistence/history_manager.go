@@ -168,6 +168,7 @@ func (m *executionManagerImpl) DeleteHistoryBranch(
 
 	// Get the history tree containing the branch to be delelted,
 	// so we know if any part of the target branch is referenced by other branches.
+	// This must not allow stale reads, a lagging read replica could miss a recently forked branch.
 	historyTreeResp, err := m.persistence.GetHistoryTreeContainingBranch(ctx, &InternalGetHistoryTreeContainingBranchRequest{
 		BranchToken: request.BranchToken,
 		ShardID:     request.ShardID,
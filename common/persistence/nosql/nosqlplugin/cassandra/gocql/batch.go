@@ -64,11 +64,25 @@ func (b *Batch) WithContext(ctx context.Context) *Batch {
 	return newBatch(b.session, b.gocqlBatch.WithContext(ctx))
 }
 
+func (b *Batch) Context() context.Context {
+	return b.gocqlBatch.Context()
+}
+
 func (b *Batch) WithTimestamp(timestamp int64) *Batch {
 	b.gocqlBatch.WithTimestamp(timestamp)
 	return newBatch(b.session, b.gocqlBatch)
 }
 
+func (b *Batch) Consistency(c Consistency) *Batch {
+	b.gocqlBatch.SetConsistency(mustConvertConsistency(c))
+	return newBatch(b.session, b.gocqlBatch)
+}
+
+func (b *Batch) SerialConsistency(c SerialConsistency) *Batch {
+	b.gocqlBatch.SerialConsistency(mustConvertSerialConsistency(c))
+	return newBatch(b.session, b.gocqlBatch)
+}
+
 func mustConvertBatchType(batchType BatchType) gocql.BatchType {
 	switch batchType {
 	case LoggedBatch:
@@ -26,6 +26,7 @@ package gocql
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gocql/gocql"
 )
@@ -73,3 +74,52 @@ func mustConvertConsistency(c Consistency) gocql.Consistency {
 		panic(fmt.Sprintf("Unknown gocql Consistency level: %v", c))
 	}
 }
+
+func mustConvertSerialConsistency(c SerialConsistency) gocql.SerialConsistency {
+	switch c {
+	case Serial:
+		return gocql.Serial
+	case LocalSerial:
+		return gocql.LocalSerial
+	default:
+		panic(fmt.Sprintf("Unknown gocql SerialConsistency level: %v", c))
+	}
+}
+
+// ParseConsistency parses a consistency level name as used in the configuration, e.g. LOCAL_QUORUM.
+func ParseConsistency(s string) (Consistency, error) {
+	switch strings.ToUpper(s) {
+	case "ANY":
+		return Any, nil
+	case "ONE":
+		return One, nil
+	case "TWO":
+		return Two, nil
+	case "THREE":
+		return Three, nil
+	case "QUORUM":
+		return Quorum, nil
+	case "ALL":
+		return All, nil
+	case "LOCAL_QUORUM":
+		return LocalQuorum, nil
+	case "EACH_QUORUM":
+		return EachQuorum, nil
+	case "LOCAL_ONE":
+		return LocalOne, nil
+	default:
+		return 0, fmt.Errorf("invalid consistency %q", s)
+	}
+}
+
+// ParseSerialConsistency parses a serial consistency level name as used in the configuration, e.g. LOCAL_SERIAL.
+func ParseSerialConsistency(s string) (SerialConsistency, error) {
+	switch strings.ToUpper(s) {
+	case "SERIAL":
+		return Serial, nil
+	case "LOCAL_SERIAL":
+		return LocalSerial, nil
+	default:
+		return 0, fmt.Errorf("invalid serial consistency %q", s)
+	}
+}
@@ -57,6 +57,7 @@ type (
 		WithContext(context.Context) Query
 		WithTimestamp(int64) Query
 		Consistency(Consistency) Query
+		SerialConsistency(SerialConsistency) Query
 		Bind(...interface{}) Query
 	}
 
@@ -107,6 +107,11 @@ func (q *query) Consistency(c Consistency) Query {
 	return newQuery(q.session, q.gocqlQuery)
 }
 
+func (q *query) SerialConsistency(c SerialConsistency) Query {
+	q.gocqlQuery.SerialConsistency(mustConvertSerialConsistency(c))
+	return newQuery(q.session, q.gocqlQuery)
+}
+
 func (q *query) WithTimestamp(timestamp int64) Query {
 	q.gocqlQuery.WithTimestamp(timestamp)
 	return newQuery(q.session, q.gocqlQuery)
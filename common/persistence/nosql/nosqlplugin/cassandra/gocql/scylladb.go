@@ -0,0 +1,57 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gocql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	scyllaDBTabletsQuery = `SELECT initial_tablets FROM system_schema.scylla_keyspaces WHERE keyspace_name = ?`
+)
+
+// CheckScyllaDBTablets returns an error if the keyspace is replicated with tablets. ScyllaDB doesn't support
+// lightweight transactions on tablets, so keyspaces must be created with tablets = {'enabled': false}.
+func CheckScyllaDBTablets(ctx context.Context, session Session, keyspace string) error {
+	var initialTablets *int
+	err := session.Query(scyllaDBTabletsQuery, keyspace).WithContext(ctx).Scan(&initialTablets)
+	if err != nil {
+		var requestErr gocql.RequestError
+		if IsNotFoundError(err) || (errors.As(err, &requestErr) && requestErr.Code() == gocql.ErrCodeInvalid) {
+			// keyspace uses vnodes, or the ScyllaDB version predates tablets
+			return nil
+		}
+		return err
+	}
+	if initialTablets != nil {
+		return fmt.Errorf("keyspace %q uses tablets, which do not support lightweight transactions; "+
+			"recreate it with tablets = {'enabled': false}", keyspace)
+	}
+	return nil
+}
@@ -156,6 +156,10 @@ func NewTestBaseWithSQL(options *TestBaseOptions) *TestBase {
 			options.DBPort = environment.GetMySQLPort()
 		case postgresql.PluginName, postgresql.PluginNamePGX:
 			options.DBPort = environment.GetPostgreSQLPort()
+		case postgresql.PluginNameCockroachDB:
+			options.DBPort = environment.GetCockroachDBPort()
+		case postgresql.PluginNameYugabyteDB:
+			options.DBPort = environment.GetYugabyteDBPort()
 		case sqlite.PluginName:
 			options.DBPort = 0
 		default:
@@ -168,6 +172,10 @@ func NewTestBaseWithSQL(options *TestBaseOptions) *TestBase {
 			options.DBHost = environment.GetMySQLAddress()
 		case postgresql.PluginName, postgresql.PluginNamePGX:
 			options.DBHost = environment.GetPostgreSQLAddress()
+		case postgresql.PluginNameCockroachDB:
+			options.DBHost = environment.GetCockroachDBAddress()
+		case postgresql.PluginNameYugabyteDB:
+			options.DBHost = environment.GetYugabyteDBAddress()
 		case sqlite.PluginName:
 			options.DBHost = environment.GetLocalhostIP()
 		default:
@@ -41,6 +41,15 @@ const (
 	testPostgreSQLPassword  = "temporal"
 	testPostgreSQLSchemaDir = "schema/postgresql/v12"
 
+	// CockroachDB runs in insecure mode in tests, which only allows the root user without a password
+	testCockroachDBUser      = "root"
+	testCockroachDBPassword  = ""
+	testCockroachDBSchemaDir = "schema/cockroachdb/v23"
+
+	testYugabyteDBUser      = "yugabyte"
+	testYugabyteDBPassword  = "yugabyte"
+	testYugabyteDBSchemaDir = "schema/yugabytedb/v2"
+
 	testSQLiteUser      = ""
 	testSQLitePassword  = ""
 	testSQLiteMode      = "memory"
@@ -87,6 +96,32 @@ func GetPostgreSQLPGXTestClusterOption() *TestBaseOptions {
 	}
 }
 
+// GetCockroachDBTestClusterOption return test options
+func GetCockroachDBTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: postgresql.PluginNameCockroachDB,
+		DBUsername:      testCockroachDBUser,
+		DBPassword:      testCockroachDBPassword,
+		DBHost:          environment.GetCockroachDBAddress(),
+		DBPort:          environment.GetCockroachDBPort(),
+		SchemaDir:       testCockroachDBSchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
+
+// GetYugabyteDBTestClusterOption return test options
+func GetYugabyteDBTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: postgresql.PluginNameYugabyteDB,
+		DBUsername:      testYugabyteDBUser,
+		DBPassword:      testYugabyteDBPassword,
+		DBHost:          environment.GetYugabyteDBAddress(),
+		DBPort:          environment.GetYugabyteDBPort(),
+		SchemaDir:       testYugabyteDBSchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
+
 // GetSQLiteFileTestClusterOption return test options
 func GetSQLiteFileTestClusterOption() *TestBaseOptions {
 	return &TestBaseOptions{
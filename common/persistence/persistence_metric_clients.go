@@ -194,7 +194,7 @@ func (p *shardPersistenceClient) GetOrCreateShard(
 	defer func() {
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, caller, latency, retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetOrCreateShardScope, caller, latency, retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetOrCreateShardScope, caller, latency, retErr)
 	}()
 	return p.persistence.GetOrCreateShard(ctx, request)
 }
@@ -207,7 +207,7 @@ func (p *shardPersistenceClient) UpdateShard(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardInfo.GetShardId(), caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateShardScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateShardScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateShard(ctx, request)
 }
@@ -220,7 +220,7 @@ func (p *shardPersistenceClient) AssertShardOwnership(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAssertShardOwnershipScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAssertShardOwnershipScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.AssertShardOwnership(ctx, request)
 }
@@ -245,7 +245,7 @@ func (p *executionPersistenceClient) CreateWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CreateWorkflowExecution(ctx, request)
 }
@@ -258,7 +258,7 @@ func (p *executionPersistenceClient) GetWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetWorkflowExecution(ctx, request)
 }
@@ -271,7 +271,7 @@ func (p *executionPersistenceClient) SetWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceSetWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceSetWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.SetWorkflowExecution(ctx, request)
 }
@@ -284,7 +284,7 @@ func (p *executionPersistenceClient) UpdateWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateWorkflowExecution(ctx, request)
 }
@@ -297,7 +297,7 @@ func (p *executionPersistenceClient) ConflictResolveWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
 }
@@ -310,7 +310,7 @@ func (p *executionPersistenceClient) DeleteWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteWorkflowExecution(ctx, request)
 }
@@ -323,7 +323,7 @@ func (p *executionPersistenceClient) DeleteCurrentWorkflowExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 }
@@ -336,7 +336,7 @@ func (p *executionPersistenceClient) GetCurrentExecution(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetCurrentExecutionScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetCurrentExecutionScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetCurrentExecution(ctx, request)
 }
@@ -349,7 +349,7 @@ func (p *executionPersistenceClient) ListConcreteExecutions(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListConcreteExecutionsScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListConcreteExecutionsScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ListConcreteExecutions(ctx, request)
 }
@@ -362,7 +362,7 @@ func (p *executionPersistenceClient) AddHistoryTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAddTasksScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAddTasksScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
@@ -393,7 +393,7 @@ func (p *executionPersistenceClient) GetHistoryTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(operation, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, operation, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetHistoryTasks(ctx, request)
 }
@@ -424,7 +424,7 @@ func (p *executionPersistenceClient) CompleteHistoryTask(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(operation, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, operation, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CompleteHistoryTask(ctx, request)
 }
@@ -455,7 +455,7 @@ func (p *executionPersistenceClient) RangeCompleteHistoryTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(operation, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, operation, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
 }
@@ -468,7 +468,7 @@ func (p *executionPersistenceClient) PutReplicationTaskToDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistencePutReplicationTaskToDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistencePutReplicationTaskToDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
 }
@@ -481,7 +481,7 @@ func (p *executionPersistenceClient) GetReplicationTasksFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
 }
@@ -494,7 +494,7 @@ func (p *executionPersistenceClient) DeleteReplicationTaskFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -507,7 +507,7 @@ func (p *executionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -520,7 +520,7 @@ func (p *executionPersistenceClient) IsReplicationDLQEmpty(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
@@ -541,7 +541,7 @@ func (p *taskPersistenceClient) CreateTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateTasksScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateTasksScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CreateTasks(ctx, request)
 }
@@ -554,7 +554,7 @@ func (p *taskPersistenceClient) GetTasks(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTasksScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTasksScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetTasks(ctx, request)
 }
@@ -567,7 +567,7 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCompleteTasksLessThanScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCompleteTasksLessThanScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
@@ -580,7 +580,7 @@ func (p *taskPersistenceClient) CreateTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateTaskQueueScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CreateTaskQueue(ctx, request)
 }
@@ -593,7 +593,7 @@ func (p *taskPersistenceClient) UpdateTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateTaskQueueScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateTaskQueue(ctx, request)
 }
@@ -606,7 +606,7 @@ func (p *taskPersistenceClient) GetTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueueScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetTaskQueue(ctx, request)
 }
@@ -619,7 +619,7 @@ func (p *taskPersistenceClient) ListTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListTaskQueueScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ListTaskQueue(ctx, request)
 }
@@ -632,7 +632,7 @@ func (p *taskPersistenceClient) DeleteTaskQueue(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteTaskQueueScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteTaskQueueScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteTaskQueue(ctx, request)
 }
@@ -645,7 +645,7 @@ func (p *taskPersistenceClient) GetTaskQueueUserData(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueUserDataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueueUserDataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetTaskQueueUserData(ctx, request)
 }
@@ -658,7 +658,7 @@ func (p *taskPersistenceClient) UpdateTaskQueueUserData(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueUserDataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateTaskQueueUserDataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateTaskQueueUserData(ctx, request)
 }
@@ -671,7 +671,7 @@ func (p *taskPersistenceClient) ListTaskQueueUserDataEntries(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ListTaskQueueUserDataEntries(ctx, request)
 }
@@ -681,7 +681,7 @@ func (p *taskPersistenceClient) GetTaskQueuesByBuildId(ctx context.Context, requ
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
 }
@@ -691,7 +691,7 @@ func (p *taskPersistenceClient) CountTaskQueuesByBuildId(ctx context.Context, re
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
 }
@@ -712,7 +712,7 @@ func (p *metadataPersistenceClient) CreateNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateNamespaceScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CreateNamespace(ctx, request)
 }
@@ -725,7 +725,7 @@ func (p *metadataPersistenceClient) GetNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetNamespaceScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetNamespace(ctx, request)
 }
@@ -738,7 +738,7 @@ func (p *metadataPersistenceClient) UpdateNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateNamespaceScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateNamespace(ctx, request)
 }
@@ -751,7 +751,7 @@ func (p *metadataPersistenceClient) RenameNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceRenameNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRenameNamespaceScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.RenameNamespace(ctx, request)
 }
@@ -764,7 +764,7 @@ func (p *metadataPersistenceClient) DeleteNamespace(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNamespaceScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteNamespace(ctx, request)
 }
@@ -777,7 +777,7 @@ func (p *metadataPersistenceClient) DeleteNamespaceByName(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceByNameScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNamespaceByNameScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteNamespaceByName(ctx, request)
 }
@@ -790,7 +790,7 @@ func (p *metadataPersistenceClient) ListNamespaces(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListNamespacesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListNamespacesScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ListNamespaces(ctx, request)
 }
@@ -802,7 +802,7 @@ func (p *metadataPersistenceClient) GetMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetMetadataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetMetadata(ctx)
 }
@@ -820,7 +820,7 @@ func (p *executionPersistenceClient) AppendHistoryNodes(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAppendHistoryNodesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendHistoryNodesScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.AppendHistoryNodes(ctx, request)
 }
@@ -834,7 +834,7 @@ func (p *executionPersistenceClient) AppendRawHistoryNodes(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceAppendRawHistoryNodesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceAppendRawHistoryNodesScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.AppendRawHistoryNodes(ctx, request)
 }
@@ -847,7 +847,7 @@ func (p *executionPersistenceClient) ReadHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ReadHistoryBranch(ctx, request)
 }
@@ -859,7 +859,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchReverse(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchReverseScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchReverseScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ReadHistoryBranchReverse(ctx, request)
 }
@@ -872,7 +872,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchByBatch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadHistoryBranchScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
 }
@@ -885,7 +885,7 @@ func (p *executionPersistenceClient) ReadRawHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceReadRawHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadRawHistoryBranchScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ReadRawHistoryBranch(ctx, request)
 }
@@ -898,7 +898,7 @@ func (p *executionPersistenceClient) ForkHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceForkHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceForkHistoryBranchScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ForkHistoryBranch(ctx, request)
 }
@@ -911,7 +911,7 @@ func (p *executionPersistenceClient) DeleteHistoryBranch(
 	caller := headers.GetCallerInfo(ctx).CallerName
 	startTime := time.Now().UTC()
 	defer func() {
-		p.recordRequestMetrics(metrics.PersistenceDeleteHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteHistoryBranchScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteHistoryBranch(ctx, request)
 }
@@ -925,7 +925,7 @@ func (p *executionPersistenceClient) TrimHistoryBranch(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceTrimHistoryBranchScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceTrimHistoryBranchScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.TrimHistoryBranch(ctx, request)
 }
@@ -938,7 +938,7 @@ func (p *executionPersistenceClient) GetAllHistoryTreeBranches(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
 }
@@ -958,7 +958,7 @@ func (p *queuePersistenceClient) EnqueueMessage(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceEnqueueMessageScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceEnqueueMessageScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.EnqueueMessage(ctx, blob)
 }
@@ -972,7 +972,7 @@ func (p *queuePersistenceClient) ReadMessages(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadQueueMessagesScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadQueueMessagesScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
 }
@@ -985,7 +985,7 @@ func (p *queuePersistenceClient) UpdateAckLevel(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateAckLevelScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateAckLevel(ctx, metadata)
 }
@@ -997,7 +997,7 @@ func (p *queuePersistenceClient) GetAckLevels(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetAckLevelScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetAckLevels(ctx)
 }
@@ -1010,7 +1010,7 @@ func (p *queuePersistenceClient) DeleteMessagesBefore(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteMessagesBeforeScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteMessagesBeforeScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteMessagesBefore(ctx, messageID)
 }
@@ -1023,7 +1023,7 @@ func (p *queuePersistenceClient) EnqueueMessageToDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceEnqueueMessageToDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceEnqueueMessageToDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.EnqueueMessageToDLQ(ctx, blob)
 }
@@ -1039,7 +1039,7 @@ func (p *queuePersistenceClient) ReadMessagesFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceReadMessagesFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceReadMessagesFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 }
@@ -1052,7 +1052,7 @@ func (p *queuePersistenceClient) DeleteMessageFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteMessageFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteMessageFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteMessageFromDLQ(ctx, messageID)
 }
@@ -1066,7 +1066,7 @@ func (p *queuePersistenceClient) RangeDeleteMessagesFromDLQ(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceRangeDeleteMessagesFromDLQScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceRangeDeleteMessagesFromDLQScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
 }
@@ -1079,7 +1079,7 @@ func (p *queuePersistenceClient) UpdateDLQAckLevel(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpdateDLQAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpdateDLQAckLevelScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpdateDLQAckLevel(ctx, metadata)
 }
@@ -1091,7 +1091,7 @@ func (p *queuePersistenceClient) GetDLQAckLevels(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetDLQAckLevelScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetDLQAckLevelScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetDLQAckLevels(ctx)
 }
@@ -1112,7 +1112,7 @@ func (p *clusterMetadataPersistenceClient) ListClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListClusterMetadataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ListClusterMetadata(ctx, request)
 }
@@ -1124,7 +1124,7 @@ func (p *clusterMetadataPersistenceClient) GetCurrentClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetCurrentClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetCurrentClusterMetadataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetCurrentClusterMetadata(ctx)
 }
@@ -1137,7 +1137,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetClusterMetadataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetClusterMetadata(ctx, request)
 }
@@ -1150,7 +1150,7 @@ func (p *clusterMetadataPersistenceClient) SaveClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceSaveClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceSaveClusterMetadataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.SaveClusterMetadata(ctx, request)
 }
@@ -1163,7 +1163,7 @@ func (p *clusterMetadataPersistenceClient) DeleteClusterMetadata(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteClusterMetadataScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteClusterMetadataScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteClusterMetadata(ctx, request)
 }
@@ -1180,7 +1180,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMembers(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetClusterMembersScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetClusterMembersScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetClusterMembers(ctx, request)
 }
@@ -1193,7 +1193,7 @@ func (p *clusterMetadataPersistenceClient) UpsertClusterMembership(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceUpsertClusterMembershipScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceUpsertClusterMembershipScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.UpsertClusterMembership(ctx, request)
 }
@@ -1206,7 +1206,7 @@ func (p *clusterMetadataPersistenceClient) PruneClusterMembership(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistencePruneClusterMembershipScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistencePruneClusterMembershipScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.PruneClusterMembership(ctx, request)
 }
@@ -1219,7 +1219,7 @@ func (p *metadataPersistenceClient) InitializeSystemNamespaces(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceInitializeSystemNamespaceScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceInitializeSystemNamespaceScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.InitializeSystemNamespaces(ctx, currentClusterName)
 }
@@ -1240,7 +1240,7 @@ func (p *nexusEndpointPersistenceClient) GetNexusEndpoint(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceGetNexusEndpointScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceGetNexusEndpointScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.GetNexusEndpoint(ctx, request)
 }
@@ -1253,7 +1253,7 @@ func (p *nexusEndpointPersistenceClient) ListNexusEndpoints(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceListNexusEndpointsScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceListNexusEndpointsScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.ListNexusEndpoints(ctx, request)
 }
@@ -1266,7 +1266,7 @@ func (p *nexusEndpointPersistenceClient) CreateOrUpdateNexusEndpoint(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceCreateOrUpdateNexusEndpointScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceCreateOrUpdateNexusEndpointScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.CreateOrUpdateNexusEndpoint(ctx, request)
 }
@@ -1279,15 +1279,21 @@ func (p *nexusEndpointPersistenceClient) DeleteNexusEndpoint(
 	startTime := time.Now().UTC()
 	defer func() {
 		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
-		p.recordRequestMetrics(metrics.PersistenceDeleteNexusEndpointScope, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(ctx, metrics.PersistenceDeleteNexusEndpointScope, caller, time.Since(startTime), retErr)
 	}()
 	return p.persistence.DeleteNexusEndpoint(ctx, request)
 }
 
-func (p *metricEmitter) recordRequestMetrics(operation string, caller string, latency time.Duration, err error) {
+func (p *metricEmitter) recordRequestMetrics(
+	ctx context.Context,
+	operation string,
+	caller string,
+	latency time.Duration,
+	err error,
+) {
 	handler := p.metricsHandler.WithTags(metrics.OperationTag(operation), metrics.NamespaceTag(caller))
 	metrics.PersistenceRequests.With(handler).Record(1)
-	metrics.PersistenceLatency.With(handler).Record(latency)
+	metrics.PersistenceLatency.With(handler).RecordContext(ctx, latency)
 	updateErrorMetric(handler, p.logger, operation, err)
 }
 
@@ -116,6 +116,7 @@ func (s *TaskSerializer) serializeTransferTask(
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", task))
 	}
+	transferTask.TraceContext = getTraceContext(task)
 
 	return TransferTaskInfoToBlob(transferTask)
 }
@@ -149,6 +150,7 @@ func (s *TaskSerializer) deserializeTransferTasks(
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", transferTask.TaskType))
 	}
+	setTraceContext(task, transferTask.TraceContext)
 	return task, nil
 }
 
@@ -178,6 +180,7 @@ func (s *TaskSerializer) serializeTimerTask(
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", task))
 	}
+	timerTask.TraceContext = getTraceContext(task)
 	return TimerTaskInfoToBlob(timerTask)
 }
 
@@ -212,6 +215,7 @@ func (s *TaskSerializer) deserializeTimerTasks(
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", timerTask.TaskType))
 	}
+	setTraceContext(timer, timerTask.TraceContext)
 	return timer, nil
 }
 
@@ -281,37 +285,64 @@ func (s *TaskSerializer) deserializeReplicationTasks(
 }
 
 func (s *TaskSerializer) ParseReplicationTask(replicationTask *persistencespb.ReplicationTaskInfo) (tasks.Task, error) {
+	var task tasks.Task
+	var err error
 	switch replicationTask.TaskType {
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_ACTIVITY:
-		return s.replicationActivityTaskFromProto(replicationTask), nil
+		task = s.replicationActivityTaskFromProto(replicationTask)
 	case enumsspb.TASK_TYPE_REPLICATION_HISTORY:
-		return s.replicationHistoryTaskFromProto(replicationTask), nil
+		task = s.replicationHistoryTaskFromProto(replicationTask)
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE:
-		return s.replicationSyncWorkflowStateTaskFromProto(replicationTask), nil
+		task = s.replicationSyncWorkflowStateTaskFromProto(replicationTask)
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_HSM:
-		return s.replicationSyncHSMTaskFromProto(replicationTask), nil
+		task = s.replicationSyncHSMTaskFromProto(replicationTask)
 	case enumsspb.TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION:
-		return s.replicationSyncVersionedTransitionTaskFromProto(replicationTask)
+		task, err = s.replicationSyncVersionedTransitionTaskFromProto(replicationTask)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown replication task type: %v", replicationTask.TaskType))
 	}
+	if err != nil {
+		return nil, err
+	}
+	setTraceContext(task, replicationTask.TraceContext)
+	return task, nil
 }
 
 func (s *TaskSerializer) ParseReplicationTaskInfo(task tasks.Task) (*persistencespb.ReplicationTaskInfo, error) {
+	var replicationTask *persistencespb.ReplicationTaskInfo
+	var err error
 	switch task := task.(type) {
 	case *tasks.SyncActivityTask:
-		return s.replicationActivityTaskToProto(task), nil
+		replicationTask = s.replicationActivityTaskToProto(task)
 	case *tasks.HistoryReplicationTask:
-		return s.replicationHistoryTaskToProto(task), nil
+		replicationTask = s.replicationHistoryTaskToProto(task)
 	case *tasks.SyncWorkflowStateTask:
-		return s.replicationSyncWorkflowStateTaskToProto(task), nil
+		replicationTask = s.replicationSyncWorkflowStateTaskToProto(task)
 	case *tasks.SyncHSMTask:
-		return s.replicationSyncHSMTaskToProto(task), nil
+		replicationTask = s.replicationSyncHSMTaskToProto(task)
 	case *tasks.SyncVersionedTransitionTask:
-		return s.replicationSyncVersionedTransitionTaskToProto(task)
+		replicationTask, err = s.replicationSyncVersionedTransitionTaskToProto(task)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown repication task type: %v", task))
 	}
+	if err != nil {
+		return nil, err
+	}
+	replicationTask.TraceContext = getTraceContext(task)
+	return replicationTask, nil
+}
+
+func getTraceContext(task tasks.Task) map[string]string {
+	if task, ok := task.(tasks.HasTraceContext); ok {
+		return task.GetTraceContext()
+	}
+	return nil
+}
+
+func setTraceContext(task tasks.Task, traceContext map[string]string) {
+	if task, ok := task.(tasks.HasTraceContext); ok && len(traceContext) > 0 {
+		task.SetTraceContext(traceContext)
+	}
 }
 
 func (s *TaskSerializer) serializeArchivalTask(
@@ -500,6 +500,38 @@ func (s *taskSerializerSuite) TestStateMachineTimerTask() {
 	s.Equal(task, deserializedTask)
 }
 
+func (s *taskSerializerSuite) TestTraceContext() {
+	traceContext := map[string]string{
+		"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+	}
+
+	s.assertEqualTasks(&tasks.WorkflowTask{
+		WorkflowKey:         s.workflowKey,
+		TraceContext:        tasks.TraceContext{Carrier: traceContext},
+		VisibilityTimestamp: time.Unix(0, rand.Int63()).UTC(),
+		TaskID:              rand.Int63(),
+		TaskQueue:           shuffle.String("random task queue name"),
+		ScheduledEventID:    rand.Int63(),
+		Version:             rand.Int63(),
+	})
+	s.assertEqualTasks(&tasks.UserTimerTask{
+		WorkflowKey:         s.workflowKey,
+		TraceContext:        tasks.TraceContext{Carrier: traceContext},
+		VisibilityTimestamp: time.Unix(0, rand.Int63()).UTC(),
+		TaskID:              rand.Int63(),
+		EventID:             rand.Int63(),
+	})
+	s.assertEqualTasks(&tasks.HistoryReplicationTask{
+		WorkflowKey:         s.workflowKey,
+		TraceContext:        tasks.TraceContext{Carrier: traceContext},
+		VisibilityTimestamp: time.Unix(0, 0).UTC(),
+		TaskID:              rand.Int63(),
+		Version:             rand.Int63(),
+		FirstEventID:        rand.Int63(),
+		NextEventID:         rand.Int63(),
+	})
+}
+
 func (s *taskSerializerSuite) assertEqualTasksWithOpts(
 	task tasks.Task,
 	cmpFunc func(task, deserializedTask tasks.Task),
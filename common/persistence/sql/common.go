@@ -32,6 +32,7 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/log"
@@ -40,6 +41,13 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
+const (
+	// txMaxAttempts bounds how often a transaction aborted with a retryable error is run.
+	txMaxAttempts = 5
+	// txRetryBackoff is multiplied by the attempt number to get the delay before the next attempt.
+	txRetryBackoff = 10 * time.Millisecond
+)
+
 // TODO: Rename all SQL Managers to Stores
 type SqlStore struct {
 	Db     sqlplugin.DB
@@ -71,9 +79,35 @@ func (m *SqlStore) Close() {
 }
 
 func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) error {
+	for attempt := 1; ; attempt++ {
+		retryable, err := m.txExecuteOnce(ctx, operation, f)
+		if !retryable || attempt >= txMaxAttempts {
+			return err
+		}
+		m.logger.Debug("retrying aborted transaction",
+			tag.NewStringTag("operation", operation), tag.Attempt(int32(attempt)), tag.Error(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * txRetryBackoff):
+		}
+	}
+}
+
+// txExecuteOnce runs f in a single transaction. retryable is true if the database aborted the
+// transaction with an error which is expected to go away when running the transaction again.
+func (m *SqlStore) txExecuteOnce(
+	ctx context.Context,
+	operation string,
+	f func(tx sqlplugin.Tx) error,
+) (retryable bool, err error) {
 	tx, err := m.Db.BeginTx(ctx)
 	if err != nil {
-		return serviceerror.NewUnavailable(fmt.Sprintf("%s failed. Failed to start transaction. Error: %v", operation, err))
+		return false, serviceerror.NewUnavailable(fmt.Sprintf("%s failed. Failed to start transaction. Error: %v", operation, err))
+	}
+	isRetryable := func() bool {
+		rtx, ok := tx.(sqlplugin.RetryableTx)
+		return ok && rtx.Retryable()
 	}
 	err = f(tx)
 	if err != nil {
@@ -90,15 +124,15 @@ func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sq
 			*persistence.ShardOwnershipLostError,
 			*serviceerror.Unavailable,
 			*serviceerror.NotFound:
-			return err
+			return isRetryable(), err
 		default:
-			return serviceerror.NewUnavailable(fmt.Sprintf("%v: %v", operation, err))
+			return isRetryable(), serviceerror.NewUnavailable(fmt.Sprintf("%v: %v", operation, err))
 		}
 	}
 	if err := tx.Commit(); err != nil {
-		return serviceerror.NewUnavailable(fmt.Sprintf("%s operation failed. Failed to commit transaction. Error: %v", operation, err))
+		return isRetryable(), serviceerror.NewUnavailable(fmt.Sprintf("%s operation failed. Failed to commit transaction. Error: %v", operation, err))
 	}
-	return nil
+	return false, nil
 }
 
 func gobSerialize(x interface{}) ([]byte, error) {
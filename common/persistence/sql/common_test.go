@@ -0,0 +1,158 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type (
+	fakeTxDB struct {
+		sqlplugin.DB
+		txs []*fakeTx
+	}
+
+	fakeTx struct {
+		sqlplugin.Tx
+		retryable  bool
+		commitErr  error
+		committed  bool
+		rolledBack bool
+	}
+)
+
+func TestTxExecute_RetriesRetryableTransactions(t *testing.T) {
+	db := &fakeTxDB{}
+	store := NewSqlStore(db, log.NewNoopLogger())
+
+	attempts := 0
+	err := store.txExecute(context.Background(), "test", func(tx sqlplugin.Tx) error {
+		attempts++
+		if attempts < 3 {
+			tx.(*fakeTx).retryable = true
+			return errors.New("restart transaction")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+	require.Len(t, db.txs, 3)
+	require.True(t, db.txs[0].rolledBack)
+	require.True(t, db.txs[1].rolledBack)
+	require.True(t, db.txs[2].committed)
+}
+
+func TestTxExecute_RetriesRetryableCommit(t *testing.T) {
+	db := &fakeTxDB{}
+	store := NewSqlStore(db, log.NewNoopLogger())
+
+	attempts := 0
+	err := store.txExecute(context.Background(), "test", func(tx sqlplugin.Tx) error {
+		attempts++
+		if attempts == 1 {
+			tx.(*fakeTx).retryable = true
+			tx.(*fakeTx).commitErr = errors.New("restart transaction")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+}
+
+func TestTxExecute_GivesUpAfterMaxAttempts(t *testing.T) {
+	db := &fakeTxDB{}
+	store := NewSqlStore(db, log.NewNoopLogger())
+
+	attempts := 0
+	err := store.txExecute(context.Background(), "test", func(tx sqlplugin.Tx) error {
+		attempts++
+		tx.(*fakeTx).retryable = true
+		return errors.New("restart transaction")
+	})
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, err, &unavailable)
+	require.Equal(t, txMaxAttempts, attempts)
+}
+
+func TestTxExecute_DoesNotRetryOtherErrors(t *testing.T) {
+	db := &fakeTxDB{}
+	store := NewSqlStore(db, log.NewNoopLogger())
+
+	attempts := 0
+	err := store.txExecute(context.Background(), "test", func(tx sqlplugin.Tx) error {
+		attempts++
+		return &persistence.ConditionFailedError{Msg: "condition failed"}
+	})
+	var conditionFailed *persistence.ConditionFailedError
+	require.ErrorAs(t, err, &conditionFailed)
+	require.Equal(t, 1, attempts)
+}
+
+func TestTxExecute_StopsWhenContextIsDone(t *testing.T) {
+	db := &fakeTxDB{}
+	store := NewSqlStore(db, log.NewNoopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	err := store.txExecute(ctx, "test", func(tx sqlplugin.Tx) error {
+		attempts++
+		tx.(*fakeTx).retryable = true
+		cancel()
+		return errors.New("restart transaction")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
+
+func (db *fakeTxDB) BeginTx(context.Context) (sqlplugin.Tx, error) {
+	tx := &fakeTx{}
+	db.txs = append(db.txs, tx)
+	return tx, nil
+}
+
+func (tx *fakeTx) Commit() error {
+	if tx.commitErr != nil {
+		return tx.commitErr
+	}
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.rolledBack = true
+	return nil
+}
+
+func (tx *fakeTx) Retryable() bool {
+	return tx.retryable
+}
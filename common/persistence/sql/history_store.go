@@ -430,7 +430,8 @@ func (m *sqlExecutionStore) GetAllHistoryTreeBranches(
 		page.BranchID = token.BranchID
 	}
 
-	rows, err := m.Db.PaginateBranchesFromHistoryTree(ctx, page)
+	// Scanning all branches is a background operation and tolerates stale results.
+	rows, err := m.Db.PaginateBranchesFromHistoryTree(p.WithStaleReadsAllowed(ctx), page)
 	if err != nil {
 		return nil, err
 	}
@@ -468,7 +469,8 @@ func (m *sqlExecutionStore) GetAllHistoryTreeBranches(
 	return response, nil
 }
 
-// GetHistoryTreeContainingBranch returns all branch information of a tree
+// GetHistoryTreeContainingBranch returns all branch information of a tree. The tree is read from a
+// read replica if ctx was created with persistence.WithStaleReadsAllowed.
 func (m *sqlExecutionStore) GetHistoryTreeContainingBranch(
 	ctx context.Context,
 	request *p.InternalGetHistoryTreeContainingBranchRequest,
@@ -35,7 +35,6 @@ import (
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
-	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -74,53 +73,35 @@ func (q *queueV2) EnqueueMessage(
 	if err != nil {
 		return nil, err
 	}
-	tx, err := q.Db.BeginTx(ctx)
-	if err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf(
-			"EnqueueMessage failed for queue with type: %v and name: %v. BeginTx operation failed. Error: %v",
-			request.QueueType,
-			request.QueueName,
-			err),
-		)
-	}
-	nextMessageID, err := q.getNextMessageID(ctx, request.QueueType, request.QueueName, tx)
-	if err != nil {
-		rollBackErr := tx.Rollback()
-		if rollBackErr != nil {
-			q.SqlStore.logger.Error("transaction rollback error", tag.Error(rollBackErr))
+	var nextMessageID int64
+	err = q.txExecute(ctx, "EnqueueMessage", func(tx sqlplugin.Tx) error {
+		var err error
+		nextMessageID, err = q.getNextMessageID(ctx, request.QueueType, request.QueueName, tx)
+		if err != nil {
+			return serviceerror.NewUnavailable(fmt.Sprintf(
+				"EnqueueMessage failed for queue with type: %v and name: %v. failed to get next messageId. Error: %v",
+				request.QueueType,
+				request.QueueName,
+				err),
+			)
 		}
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf(
-			"EnqueueMessage failed for queue with type: %v and name: %v. failed to get next messageId. Error: %v",
-			request.QueueType,
-			request.QueueName,
-			err),
-		)
-	}
-	_, err = tx.InsertIntoQueueV2Messages(ctx, []sqlplugin.QueueV2MessageRow{
-		newQueueV2Row(request.QueueType, request.QueueName, nextMessageID, request.Blob),
+		_, err = tx.InsertIntoQueueV2Messages(ctx, []sqlplugin.QueueV2MessageRow{
+			newQueueV2Row(request.QueueType, request.QueueName, nextMessageID, request.Blob),
+		})
+		if err != nil {
+			return serviceerror.NewUnavailable(fmt.Sprintf(
+				"EnqueueMessage failed for queue with type: %v and name: %v. InsertIntoQueueV2Messages operation failed. Error: %v",
+				request.QueueType,
+				request.QueueName,
+				err),
+			)
+		}
+		return nil
 	})
 	if err != nil {
-		rollBackErr := tx.Rollback()
-		if rollBackErr != nil {
-			q.SqlStore.logger.Error("transaction rollback error", tag.Error(rollBackErr))
-		}
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf(
-			"EnqueueMessage failed for queue with type: %v and name: %v. InsertIntoQueueV2Messages operation failed. Error: %v",
-			request.QueueType,
-			request.QueueName,
-			err),
-		)
-	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, serviceerror.NewUnavailable(fmt.Sprintf(
-			"EnqueueMessage failed for queue with type: %v and name: %v. commit operation failed. Error: %v",
-			request.QueueType,
-			request.QueueName,
-			err),
-		)
+		return nil, err
 	}
-	return &persistence.InternalEnqueueMessageResponse{Metadata: persistence.MessageMetadata{ID: nextMessageID}}, err
+	return &persistence.InternalEnqueueMessageResponse{Metadata: persistence.MessageMetadata{ID: nextMessageID}}, nil
 }
 
 func (q *queueV2) ReadMessages(
@@ -49,6 +49,9 @@ const (
 
 var (
 	DatabaseUnavailableError = serviceerror.NewUnavailable("no usable database connection found")
+	// ErrTxRetryable wraps errors which abort the current transaction but are expected to succeed
+	// if the whole transaction is retried, e.g. serialization failures on distributed SQL databases.
+	ErrTxRetryable = errors.New("transaction aborted and can be retried")
 )
 
 type DatabaseHandle struct {
@@ -56,6 +59,8 @@ type DatabaseHandle struct {
 	db           atomic.Pointer[sqlx.DB]
 	connect      func() (*sqlx.DB, error)
 	needsRefresh func(error) bool
+	isRetryable  func(error) bool
+	readReplicas *ReadReplicas
 
 	lastRefresh time.Time
 	metrics     metrics.Handler
@@ -145,6 +150,9 @@ func (h *DatabaseHandle) Close() {
 		if db != nil {
 			db.Close()
 		}
+		if h.readReplicas != nil {
+			h.readReplicas.Close()
+		}
 	}
 }
 
@@ -170,7 +178,34 @@ func (h *DatabaseHandle) Conn() Conn {
 	return invalidConn{}
 }
 
+// SetRetryableTxErrorFunc installs a classifier for errors which abort a transaction but which the
+// caller may resolve by retrying the transaction from the start. Such errors are returned from
+// ConvertError wrapped with ErrTxRetryable. Must be called before the handle is used.
+func (h *DatabaseHandle) SetRetryableTxErrorFunc(isRetryable func(error) bool) {
+	h.isRetryable = isRetryable
+}
+
+// SetReadReplicas installs the read replicas returned by ReplicaConn. Must be called before the handle is used.
+func (h *DatabaseHandle) SetReadReplicas(readReplicas *ReadReplicas) {
+	h.readReplicas = readReplicas
+}
+
+// ReplicaConn returns a connection for a read which tolerates replication lag together with the handle
+// which owns it, or nil if there is no read replica with lag within bounds.
+func (h *DatabaseHandle) ReplicaConn() (Conn, *DatabaseHandle) {
+	if h.readReplicas == nil {
+		return nil, nil
+	}
+	return h.readReplicas.Conn()
+}
+
 func (h *DatabaseHandle) ConvertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if h.isRetryable != nil && h.isRetryable(err) {
+		return fmt.Errorf("%w: %w", ErrTxRetryable, err)
+	}
 	if h.needsRefresh(err) ||
 		errors.Is(err, driver.ErrBadConn) ||
 		errors.Is(err, io.ErrUnexpectedEOF) ||
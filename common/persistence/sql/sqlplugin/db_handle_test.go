@@ -74,4 +74,22 @@ func TestDatabaseHandleReconnect(t *testing.T) {
 	}
 }
 
+func TestDatabaseHandleConvertRetryableTxError(t *testing.T) {
+	errConflict := errors.New("restart transaction")
+	connectFunc := func() (*sqlx.DB, error) {
+		return nil, errTest
+	}
+	needsRefreshFunc := func(_ error) bool { return false }
+	dbHandle := NewDatabaseHandle(connectFunc, needsRefreshFunc, log.NewNoopLogger(), metrics.NoopMetricsHandler, clock.NewRealTimeSource())
+
+	assert.Equal(t, errConflict, dbHandle.ConvertError(errConflict))
+
+	dbHandle.SetRetryableTxErrorFunc(func(err error) bool { return err == errConflict })
+	err := dbHandle.ConvertError(errConflict)
+	assert.ErrorIs(t, err, ErrTxRetryable)
+	assert.ErrorIs(t, err, errConflict)
+	assert.Equal(t, errTest, dbHandle.ConvertError(errTest))
+	assert.NoError(t, dbHandle.ConvertError(nil))
+}
+
 var errTest = errors.New("test")
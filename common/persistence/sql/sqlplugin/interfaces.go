@@ -57,6 +57,15 @@ type (
 		CreateDB(dbKind DbKind, cfg *config.SQL, r resolver.ServiceResolver, l log.Logger, mh metrics.Handler) (GenericDB, error)
 	}
 
+	// BackupPlugin is implemented by plugins that can copy a database while it is in use and restore such a copy.
+	BackupPlugin interface {
+		// Backup writes a consistent copy of the database to destination, which must not exist yet.
+		Backup(ctx context.Context, cfg *config.SQL, destination string) error
+		// Restore replaces the contents of the database with the copy at source. The database must not be in use
+		// by a running server.
+		Restore(ctx context.Context, cfg *config.SQL, source string) error
+	}
+
 	// TableCRUD defines the API for interacting with the database tables
 	TableCRUD interface {
 		ClusterMetadata
@@ -96,6 +105,13 @@ type (
 		HistoryVisibilityTask
 	}
 
+	// TableObjectRow is a column or secondary index of a table, as reported by the
+	// database catalog
+	TableObjectRow struct {
+		TableName string `db:"table_name"`
+		Name      string `db:"name"`
+	}
+
 	// AdminCRUD defines admin operations for CLI and test suites
 	AdminCRUD interface {
 		CreateSchemaVersionTables() error
@@ -103,11 +119,16 @@ type (
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
 		ListTables(database string) ([]string, error)
+		ListColumns(database string) ([]TableObjectRow, error)
+		ListIndexes(database string) ([]TableObjectRow, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
 		CreateDatabase(database string) error
 		DropDatabase(database string) error
 		Exec(stmt string, args ...interface{}) error
+		// QueryRows executes a query and returns the column values of all result rows, see ScanRows
+		QueryRows(stmt string, args ...interface{}) ([][]interface{}, error)
+		Rebind(query string) string
 	}
 
 	// Tx defines the API for a SQL transaction
@@ -117,6 +138,14 @@ type (
 		Rollback() error
 	}
 
+	// RetryableTx is implemented by transactions of databases which abort transactions on contention
+	// (e.g. serialization failures) and expect the client to retry them from the start.
+	RetryableTx interface {
+		Tx
+		// Retryable reports whether the transaction was aborted with an error wrapping ErrTxRetryable.
+		Retryable() bool
+	}
+
 	// DB defines the API for regular SQL operations of a Temporal server
 	DB interface {
 		TableCRUD
@@ -27,6 +27,8 @@ package mysql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -64,6 +66,12 @@ const (
 
 	listTablesQuery = "SHOW TABLES FROM %v"
 
+	listColumnsQuery = "SELECT table_name AS table_name, column_name AS name FROM information_schema.columns WHERE table_schema = ?"
+
+	// indexes backing PRIMARY KEY constraints are part of the table definition, not the schema files' indexes
+	listIndexesQuery = "SELECT DISTINCT table_name AS table_name, index_name AS name FROM information_schema.statistics " +
+		"WHERE table_schema = ? AND index_name <> 'PRIMARY'"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -107,6 +115,20 @@ func (mdb *db) Exec(stmt string, args ...interface{}) error {
 	return mdb.handle.ConvertError(err)
 }
 
+// QueryRows executes a query and returns the column values of all result rows
+func (mdb *db) QueryRows(stmt string, args ...interface{}) ([][]interface{}, error) {
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.Query(stmt, args...)
+	if err != nil {
+		return nil, mdb.handle.ConvertError(err)
+	}
+	result, err := sqlplugin.ScanRows(rows)
+	return result, mdb.handle.ConvertError(err)
+}
+
 // ListTables returns a list of tables in this database
 func (mdb *db) ListTables(database string) ([]string, error) {
 	var tables []string
@@ -118,6 +140,28 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, mdb.handle.ConvertError(err)
 }
 
+// ListColumns returns the columns of all tables in this database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.TableObjectRow, error) {
+	var rows []sqlplugin.TableObjectRow
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&rows, listColumnsQuery, database)
+	return rows, mdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns the secondary indexes of all tables in this database
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.TableObjectRow, error) {
+	var rows []sqlplugin.TableObjectRow
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Select(&rows, listIndexesQuery, database)
+	return rows, mdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
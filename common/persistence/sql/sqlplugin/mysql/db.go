@@ -31,6 +31,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	mysqlschemaV8 "go.temporal.io/server/schema/mysql/v8"
@@ -165,12 +166,34 @@ func (mdb *db) ExecContext(ctx context.Context, stmt string, args ...any) (sql.R
 	return res, mdb.handle.ConvertError(err)
 }
 
+// replicaConn returns a read replica connection for reads made with persistence.WithStaleReadsAllowed
+// outside of transactions, or nil if the read has to go to the primary. Reads which fail on the replica
+// are retried on the primary.
+func (mdb *db) replicaConn(ctx context.Context) (sqlplugin.Conn, *sqlplugin.DatabaseHandle) {
+	if mdb.tx != nil || !persistence.StaleReadsAllowed(ctx) {
+		return nil, nil
+	}
+	return mdb.handle.ReplicaConn()
+}
+
 func (mdb *db) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if conn, handle := mdb.replicaConn(ctx); conn != nil {
+		err := conn.GetContext(ctx, dest, query, args...)
+		if !handle.RetryReplicaReadOnPrimary(ctx, dest, err) {
+			return handle.ConvertError(err)
+		}
+	}
 	err := mdb.conn().GetContext(ctx, dest, query, args...)
 	return mdb.handle.ConvertError(err)
 }
 
 func (mdb *db) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if conn, handle := mdb.replicaConn(ctx); conn != nil {
+		err := conn.SelectContext(ctx, dest, query, args...)
+		if !handle.RetryReplicaReadOnPrimary(ctx, dest, err) {
+			return handle.ConvertError(err)
+		}
+	}
 	err := mdb.conn().SelectContext(ctx, dest, query, args...)
 	return mdb.handle.ConvertError(err)
 }
@@ -41,6 +41,22 @@ const (
 	PluginName = "mysql8"
 )
 
+// replicationLagQuery returns how long ago the oldest transaction currently received or applied by a
+// replica was committed on the source, 0 if the replica is idle or the database is not a replica, and
+// -1 if the replica's receiver thread is not connected to the source.
+const replicationLagQuery = `SELECT CASE
+ WHEN NOT EXISTS (SELECT 1 FROM performance_schema.replication_connection_status) THEN 0
+ WHEN EXISTS (SELECT 1 FROM performance_schema.replication_connection_status WHERE SERVICE_STATE <> 'ON') THEN -1
+ ELSE GREATEST(
+  (SELECT COALESCE(MAX(IF(APPLYING_TRANSACTION = '', 0,
+   TIMESTAMPDIFF(MICROSECOND, APPLYING_TRANSACTION_ORIGINAL_COMMIT_TIMESTAMP, NOW(6)))), 0)
+   FROM performance_schema.replication_applier_status_by_worker),
+  (SELECT COALESCE(MAX(IF(QUEUEING_TRANSACTION = '', 0,
+   TIMESTAMPDIFF(MICROSECOND, QUEUEING_TRANSACTION_ORIGINAL_COMMIT_TIMESTAMP, NOW(6)))), 0)
+   FROM performance_schema.replication_connection_status)
+ ) / 1000000
+ END`
+
 type plugin struct{}
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
@@ -64,6 +80,16 @@ func (p *plugin) CreateDB(
 		return p.createDBConnection(dbKind, cfg, r)
 	}
 	handle := sqlplugin.NewDatabaseHandle(connect, isConnNeedsRefreshError, logger, metricsHandler, clock.NewRealTimeSource())
+	if len(cfg.ReadReplicas) > 0 {
+		handle.SetReadReplicas(sqlplugin.NewReadReplicas(
+			cfg,
+			func(cfg *config.SQL) (*sqlx.DB, error) { return p.createDBConnection(dbKind, cfg, r) },
+			isConnNeedsRefreshError,
+			replicationLagQuery,
+			logger,
+			metricsHandler,
+		))
+	}
 	db := newDB(dbKind, cfg.DatabaseName, handle, nil)
 	return db, nil
 }
@@ -27,6 +27,8 @@ package postgresql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -68,6 +70,16 @@ const (
 
 	listTablesQuery = "select table_name from information_schema.tables where table_schema='public'"
 
+	listColumnsQuery = "SELECT table_name, column_name AS name FROM information_schema.columns WHERE table_schema='public'"
+
+	// indexes created implicitly for PRIMARY KEY and UNIQUE constraints are skipped
+	listIndexesQuery = "SELECT t.relname AS table_name, i.relname AS name FROM pg_index x " +
+		"JOIN pg_class t ON t.oid = x.indrelid " +
+		"JOIN pg_class i ON i.oid = x.indexrelid " +
+		"JOIN pg_namespace n ON n.oid = t.relnamespace " +
+		"WHERE n.nspname = 'public' AND NOT x.indisprimary " +
+		"AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = x.indexrelid)"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -112,6 +124,20 @@ func (pdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manife
 	return pdb.handle.ConvertError(err)
 }
 
+// QueryRows executes a query and returns the column values of all result rows
+func (pdb *db) QueryRows(stmt string, args ...interface{}) ([][]interface{}, error) {
+	db, err := pdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.Query(stmt, args...)
+	if err != nil {
+		return nil, pdb.handle.ConvertError(err)
+	}
+	result, err := sqlplugin.ScanRows(rows)
+	return result, pdb.handle.ConvertError(err)
+}
+
 // ListTables returns a list of tables in this database
 func (pdb *db) ListTables(database string) ([]string, error) {
 	var tables []string
@@ -119,6 +145,20 @@ func (pdb *db) ListTables(database string) ([]string, error) {
 	return tables, pdb.handle.ConvertError(err)
 }
 
+// ListColumns returns the columns of all tables in this database
+func (pdb *db) ListColumns(database string) ([]sqlplugin.TableObjectRow, error) {
+	var rows []sqlplugin.TableObjectRow
+	err := pdb.Select(&rows, listColumnsQuery)
+	return rows, pdb.handle.ConvertError(err)
+}
+
+// ListIndexes returns the secondary indexes of all tables in this database
+func (pdb *db) ListIndexes(database string) ([]sqlplugin.TableObjectRow, error) {
+	var rows []sqlplugin.TableObjectRow
+	err := pdb.Select(&rows, listIndexesQuery)
+	return rows, pdb.handle.ConvertError(err)
+}
+
 // DropTable drops a given table from the database
 func (pdb *db) DropTable(name string) error {
 	return pdb.Exec(fmt.Sprintf(dropTableQuery, name))
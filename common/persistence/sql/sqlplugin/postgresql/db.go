@@ -27,15 +27,19 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
 	"go.temporal.io/server/common/resolver"
+	cockroachdbschemaV23 "go.temporal.io/server/schema/cockroachdb/v23"
 	postgresqlschemaV12 "go.temporal.io/server/schema/postgresql/v12"
+	yugabytedbschemaV2 "go.temporal.io/server/schema/yugabytedb/v2"
 )
 
 func (pdb *db) IsDupEntryError(err error) bool {
@@ -51,6 +55,7 @@ type db struct {
 	dbKind   sqlplugin.DbKind
 	dbName   string
 	dbDriver driver.Driver
+	flavor   flavor
 
 	plugin    *plugin
 	cfg       *config.SQL
@@ -59,9 +64,12 @@ type db struct {
 
 	handle *sqlplugin.DatabaseHandle
 	tx     *sqlx.Tx
+	// retryable is set once a statement of tx failed with an error wrapping sqlplugin.ErrTxRetryable
+	retryable bool
 }
 
 var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.RetryableTx = (*db)(nil)
 
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying postgresql database
@@ -69,6 +77,7 @@ func newDB(
 	dbKind sqlplugin.DbKind,
 	dbName string,
 	dbDriver driver.Driver,
+	flavor flavor,
 	handle *sqlplugin.DatabaseHandle,
 	tx *sqlx.Tx,
 ) *db {
@@ -76,6 +85,7 @@ func newDB(
 		dbKind:   dbKind,
 		dbName:   dbName,
 		dbDriver: dbDriver,
+		flavor:   flavor,
 		handle:   handle,
 		tx:       tx,
 	}
@@ -97,11 +107,17 @@ func (pdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 		// This error needs no conversion
 		return nil, err
 	}
-	tx, err := db.BeginTxx(ctx, nil)
+	var opts *sql.TxOptions
+	if pdb.flavor.distributed() {
+		// Locking reads are only advisory on distributed databases, correctness relies on
+		// SERIALIZABLE isolation (see execution.go).
+		opts = &sql.TxOptions{Isolation: sql.LevelSerializable}
+	}
+	tx, err := db.BeginTxx(ctx, opts)
 	if err != nil {
 		return nil, pdb.handle.ConvertError(err)
 	}
-	return newDB(pdb.dbKind, pdb.dbName, pdb.dbDriver, pdb.handle, tx), nil
+	return newDB(pdb.dbKind, pdb.dbName, pdb.dbDriver, pdb.flavor, pdb.handle, tx), nil
 }
 
 // Close closes the connection to the mysql db
@@ -110,9 +126,16 @@ func (pdb *db) Close() error {
 	return nil
 }
 
-// PluginName returns the name of the mysql plugin
+// PluginName returns the name of the postgresql plugin
 func (pdb *db) PluginName() string {
-	return PluginName
+	switch pdb.flavor {
+	case flavorCockroachDB:
+		return PluginNameCockroachDB
+	case flavorYugabyteDB:
+		return PluginNameYugabyteDB
+	default:
+		return PluginName
+	}
 }
 
 // DbName returns the name of the database
@@ -122,11 +145,22 @@ func (pdb *db) DbName() string {
 
 // ExpectedVersion returns expected version.
 func (pdb *db) ExpectedVersion() string {
+	switch pdb.flavor {
+	case flavorCockroachDB:
+		return pdb.expectedVersion(cockroachdbschemaV23.Version, cockroachdbschemaV23.VisibilityVersion)
+	case flavorYugabyteDB:
+		return pdb.expectedVersion(yugabytedbschemaV2.Version, yugabytedbschemaV2.VisibilityVersion)
+	default:
+		return pdb.expectedVersion(postgresqlschemaV12.Version, postgresqlschemaV12.VisibilityVersion)
+	}
+}
+
+func (pdb *db) expectedVersion(version string, visibilityVersion string) string {
 	switch pdb.dbKind {
 	case sqlplugin.DbKindMain:
-		return postgresqlschemaV12.Version
+		return version
 	case sqlplugin.DbKindVisibility:
-		return postgresqlschemaV12.VisibilityVersion
+		return visibilityVersion
 	default:
 		panic(fmt.Sprintf("unknown db kind %v", pdb.dbKind))
 	}
@@ -140,7 +174,12 @@ func (pdb *db) VerifyVersion() error {
 
 // Commit commits a previously started transaction
 func (pdb *db) Commit() error {
-	return pdb.tx.Commit()
+	err := pdb.tx.Commit()
+	if pdb.flavor.distributed() {
+		// Distributed databases may only detect serialization conflicts on commit
+		return pdb.convertError(err)
+	}
+	return err
 }
 
 // Rollback triggers rollback of a previously started transaction
@@ -148,15 +187,44 @@ func (pdb *db) Rollback() error {
 	return pdb.tx.Rollback()
 }
 
+// Retryable implements sqlplugin.RetryableTx
+func (pdb *db) Retryable() bool {
+	return pdb.retryable
+}
+
 // Helper methods to hide common error handling
+func (pdb *db) convertError(err error) error {
+	err = pdb.handle.ConvertError(err)
+	if pdb.tx != nil && errors.Is(err, sqlplugin.ErrTxRetryable) {
+		pdb.retryable = true
+	}
+	return err
+}
+
 func (pdb *db) ExecContext(ctx context.Context, stmt string, args ...any) (sql.Result, error) {
 	res, err := pdb.conn().ExecContext(ctx, stmt, args...)
-	return res, pdb.handle.ConvertError(err)
+	return res, pdb.convertError(err)
+}
+
+// replicaConn returns a read replica connection for reads made with persistence.WithStaleReadsAllowed
+// outside of transactions, or nil if the read has to go to the primary. Reads which fail on the replica
+// are retried on the primary.
+func (pdb *db) replicaConn(ctx context.Context) (sqlplugin.Conn, *sqlplugin.DatabaseHandle) {
+	if pdb.tx != nil || !persistence.StaleReadsAllowed(ctx) {
+		return nil, nil
+	}
+	return pdb.handle.ReplicaConn()
 }
 
 func (pdb *db) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	if conn, handle := pdb.replicaConn(ctx); conn != nil {
+		err := conn.GetContext(ctx, dest, query, args...)
+		if !handle.RetryReplicaReadOnPrimary(ctx, dest, err) {
+			return handle.ConvertError(err)
+		}
+	}
 	err := pdb.conn().GetContext(ctx, dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertError(err)
 }
 
 func (pdb *db) Select(dest any, query string, args ...any) error {
@@ -165,22 +233,28 @@ func (pdb *db) Select(dest any, query string, args ...any) error {
 		return err
 	}
 	err = db.Select(dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertError(err)
 }
 
 func (pdb *db) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	if conn, handle := pdb.replicaConn(ctx); conn != nil {
+		err := conn.SelectContext(ctx, dest, query, args...)
+		if !handle.RetryReplicaReadOnPrimary(ctx, dest, err) {
+			return handle.ConvertError(err)
+		}
+	}
 	err := pdb.conn().SelectContext(ctx, dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertError(err)
 }
 
 func (pdb *db) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
 	res, err := pdb.conn().NamedExecContext(ctx, query, arg)
-	return res, pdb.handle.ConvertError(err)
+	return res, pdb.convertError(err)
 }
 
 func (pdb *db) PrepareNamedContext(ctx context.Context, query string) (*sqlx.NamedStmt, error) {
 	stmt, err := pdb.conn().PrepareNamedContext(ctx, query)
-	return stmt, pdb.handle.ConvertError(err)
+	return stmt, pdb.convertError(err)
 }
 
 func (pdb *db) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
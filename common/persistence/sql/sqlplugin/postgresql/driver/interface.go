@@ -30,11 +30,13 @@ import (
 
 const (
 	// check http://www.postgresql.org/docs/9.3/static/errcodes-appendix.html
-	dupEntryCode            = "23505"
-	dupDatabaseCode         = "42P04"
-	readOnlyTransactionCode = "25006"
-	cannotConnectNowCode    = "57P03"
-	featureNotSupportedCode = "0A000"
+	dupEntryCode             = "23505"
+	dupDatabaseCode          = "42P04"
+	readOnlyTransactionCode  = "25006"
+	cannotConnectNowCode     = "57P03"
+	featureNotSupportedCode  = "0A000"
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
 
 	// Unsupported "feature" messages to look for
 	cannotSetReadWriteModeDuringRecoveryMsg = "cannot set transaction read-write mode during recovery"
@@ -56,3 +58,7 @@ func isConnNeedsRefreshError(code, message string) bool {
 	}
 	return false
 }
+
+func isRetryableTxError(code string) bool {
+	return code == serializationFailureCode || code == deadlockDetectedCode
+}
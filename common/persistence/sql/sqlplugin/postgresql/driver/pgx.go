@@ -25,6 +25,8 @@
 package driver
 
 import (
+	"errors"
+
 	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib" // register pgx driver for sqlx
 	"github.com/jmoiron/sqlx"
@@ -53,3 +55,10 @@ func (p *PGXDriver) IsConnNeedsRefreshError(err error) bool {
 	}
 	return isConnNeedsRefreshError(pqErr.Code, pqErr.Message)
 }
+
+// IsRetryableTxError returns true for pgx errors which abort the transaction but are expected to go
+// away when the whole transaction is retried, such as serialization failures.
+func IsRetryableTxError(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && isRetryableTxError(pgErr.Code)
+}
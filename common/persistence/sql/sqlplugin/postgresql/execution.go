@@ -27,6 +27,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
@@ -52,6 +53,10 @@ const (
 
 	writeLockExecutionQuery = lockExecutionQueryBase + ` FOR UPDATE`
 	readLockExecutionQuery  = lockExecutionQueryBase + ` FOR SHARE`
+	// Distributed databases run transactions with SERIALIZABLE isolation, which already aborts
+	// one of two transactions if the other one modified a row it read. Shared locks are not
+	// needed on top of that and are ignored by some CockroachDB versions anyway.
+	distributedReadLockExecutionQuery = lockExecutionQueryBase
 
 	createCurrentExecutionQuery = `INSERT INTO current_executions
 (shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_time, last_write_version, data, data_encoding) VALUES
@@ -71,6 +76,12 @@ WHERE ce.shard_id = $1 AND ce.namespace_id = $2 AND ce.workflow_id = $3 FOR UPDA
 
 	lockCurrentExecutionQuery = getCurrentExecutionQuery + ` FOR UPDATE`
 
+	// On distributed databases FOR UPDATE on a join locks the rows of both tables, on nodes which
+	// may be far apart, and blocks concurrent updates of the execution. Only current_executions
+	// needs to be locked, the last write version is read separately in the same transaction.
+	getExecutionLastWriteVersionQuery = `SELECT last_write_version FROM executions
+ WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
+
 	updateCurrentExecutionsQuery = `UPDATE current_executions SET
 run_id = :run_id,
 create_request_id = :create_request_id,
@@ -249,10 +260,14 @@ func (pdb *db) ReadLockExecutions(
 	ctx context.Context,
 	filter sqlplugin.ExecutionsFilter,
 ) (int64, int64, error) {
+	query := readLockExecutionQuery
+	if pdb.flavor.distributed() {
+		query = distributedReadLockExecutionQuery
+	}
 	var executionVersion sqlplugin.ExecutionVersion
 	err := pdb.GetContext(ctx,
 		&executionVersion,
-		readLockExecutionQuery,
+		query,
 		filter.ShardID,
 		filter.NamespaceID,
 		filter.WorkflowID,
@@ -352,6 +367,9 @@ func (pdb *db) LockCurrentExecutionsJoinExecutions(
 	ctx context.Context,
 	filter sqlplugin.CurrentExecutionsFilter,
 ) ([]sqlplugin.CurrentExecutionsRow, error) {
+	if pdb.flavor.distributed() {
+		return pdb.lockCurrentExecutionsThenReadExecutions(ctx, filter)
+	}
 	var rows []sqlplugin.CurrentExecutionsRow
 	err := pdb.SelectContext(ctx,
 		&rows,
@@ -363,6 +381,46 @@ func (pdb *db) LockCurrentExecutionsJoinExecutions(
 	return rows, err
 }
 
+// lockCurrentExecutionsThenReadExecutions has the same result as lockCurrentExecutionJoinExecutionsQuery
+// but only locks the row in current_executions table.
+func (pdb *db) lockCurrentExecutionsThenReadExecutions(
+	ctx context.Context,
+	filter sqlplugin.CurrentExecutionsFilter,
+) ([]sqlplugin.CurrentExecutionsRow, error) {
+	var rows []sqlplugin.CurrentExecutionsRow
+	if err := pdb.SelectContext(ctx,
+		&rows,
+		lockCurrentExecutionQuery,
+		filter.ShardID,
+		filter.NamespaceID,
+		filter.WorkflowID,
+	); err != nil {
+		return nil, err
+	}
+	result := rows[:0]
+	for _, row := range rows {
+		var lastWriteVersion int64
+		err := pdb.GetContext(ctx,
+			&lastWriteVersion,
+			getExecutionLastWriteVersionQuery,
+			row.ShardID,
+			row.NamespaceID,
+			row.WorkflowID,
+			row.RunID,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			// same as the inner join
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		row.LastWriteVersion = lastWriteVersion
+		result = append(result, row)
+	}
+	return result, nil
+}
+
 // InsertIntoHistoryImmediateTasks inserts one or more rows into history_immediate_tasks table
 func (pdb *db) InsertIntoHistoryImmediateTasks(
 	ctx context.Context,
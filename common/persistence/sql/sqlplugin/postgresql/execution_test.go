@@ -0,0 +1,192 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	pgdriver "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// fakeConnector is a database/sql connector which answers queries with query.
+	fakeConnector struct {
+		query func(query string, args []driver.Value) (columns []string, rows [][]driver.Value, err error)
+	}
+	fakeConn struct {
+		connector *fakeConnector
+	}
+	fakeStmt struct {
+		connector *fakeConnector
+		query     string
+	}
+	fakeRows struct {
+		columns []string
+		rows    [][]driver.Value
+	}
+)
+
+func TestLockCurrentExecutionsThenReadExecutions(t *testing.T) {
+	namespaceID := primitives.NewUUID()
+	runIDs := []primitives.UUID{primitives.NewUUID(), primitives.NewUUID()}
+	lastWriteVersions := map[string]int64{runIDs[0].String(): 42} // no executions row for runIDs[1]
+
+	var queries []string
+	pdb := newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+		queries = append(queries, query)
+		switch query {
+		case lockCurrentExecutionQuery:
+			require.Equal(t, []driver.Value{int64(1), []byte(namespaceID), "wf"}, args)
+			var rows [][]driver.Value
+			for _, runID := range runIDs {
+				rows = append(rows, []driver.Value{int64(1), []byte(namespaceID), "wf", []byte(runID), int64(7)})
+			}
+			return []string{"shard_id", "namespace_id", "workflow_id", "run_id", "last_write_version"}, rows, nil
+		case getExecutionLastWriteVersionQuery:
+			version, ok := lastWriteVersions[primitives.UUID(args[3].([]byte)).String()]
+			if !ok {
+				return []string{"last_write_version"}, nil, nil
+			}
+			return []string{"last_write_version"}, [][]driver.Value{{version}}, nil
+		}
+		return nil, nil, errors.New("unexpected query")
+	})
+
+	rows, err := pdb.LockCurrentExecutionsJoinExecutions(context.Background(), sqlplugin.CurrentExecutionsFilter{
+		ShardID:     1,
+		NamespaceID: namespaceID,
+		WorkflowID:  "wf",
+	})
+	require.NoError(t, err)
+	// like the inner join, rows without an executions row are dropped and the last write version
+	// is the one of the executions row
+	require.Len(t, rows, 1)
+	require.Equal(t, runIDs[0], rows[0].RunID)
+	require.Equal(t, int64(42), rows[0].LastWriteVersion)
+	// only current_executions is locked
+	require.Equal(t, []string{lockCurrentExecutionQuery, getExecutionLastWriteVersionQuery, getExecutionLastWriteVersionQuery}, queries)
+}
+
+func TestLockCurrentExecutionsThenReadExecutions_Error(t *testing.T) {
+	errRead := errors.New("read failed")
+	pdb := newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+		if query == lockCurrentExecutionQuery {
+			return []string{"shard_id", "run_id"}, [][]driver.Value{{int64(1), []byte(primitives.NewUUID())}}, nil
+		}
+		return nil, nil, errRead
+	})
+
+	_, err := pdb.LockCurrentExecutionsJoinExecutions(context.Background(), sqlplugin.CurrentExecutionsFilter{ShardID: 1})
+	require.ErrorIs(t, err, errRead)
+}
+
+// newFakeDB returns a CockroachDB flavored db whose connection answers queries with query.
+func newFakeDB(
+	t *testing.T,
+	query func(query string, args []driver.Value) ([]string, [][]driver.Value, error),
+) *db {
+	sqlDB := sqlx.NewDb(sql.OpenDB(&fakeConnector{query: query}), "pgx")
+	sqlDB.MapperFunc(strcase.ToSnake)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	handle := sqlplugin.NewDatabaseHandle(
+		func() (*sqlx.DB, error) { return sqlDB, nil },
+		func(error) bool { return false },
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+		clock.NewRealTimeSource(),
+	)
+	return newDB(sqlplugin.DbKindMain, "test", &pgdriver.PGXDriver{}, flavorCockroachDB, handle, nil)
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{connector: c.connector, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	columns, rows, err := s.connector.query(strings.TrimSpace(s.query), args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{columns: columns, rows: rows}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
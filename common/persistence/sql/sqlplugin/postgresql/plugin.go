@@ -25,6 +25,7 @@
 package postgresql
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -45,8 +46,32 @@ const (
 	// PluginName is the name of the plugin
 	PluginName    = "postgres12"
 	PluginNamePGX = "postgres12_pgx"
+	// PluginNameCockroachDB is the name of the plugin for CockroachDB (v23.2+)
+	PluginNameCockroachDB = "cockroachdb"
+	// PluginNameYugabyteDB is the name of the plugin for YugabyteDB YSQL (v2.25+)
+	PluginNameYugabyteDB = "yugabytedb"
 )
 
+// flavor identifies the database behind the PostgreSQL wire protocol.
+type flavor int
+
+const (
+	flavorPostgreSQL flavor = iota
+	flavorCockroachDB
+	flavorYugabyteDB
+)
+
+// replicationLagQuery returns the time since the last replayed transaction on a standby which has not
+// replayed all received WAL yet, 0 if it has (or on a primary), and -1 on a standby without a running
+// WAL receiver, i.e. one which is not streaming from its primary. Rows of pg_stat_wal_receiver are
+// visible without pg_read_all_stats, but their details are not.
+const replicationLagQuery = `SELECT CASE
+ WHEN NOT pg_is_in_recovery() THEN 0
+ WHEN NOT EXISTS (SELECT 1 FROM pg_stat_wal_receiver) THEN -1
+ WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+ ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+ END::float8`
+
 var (
 	defaultDatabaseNames = []string{
 		"postgres",  // normal PostgreSQL default DB name
@@ -55,14 +80,17 @@ var (
 )
 
 type plugin struct {
-	d driver.Driver
+	d      driver.Driver
+	flavor flavor
 }
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
 
 func init() {
-	sql.RegisterPlugin(PluginName, &plugin{&driver.PQDriver{}})
-	sql.RegisterPlugin(PluginNamePGX, &plugin{&driver.PGXDriver{}})
+	sql.RegisterPlugin(PluginName, &plugin{d: &driver.PQDriver{}})
+	sql.RegisterPlugin(PluginNamePGX, &plugin{d: &driver.PGXDriver{}})
+	sql.RegisterPlugin(PluginNameCockroachDB, &plugin{d: &driver.PGXDriver{}, flavor: flavorCockroachDB})
+	sql.RegisterPlugin(PluginNameYugabyteDB, &plugin{d: &driver.PGXDriver{}, flavor: flavorYugabyteDB})
 }
 
 // CreateDB initialize the db object
@@ -81,7 +109,26 @@ func (d *plugin) CreateDB(
 	}
 	needsRefresh := d.d.IsConnNeedsRefreshError
 	handle := sqlplugin.NewDatabaseHandle(connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
-	db := newDB(dbKind, cfg.DatabaseName, d.d, handle, nil)
+	if d.flavor.distributed() {
+		// Distributed databases abort conflicting transactions instead of blocking on them and
+		// expect the client to retry.
+		handle.SetRetryableTxErrorFunc(driver.IsRetryableTxError)
+	}
+	if len(cfg.ReadReplicas) > 0 {
+		if d.flavor.distributed() {
+			handle.Close()
+			return nil, errors.New("read replicas are not supported by distributed SQL plugins")
+		}
+		handle.SetReadReplicas(sqlplugin.NewReadReplicas(
+			cfg,
+			func(cfg *config.SQL) (*sqlx.DB, error) { return d.createDBConnection(cfg, r) },
+			needsRefresh,
+			replicationLagQuery,
+			logger,
+			metricsHandler,
+		))
+	}
+	db := newDB(dbKind, cfg.DatabaseName, d.d, d.flavor, handle, nil)
 	return db, nil
 }
 
@@ -122,3 +169,9 @@ func (d *plugin) createDBConnection(
 		fmt.Sprintf("unable to connect to DB, tried default DB names: %v, errors: %v", strings.Join(defaultDatabaseNames, ","), errors),
 	)
 }
+
+// distributed returns true for distributed SQL databases, which run transactions with SERIALIZABLE
+// isolation and resolve conflicts by aborting transactions with retryable errors.
+func (f flavor) distributed() bool {
+	return f == flavorCockroachDB || f == flavorYugabyteDB
+}
@@ -45,6 +45,8 @@ const (
 
 	lockShardQry     = `SELECT range_id FROM shards WHERE shard_id = $1 FOR UPDATE`
 	readLockShardQry = `SELECT range_id FROM shards WHERE shard_id = $1 FOR SHARE`
+	// see distributedReadLockExecutionQuery
+	distributedReadLockShardQry = `SELECT range_id FROM shards WHERE shard_id = $1`
 )
 
 // InsertIntoShards inserts one or more rows into shards table
@@ -97,10 +99,14 @@ func (pdb *db) ReadLockShards(
 	ctx context.Context,
 	filter sqlplugin.ShardsFilter,
 ) (int64, error) {
+	query := readLockShardQry
+	if pdb.flavor.distributed() {
+		query = distributedReadLockShardQry
+	}
 	var rangeID int64
 	err := pdb.GetContext(ctx,
 		&rangeID,
-		readLockShardQry,
+		query,
 		filter.ShardID,
 	)
 	return rangeID, err
@@ -115,13 +115,9 @@ func (pdb *db) SelectFromVisibility(
 	}
 
 	// Rebind will replace default placeholder `?` with the right placeholder for PostgreSQL.
-	db, err := pdb.handle.DB()
-	if err != nil {
-		return nil, err
-	}
-	filter.Query = db.Rebind(filter.Query)
+	filter.Query = pdb.Rebind(filter.Query)
 	var rows []sqlplugin.VisibilityRow
-	err = db.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
+	err := pdb.SelectContext(ctx, &rows, filter.Query, filter.QueryArgs...)
 	if err != nil {
 		return nil, err
 	}
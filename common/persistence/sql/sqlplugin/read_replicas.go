@@ -0,0 +1,235 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	defaultReadReplicaMaxLag           = 5 * time.Second
+	defaultReadReplicaLagCheckInterval = 2 * time.Second
+
+	readReplicaTargetTagName = "target"
+	primaryTarget            = "primary"
+)
+
+// errReplicaDisconnected is returned by lag checks of a replica which is not connected to its primary.
+var errReplicaDisconnected = errors.New("replica is not connected to its primary")
+
+type (
+	// ReadReplicas routes reads which tolerate replication lag (see persistence.WithStaleReadsAllowed)
+	// to replicas of the database. A replica is only used while its replication lag, checked
+	// periodically with a plugin specific query, is within its configured bound.
+	ReadReplicas struct {
+		replicas []*readReplica
+		lagQuery string
+		next     atomic.Uint32
+		metrics  metrics.Handler
+		logger   log.Logger
+
+		stopOnce sync.Once
+		stopCh   chan struct{}
+	}
+
+	readReplica struct {
+		addr             string
+		maxLag           time.Duration
+		lagCheckInterval time.Duration
+		handle           *DatabaseHandle
+		usable           atomic.Bool
+	}
+)
+
+// NewReadReplicas connects to the read replicas of cfg. lagQuery must return the replication lag of
+// the database it runs on in seconds, 0 if the database is not a replica, and a negative value if
+// the database is a replica which is not connected to its primary. The lag of a disconnected replica
+// can't be measured, since it doesn't know about the transactions it is missing.
+func NewReadReplicas(
+	cfg *config.SQL,
+	connect func(cfg *config.SQL) (*sqlx.DB, error),
+	needsRefresh func(error) bool,
+	lagQuery string,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *ReadReplicas {
+	r := &ReadReplicas{
+		lagQuery: lagQuery,
+		metrics:  metricsHandler,
+		logger:   logger,
+		stopCh:   make(chan struct{}),
+	}
+	for _, replicaCfg := range cfg.ReadReplicas {
+		sqlCfg := *cfg
+		sqlCfg.ConnectAddr = replicaCfg.ConnectAddr
+		sqlCfg.ReadReplicas = nil
+		maxLag := replicaCfg.MaxLag
+		if maxLag <= 0 {
+			maxLag = defaultReadReplicaMaxLag
+		}
+		lagCheckInterval := replicaCfg.LagCheckInterval
+		if lagCheckInterval <= 0 {
+			lagCheckInterval = defaultReadReplicaLagCheckInterval
+		}
+		replicaLogger := log.With(logger, tag.Address(replicaCfg.ConnectAddr))
+		handle := NewDatabaseHandle(
+			func() (*sqlx.DB, error) { return connect(&sqlCfg) },
+			needsRefresh,
+			replicaLogger,
+			metricsHandler,
+			clock.NewRealTimeSource(),
+		)
+		r.replicas = append(r.replicas, &readReplica{
+			addr:             replicaCfg.ConnectAddr,
+			maxLag:           maxLag,
+			lagCheckInterval: lagCheckInterval,
+			handle:           handle,
+		})
+	}
+	for _, replica := range r.replicas {
+		r.checkLag(replica)
+		go r.monitorLag(replica)
+	}
+	return r
+}
+
+// Conn returns a connection to a replica whose lag is within bounds and the handle which owns it, or
+// nil if the read has to go to the primary. Replicas which are not connected are skipped.
+func (r *ReadReplicas) Conn() (Conn, *DatabaseHandle) {
+	type candidate struct {
+		replica *readReplica
+		db      *sqlx.DB
+	}
+	candidates := make([]candidate, 0, len(r.replicas))
+	for _, replica := range r.replicas {
+		if !replica.usable.Load() {
+			continue
+		}
+		if db := replica.handle.db.Load(); db != nil {
+			candidates = append(candidates, candidate{replica: replica, db: db})
+		}
+	}
+	if len(candidates) == 0 {
+		r.recordTarget(primaryTarget)
+		return nil, nil
+	}
+	c := candidates[r.next.Add(1)%uint32(len(candidates))]
+	r.recordTarget(c.replica.addr)
+	return c.db, c.replica.handle
+}
+
+// Close stops the lag checks and closes the connections to all replicas.
+func (r *ReadReplicas) Close() {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+		for _, replica := range r.replicas {
+			replica.handle.Close()
+		}
+	})
+}
+
+func (r *ReadReplicas) recordTarget(target string) {
+	metrics.PersistenceReadReplicaRequests.With(r.metrics).Record(1, metrics.StringTag(readReplicaTargetTagName, target))
+}
+
+func (r *ReadReplicas) monitorLag(replica *readReplica) {
+	ticker := time.NewTicker(replica.lagCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.checkLag(replica)
+		}
+	}
+}
+
+func (r *ReadReplicas) checkLag(replica *readReplica) {
+	lag, err := replica.lag(r.lagQuery)
+	if err != nil {
+		if replica.usable.Swap(false) {
+			r.logger.Warn("sql read replica: unable to check replication lag, sending reads to primary",
+				tag.Address(replica.addr), tag.Error(err))
+		}
+		return
+	}
+	metrics.PersistenceReadReplicaLag.With(r.metrics).Record(lag.Seconds(), metrics.StringTag(readReplicaTargetTagName, replica.addr))
+	usable := lag <= replica.maxLag
+	if replica.usable.Swap(usable) != usable {
+		if usable {
+			r.logger.Info("sql read replica: caught up, sending reads to replica",
+				tag.Address(replica.addr), tag.NewDurationTag("lag", lag))
+		} else {
+			r.logger.Warn("sql read replica: lagging behind, sending reads to primary",
+				tag.Address(replica.addr), tag.NewDurationTag("lag", lag))
+		}
+	}
+}
+
+func (r *readReplica) lag(query string) (time.Duration, error) {
+	db, err := r.handle.DB()
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.lagCheckInterval)
+	defer cancel()
+	var seconds float64
+	if err := db.GetContext(ctx, &seconds, query); err != nil {
+		return 0, r.handle.ConvertError(err)
+	}
+	if seconds < 0 {
+		return 0, errReplicaDisconnected
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// RetryReplicaReadOnPrimary reports whether a read from a replica connection owned by h, which failed
+// with err, should be retried on the primary. Reads which found no rows or whose context is done are
+// not retried. Rows scanned into a slice dest before the failure are discarded.
+func (h *DatabaseHandle) RetryReplicaReadOnPrimary(ctx context.Context, dest any, err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) || ctx.Err() != nil {
+		return false
+	}
+	// Lets the handle reconnect if the connection to the replica is broken.
+	_ = h.ConvertError(err)
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Slice {
+		v.Elem().SetLen(0)
+	}
+	return true
+}
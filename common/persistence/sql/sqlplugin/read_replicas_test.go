@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+func TestReadReplicasConn(t *testing.T) {
+	newReplica := func(addr string, usable bool) *readReplica {
+		handle := &DatabaseHandle{}
+		handle.db.Store(sqlx.NewDb(&sql.DB{}, "test"))
+		replica := &readReplica{addr: addr, handle: handle}
+		replica.usable.Store(usable)
+		return replica
+	}
+	replicas := &ReadReplicas{
+		replicas: []*readReplica{
+			newReplica("replica-1", true),
+			newReplica("replica-2", false),
+			newReplica("replica-3", true),
+		},
+		metrics: metrics.NoopMetricsHandler,
+		logger:  log.NewNoopLogger(),
+	}
+
+	seen := make(map[*DatabaseHandle]int)
+	for i := 0; i < 4; i++ {
+		conn, handle := replicas.Conn()
+		assert.NotNil(t, conn)
+		seen[handle]++
+	}
+	assert.Equal(t, map[*DatabaseHandle]int{
+		replicas.replicas[0].handle: 2,
+		replicas.replicas[2].handle: 2,
+	}, seen)
+
+	// all replicas lag behind: reads go to the primary
+	replicas.replicas[0].usable.Store(false)
+	replicas.replicas[2].usable.Store(false)
+	conn, handle := replicas.Conn()
+	assert.Nil(t, conn)
+	assert.Nil(t, handle)
+
+	// disconnected replicas are skipped in favor of the other usable replicas
+	replicas.replicas[0].usable.Store(true)
+	replicas.replicas[2].usable.Store(true)
+	replicas.replicas[2].handle.db.Store(nil)
+	for i := 0; i < 4; i++ {
+		conn, handle = replicas.Conn()
+		assert.NotNil(t, conn)
+		assert.Equal(t, replicas.replicas[0].handle, handle)
+	}
+
+	// and reads go to the primary once no replica is connected
+	replicas.replicas[0].handle.db.Store(nil)
+	conn, handle = replicas.Conn()
+	assert.Nil(t, conn)
+	assert.Nil(t, handle)
+}
+
+func TestRetryReplicaReadOnPrimary(t *testing.T) {
+	handle := &DatabaseHandle{needsRefresh: func(error) bool { return false }}
+	ctx := context.Background()
+
+	assert.False(t, handle.RetryReplicaReadOnPrimary(ctx, nil, nil))
+	assert.False(t, handle.RetryReplicaReadOnPrimary(ctx, nil, sql.ErrNoRows))
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, handle.RetryReplicaReadOnPrimary(canceledCtx, nil, errors.New("canceled")))
+
+	// rows scanned before the failure are discarded before retrying on the primary
+	rows := []int{1, 2}
+	assert.True(t, handle.RetryReplicaReadOnPrimary(ctx, &rows, errors.New("connection reset")))
+	assert.Empty(t, rows)
+
+	var row int
+	assert.True(t, handle.RetryReplicaReadOnPrimary(ctx, &row, errors.New("connection reset")))
+}
@@ -29,6 +29,8 @@ package sqlite
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -58,6 +60,11 @@ const (
 
 	listTablesQuery = "SELECT name FROM sqlite_master WHERE type='table'"
 
+	listColumnsQuery = "SELECT m.name AS table_name, c.name AS name FROM sqlite_master m, pragma_table_info(m.name) c WHERE m.type='table'"
+
+	// sqlite_autoindex_* indexes are created implicitly for PRIMARY KEY and UNIQUE constraints
+	listIndexesQuery = "SELECT tbl_name AS table_name, name FROM sqlite_master WHERE type='index' AND name NOT LIKE 'sqlite_autoindex%'"
+
 	dropTableQuery = "DROP TABLE %v"
 )
 
@@ -93,6 +100,20 @@ func (mdb *db) Exec(stmt string, args ...interface{}) error {
 	return err
 }
 
+// QueryRows executes a query and returns the column values of all result rows
+func (mdb *db) QueryRows(stmt string, args ...interface{}) ([][]interface{}, error) {
+	rows, err := mdb.db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	return sqlplugin.ScanRows(rows)
+}
+
+// Rebind converts the bind vars of a query to the ones of this database
+func (mdb *db) Rebind(query string) string {
+	return mdb.conn.Rebind(query)
+}
+
 // ListTables returns a list of tables in this database
 func (mdb *db) ListTables(database string) ([]string, error) {
 	var tables []string
@@ -100,6 +121,20 @@ func (mdb *db) ListTables(database string) ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns the columns of all tables in this database
+func (mdb *db) ListColumns(database string) ([]sqlplugin.TableObjectRow, error) {
+	var rows []sqlplugin.TableObjectRow
+	err := mdb.db.Select(&rows, listColumnsQuery)
+	return rows, err
+}
+
+// ListIndexes returns the secondary indexes of all tables in this database
+func (mdb *db) ListIndexes(database string) ([]sqlplugin.TableObjectRow, error) {
+	var rows []sqlplugin.TableObjectRow
+	err := mdb.db.Select(&rows, listIndexesQuery)
+	return rows, err
+}
+
 // DropTable drops a given table from the database
 func (mdb *db) DropTable(name string) error {
 	return mdb.Exec(fmt.Sprintf(dropTableQuery, name))
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/resolver"
+	"modernc.org/sqlite"
+)
+
+// backupConn is the part of the driver connection that exposes the SQLite online backup API.
+type backupConn interface {
+	NewBackup(dstUri string) (*sqlite.Backup, error)
+	NewRestore(srcUri string) (*sqlite.Backup, error)
+}
+
+var _ sqlplugin.BackupPlugin = (*plugin)(nil)
+
+// Backup copies the database to destination with the SQLite online backup API.
+func (p *plugin) Backup(ctx context.Context, cfg *config.SQL, destination string) error {
+	if _, err := os.Stat(destination); err == nil {
+		return fmt.Errorf("backup destination %q already exists", destination)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	var conn *sqlx.DB
+	if isMemoryMode(cfg) {
+		// an in-memory database only exists within the pooled connection
+		db, err := p.connPool.Allocate(cfg, resolver.NewNoopResolver(), p.createDBConnection)
+		if err != nil {
+			return err
+		}
+		defer p.connPool.Close(cfg)
+		conn = db
+	} else {
+		// A separate connection reads a snapshot of the database. With write-ahead logging this does not block
+		// the writes of the server while the copy is made.
+		db, err := openConnection(cfg)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = db.Close() }()
+		conn = db
+	}
+	return copyDatabase(ctx, conn, func(c backupConn) (*sqlite.Backup, error) {
+		return c.NewBackup(fileURI(destination))
+	})
+}
+
+// Restore replaces the contents of the on-disk database with the backup at source. The copy waits for pending
+// writes and replaces the contents in a single transaction, but it does not detect other connections to the
+// database: a server still using it is not stopped and keeps serving from state that no longer matches, so the
+// server must be stopped before restoring.
+func (p *plugin) Restore(ctx context.Context, cfg *config.SQL, source string) error {
+	if isMemoryMode(cfg) {
+		return errors.New("cannot restore into an in-memory database")
+	}
+	if _, err := os.Stat(source); err != nil {
+		return err
+	}
+	db, err := openConnection(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+	return copyDatabase(ctx, db, func(c backupConn) (*sqlite.Backup, error) {
+		return c.NewRestore(fileURI(source))
+	})
+}
+
+func openConnection(cfg *config.SQL) (*sqlx.DB, error) {
+	dsn, err := buildDSN(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error building DSN: %w", err)
+	}
+	db, err := sqlx.Open(goSqlDriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+func copyDatabase(ctx context.Context, db *sqlx.DB, start func(backupConn) (*sqlite.Backup, error)) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(backupConn)
+		if !ok {
+			return fmt.Errorf("unexpected SQLite driver connection type %T", driverConn)
+		}
+		backup, err := start(c)
+		if err != nil {
+			return err
+		}
+		// Copy all pages in a single step, so that the copy is a consistent snapshot.
+		if _, err := backup.Step(-1); err != nil {
+			_ = backup.Finish()
+			return err
+		}
+		return backup.Finish()
+	})
+}
+
+func fileURI(path string) string {
+	return "file:" + (&url.URL{Path: path}).EscapedPath()
+}
@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package sqlite implements the SQL persistence plugin for SQLite.
+//
+// The plugin supports two modes, selected by the connect attributes:
+//
+//   - mode=memory keeps the database in memory. It is lost when the process exits and is meant for development
+//     and tests.
+//   - Any other mode stores the database in the file named by databaseName. This mode is supported for small,
+//     single-node deployments.
+//
+// # Durability
+//
+// On disk, unless overridden by the connect attributes, the database uses write-ahead logging
+// (journal_mode=wal) with synchronous=full. With these settings a transaction is durable on the local disk once
+// its commit returns, and a crash of the process or the host never corrupts the database nor loses a committed
+// transaction. The guarantee is that of a single node: there is no replication, so losing the disk loses the
+// data unless it was backed up. Lowering synchronous or using another journal mode weakens the guarantee.
+//
+// The write-ahead log is checkpointed into the database file once it reaches wal_autocheckpoint pages (1000 by
+// default) and truncated to journal_size_limit bytes (64 MiB by default) after a checkpoint. Both can be set as
+// connect attributes, as can busy_timeout, which bounds how long a connection waits for a lock held by another
+// one, e.g. a backup or the schema tool.
+//
+// The database must only be used by a single Temporal server process.
+//
+// # Schema
+//
+// The schema can be created and upgraded with temporal-sql-tool (tools/sql) like for the other SQL plugins:
+//
+//	temporal-sql-tool --plugin sqlite --db <file> setup-schema -f schema/sqlite/v3/temporal/schema.sql -v <version>
+//	temporal-sql-tool --plugin sqlite --db <file> update-schema -d schema/sqlite/v3/temporal/versioned
+//
+// where <version> is the version of schema.sql, see schema/sqlite/version.go. Setting the connect attribute
+// setup=true instead creates the schema, including the visibility tables, and records its version when the server
+// connects to an empty file. Either way, later schema versions are applied with update-schema.
+//
+// # Backup and restore
+//
+// The plugin implements sqlplugin.BackupPlugin with the SQLite online backup API. A backup is a consistent copy
+// of the database taken while the server keeps running, and can be requested from a running server with
+// "tdbg persistence backup". The backup is written to the backupDir configured for the datastore, on the
+// frontend host that serves the request. Restoring replaces the database file contents and requires the server to be
+// stopped; it is done with "tdbg persistence restore".
+package sqlite
@@ -62,6 +62,16 @@ var queryParameters = map[string]struct{}{
 	"vfs":       {},
 }
 
+// Pragmas applied to on-disk databases unless set in the connect attributes. They enable write-ahead logging and
+// make every commit durable once it returns, see the package documentation.
+var fileModeDefaultPragmas = map[string]string{
+	"journal_mode":       "wal",
+	"synchronous":        "full",
+	"busy_timeout":       "10000",    // ms to wait for a lock held by e.g. a backup or the schema tool
+	"wal_autocheckpoint": "1000",     // pages
+	"journal_size_limit": "67108864", // bytes to truncate the WAL to after a checkpoint
+}
+
 type plugin struct {
 	connPool *connPool
 }
@@ -149,7 +159,15 @@ func (p *plugin) setupSQLiteDatabase(cfg *config.SQL, conn *sqlx.DB) error {
 	}
 
 	// init tables
-	return sqliteschema.SetupSchemaOnDB(db)
+	if err := sqliteschema.SetupSchemaOnDB(db); err != nil {
+		return err
+	}
+
+	// record the schema version, so that temporal-sql-tool can upgrade the schema later on
+	if err := db.CreateSchemaVersionTables(); err != nil {
+		return err
+	}
+	return db.UpdateSchemaVersion(cfg.DatabaseName, sqliteschema.Version, sqliteschema.Version)
 }
 
 func buildDSN(cfg *config.SQL) (string, error) {
@@ -171,13 +189,27 @@ func buildDSN(cfg *config.SQL) (string, error) {
 func buildDSNAttr(cfg *config.SQL) (url.Values, error) {
 	parameters := url.Values{}
 
+	attrs := make(map[string]string, len(cfg.ConnectAttributes)+len(fileModeDefaultPragmas))
+	configured := make(map[string]struct{}, len(cfg.ConnectAttributes))
+	for k, v := range cfg.ConnectAttributes {
+		attrs[k] = v
+		configured[strings.TrimSpace(k)] = struct{}{}
+	}
+	if !isMemoryMode(cfg) {
+		for k, v := range fileModeDefaultPragmas {
+			if _, ok := configured[k]; !ok {
+				attrs[k] = v
+			}
+		}
+	}
+
 	// sort ConnectAttributes to get a deterministic order
-	keys := expmaps.Keys(cfg.ConnectAttributes)
+	keys := expmaps.Keys(attrs)
 	sort.Strings(keys)
 
 	for _, k := range keys {
 		key := strings.TrimSpace(k)
-		value := strings.TrimSpace(cfg.ConnectAttributes[k])
+		value := strings.TrimSpace(attrs[k])
 		if parameters.Get(key) != "" {
 			return nil, fmt.Errorf("duplicate connection attr: %v:%v, %v:%v",
 				key,
@@ -198,3 +230,7 @@ func buildDSNAttr(cfg *config.SQL) (url.Values, error) {
 	parameters.Add("_time_format", "sqlite")
 	return parameters, nil
 }
+
+func isMemoryMode(cfg *config.SQL) bool {
+	return cfg.ConnectAttributes["mode"] == "memory" || cfg.DatabaseName == ":memory:"
+}
@@ -320,7 +320,8 @@ func testCommitTxFails(ctx context.Context, t *testing.T, baseDB sqlplugin.DB) {
 	require.NoError(t, err)
 	_, err = persistencetest.EnqueueMessage(context.Background(), q, queueType, queueName)
 	require.Error(t, err)
-	assert.ErrorContains(t, err, "EnqueueMessage failed")
+	assert.ErrorContains(t, err, "EnqueueMessage operation failed. Failed to commit transaction")
+	assert.ErrorContains(t, err, ErrTxCommitFailed.Error())
 	assert.Equal(t, db.commitCalls, 1)
 }
 
@@ -24,7 +24,11 @@
 
 package sqlplugin
 
-import "strings"
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+)
 
 func appendPrefix(prefix string, fields []string) []string {
 	out := make([]string, len(fields))
@@ -37,3 +41,43 @@ func appendPrefix(prefix string, fields []string) []string {
 func BuildNamedPlaceholder(fields ...string) string {
 	return strings.Join(appendPrefix(":", fields), ", ")
 }
+
+// ScanRows reads all rows and closes them. Values of character columns are returned as strings and values
+// of integer columns as int64 even if the driver reports them as []byte, so they compare the same way as
+// the column when they are passed back as query arguments.
+func ScanRows(rows *sql.Rows) ([][]interface{}, error) {
+	defer func() { _ = rows.Close() }()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	var result [][]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columnTypes))
+		dest := make([]interface{}, len(columnTypes))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, value := range values {
+			b, ok := value.([]byte)
+			if !ok {
+				continue
+			}
+			typeName := strings.ToUpper(columnTypes[i].DatabaseTypeName())
+			switch {
+			case strings.Contains(typeName, "CHAR") || strings.Contains(typeName, "TEXT"):
+				values[i] = string(b)
+			case strings.Contains(typeName, "INT"):
+				if n, err := strconv.ParseInt(string(b), 10, 64); err == nil {
+					values[i] = n
+				}
+			}
+		}
+		result = append(result, values)
+	}
+	return result, rows.Err()
+}
@@ -25,6 +25,7 @@
 package sql
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
@@ -37,7 +38,10 @@ import (
 	expmaps "golang.org/x/exp/maps"
 )
 
-var ErrPluginNotSupported = errors.New("plugin not supported")
+var (
+	ErrPluginNotSupported = errors.New("plugin not supported")
+	ErrBackupNotSupported = errors.New("plugin does not support backups")
+)
 
 var supportedPlugins = map[string]sqlplugin.Plugin{}
 
@@ -74,6 +78,36 @@ func NewSQLAdminDB(
 	return createDB[sqlplugin.AdminDB](dbKind, cfg, r, logger, mh)
 }
 
+// BackupDB writes a consistent copy of the database to destination while it is in use.
+func BackupDB(ctx context.Context, cfg *config.SQL, destination string) error {
+	plugin, err := getBackupPlugin(cfg.PluginName)
+	if err != nil {
+		return err
+	}
+	return plugin.Backup(ctx, cfg, destination)
+}
+
+// RestoreDB replaces the contents of the database with a copy made by BackupDB.
+func RestoreDB(ctx context.Context, cfg *config.SQL, source string) error {
+	plugin, err := getBackupPlugin(cfg.PluginName)
+	if err != nil {
+		return err
+	}
+	return plugin.Restore(ctx, cfg, source)
+}
+
+func getBackupPlugin(pluginName string) (sqlplugin.BackupPlugin, error) {
+	plugin, err := getPlugin(pluginName)
+	if err != nil {
+		return nil, err
+	}
+	backupPlugin, ok := plugin.(sqlplugin.BackupPlugin)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrBackupNotSupported, pluginName)
+	}
+	return backupPlugin, nil
+}
+
 func createDB[T any](
 	dbKind sqlplugin.DbKind,
 	cfg *config.SQL,
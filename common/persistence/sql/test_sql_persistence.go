@@ -167,6 +167,12 @@ func (s *TestCluster) DropDatabase() {
 
 	if cfg2.PluginName == "sqlite" && cfg2.DatabaseName != ":memory:" && cfg2.ConnectAttributes["mode"] != "memory" {
 		if len(cfg2.DatabaseName) > 3 { // 3 should mean not ., .., empty, or /
+			// on-disk databases use write-ahead logging by default
+			for _, suffix := range []string{"-wal", "-shm"} {
+				if err := os.Remove(cfg2.DatabaseName + suffix); err != nil && !os.IsNotExist(err) {
+					panic(err)
+				}
+			}
 			err := os.Remove(cfg2.DatabaseName)
 			if err != nil {
 				panic(err)
@@ -112,7 +112,11 @@ func SetUpCassandraDatabase(t *testing.T, cfg *config.Cassandra, logger log.Logg
 	}
 	defer session.Close()
 
-	if err := cassandra.CreateCassandraKeyspace(
+	createKeyspace := cassandra.CreateCassandraKeyspace
+	if cfg.ScyllaDB != nil {
+		createKeyspace = cassandra.CreateScyllaDBKeyspace
+	}
+	if err := createKeyspace(
 		session,
 		cfg.Keyspace,
 		1,
@@ -243,7 +247,7 @@ func GetSchemaFiles(t *testing.T, schemaDir string, logger log.Logger) []string
 
 // NewCassandraConfig returns a new Cassandra config for test
 func NewCassandraConfig() *config.Cassandra {
-	return &config.Cassandra{
+	cfg := &config.Cassandra{
 		User:           testCassandraUser,
 		Password:       testCassandraPassword,
 		Hosts:          environment.GetCassandraAddress(),
@@ -251,4 +255,8 @@ func NewCassandraConfig() *config.Cassandra {
 		Keyspace:       testCassandraDatabaseNamePrefix + shuffle.String(testCassandraDatabaseNameSuffix),
 		ConnectTimeout: 30 * time.Second,
 	}
+	if environment.GetCassandraScyllaDB() {
+		cfg.ScyllaDB = &config.ScyllaDB{}
+	}
+	return cfg
 }
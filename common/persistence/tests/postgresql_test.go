@@ -35,7 +35,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // register plugins
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	sqltests "go.temporal.io/server/common/persistence/sql/sqlplugin/tests"
 	"go.temporal.io/server/common/resolver"
 )
@@ -164,7 +164,7 @@ func (p *PostgreSQLSuite) TestPostgreSQLTaskQueueUserDataSuite() {
 
 func (p *PostgreSQLSuite) TestPostgreSQLVisibilityPersistenceSuite() {
 	s := &VisibilityPersistenceSuite{
-		TestBase: persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption()),
+		TestBase: persistencetests.NewTestBaseWithSQL(p.testClusterOption()),
 	}
 	suite.Run(p.T(), s)
 }
@@ -173,28 +173,28 @@ func (p *PostgreSQLSuite) TestPostgreSQLVisibilityPersistenceSuite() {
 
 func (p *PostgreSQLSuite) TestPostgreSQLHistoryV2PersistenceSuite() {
 	s := new(persistencetests.HistoryV2PersistenceSuite)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
 
 func (p *PostgreSQLSuite) TestPostgreSQLMetadataPersistenceSuiteV2() {
 	s := new(persistencetests.MetadataPersistenceSuiteV2)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
 
 func (p *PostgreSQLSuite) TestPostgreSQLClusterMetadataPersistence() {
 	s := new(persistencetests.ClusterMetadataManagerSuite)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
 
 func (p *PostgreSQLSuite) TestPostgreSQLQueuePersistence() {
 	s := new(persistencetests.QueuePersistenceSuite)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
@@ -630,6 +630,18 @@ func (p *PostgreSQLSuite) TestPostgreSQLNexusEndpointPersistence() {
 	RunNexusEndpointTestSuiteForSQL(p.T(), testData.Factory)
 }
 
+// testClusterOption returns the options for the persistence-tests suites.
+func (p *PostgreSQLSuite) testClusterOption() *persistencetests.TestBaseOptions {
+	switch p.pluginName {
+	case postgresql.PluginNameCockroachDB:
+		return persistencetests.GetCockroachDBTestClusterOption()
+	case postgresql.PluginNameYugabyteDB:
+		return persistencetests.GetYugabyteDBTestClusterOption()
+	default:
+		return persistencetests.GetPostgreSQLTestClusterOption()
+	}
+}
+
 func TestPQ(t *testing.T) {
 	s := &PostgreSQLSuite{pluginName: "postgres12"}
 	suite.Run(t, s)
@@ -639,3 +651,13 @@ func TestPGX(t *testing.T) {
 	s := &PostgreSQLSuite{pluginName: "postgres12_pgx"}
 	suite.Run(t, s)
 }
+
+func TestCockroachDB(t *testing.T) {
+	s := &PostgreSQLSuite{pluginName: postgresql.PluginNameCockroachDB}
+	suite.Run(t, s)
+}
+
+func TestYugabyteDB(t *testing.T) {
+	s := &PostgreSQLSuite{pluginName: postgresql.PluginNameYugabyteDB}
+	suite.Run(t, s)
+}
@@ -37,6 +37,7 @@ import (
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/shuffle"
 	"go.temporal.io/server/temporal/environment"
@@ -57,6 +58,17 @@ const (
 	//  need to merge persistence test config / initialization in one place
 	testPostgreSQLExecutionSchema  = "../../../schema/postgresql/v12/temporal/schema.sql"
 	testPostgreSQLVisibilitySchema = "../../../schema/postgresql/v12/visibility/schema.sql"
+
+	// CockroachDB runs in insecure mode in tests, which only allows the root user without a password
+	testCockroachDBUser             = "root"
+	testCockroachDBPassword         = ""
+	testCockroachDBExecutionSchema  = "../../../schema/cockroachdb/v23/temporal/schema.sql"
+	testCockroachDBVisibilitySchema = "../../../schema/cockroachdb/v23/visibility/schema.sql"
+
+	testYugabyteDBUser             = "yugabyte"
+	testYugabyteDBPassword         = "yugabyte"
+	testYugabyteDBExecutionSchema  = "../../../schema/yugabytedb/v2/temporal/schema.sql"
+	testYugabyteDBVisibilitySchema = "../../../schema/yugabytedb/v2/visibility/schema.sql"
 )
 
 type (
@@ -94,21 +106,41 @@ func setUpPostgreSQLTest(t *testing.T, pluginName string) (PostgreSQLTestData, f
 	return testData, tearDown
 }
 
-// NewPostgreSQLConfig returns a new MySQL config for test
+// NewPostgreSQLConfig returns a new PostgreSQL config for test. The CockroachDB and YugabyteDB
+// plugins connect to their own test databases.
 func NewPostgreSQLConfig(pluginName string) *config.SQL {
+	user, password := testPostgreSQLUser, testPostgreSQLPassword
+	host, port := environment.GetPostgreSQLAddress(), environment.GetPostgreSQLPort()
+	switch pluginName {
+	case postgresql.PluginNameCockroachDB:
+		user, password = testCockroachDBUser, testCockroachDBPassword
+		host, port = environment.GetCockroachDBAddress(), environment.GetCockroachDBPort()
+	case postgresql.PluginNameYugabyteDB:
+		user, password = testYugabyteDBUser, testYugabyteDBPassword
+		host, port = environment.GetYugabyteDBAddress(), environment.GetYugabyteDBPort()
+	}
 	return &config.SQL{
-		User:     testPostgreSQLUser,
-		Password: testPostgreSQLPassword,
-		ConnectAddr: net.JoinHostPort(
-			environment.GetPostgreSQLAddress(),
-			strconv.Itoa(environment.GetPostgreSQLPort()),
-		),
+		User:            user,
+		Password:        password,
+		ConnectAddr:     net.JoinHostPort(host, strconv.Itoa(port)),
 		ConnectProtocol: testPostgreSQLConnectionProtocol,
 		PluginName:      pluginName,
 		DatabaseName:    testPostgreSQLDatabaseNamePrefix + shuffle.String(testPostgreSQLDatabaseNameSuffix),
 	}
 }
 
+// postgreSQLSchemaFiles returns the execution and visibility schema files for the plugin.
+func postgreSQLSchemaFiles(pluginName string) (string, string) {
+	switch pluginName {
+	case postgresql.PluginNameCockroachDB:
+		return testCockroachDBExecutionSchema, testCockroachDBVisibilitySchema
+	case postgresql.PluginNameYugabyteDB:
+		return testYugabyteDBExecutionSchema, testYugabyteDBVisibilitySchema
+	default:
+		return testPostgreSQLExecutionSchema, testPostgreSQLVisibilitySchema
+	}
+}
+
 func SetupPostgreSQLDatabase(t *testing.T, cfg *config.SQL) {
 	adminCfg := *cfg
 	// NOTE need to connect with empty name to create new database
@@ -133,7 +165,8 @@ func SetupPostgreSQLSchema(t *testing.T, cfg *config.SQL) {
 	}
 	defer func() { _ = db.Close() }()
 
-	schemaPath, err := filepath.Abs(testPostgreSQLExecutionSchema)
+	executionSchema, visibilitySchema := postgreSQLSchemaFiles(cfg.PluginName)
+	schemaPath, err := filepath.Abs(executionSchema)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -149,7 +182,7 @@ func SetupPostgreSQLSchema(t *testing.T, cfg *config.SQL) {
 		}
 	}
 
-	schemaPath, err = filepath.Abs(testPostgreSQLVisibilitySchema)
+	schemaPath, err = filepath.Abs(visibilitySchema)
 	if err != nil {
 		t.Fatal(err)
 	}
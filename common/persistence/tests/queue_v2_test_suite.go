@@ -154,6 +154,10 @@ func RunQueueV2TestSuite(t *testing.T, q persistence.QueueV2) {
 		t.Parallel()
 		RunHistoryTaskQueueManagerTestSuite(t, q)
 	})
+	t.Run("UsageRecordManager", func(t *testing.T) {
+		t.Parallel()
+		RunUsageRecordManagerTestSuite(t, q)
+	})
 }
 
 func testHappyPath(
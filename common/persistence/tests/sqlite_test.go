@@ -45,6 +45,7 @@ import (
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	sqltests "go.temporal.io/server/common/persistence/sql/sqlplugin/tests"
 	"go.temporal.io/server/common/resolver"
+	sqliteschema "go.temporal.io/server/schema/sqlite"
 	"go.temporal.io/server/temporal/environment"
 )
 
@@ -80,6 +81,16 @@ func NewSQLiteFileConfig() *config.SQL {
 	}
 }
 
+// RemoveSQLiteFile removes an on-disk SQLite database together with its write-ahead log.
+func RemoveSQLiteFile(name string) error {
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(name + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Remove(name)
+}
+
 func SetupSQLiteDatabase(t *testing.T, cfg *config.SQL) {
 	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver(), log.NewTestLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
@@ -266,7 +277,7 @@ func TestSQLiteFileExecutionMutableStateStoreSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(t, cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -303,7 +314,7 @@ func TestSQLiteFileExecutionMutableStateTaskStoreSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(t, cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -339,7 +350,7 @@ func TestSQLiteFileHistoryStoreSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(t, cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -365,7 +376,7 @@ func TestSQLiteFileTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(t, cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -391,7 +402,7 @@ func TestSQLiteFileTaskQueueTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(t, cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -417,7 +428,7 @@ func TestSQLiteFileTaskQueueUserDataSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(t, cfg)
 	defer func() {
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	}()
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -848,7 +859,7 @@ func TestSQLiteFileNamespaceSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewNamespaceSuite(t, store)
 	suite.Run(t, s)
@@ -861,7 +872,7 @@ func TestSQLiteFileQueueMessageSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewQueueMessageSuite(t, store)
 	suite.Run(t, s)
@@ -874,7 +885,7 @@ func TestSQLiteFileQueueMetadataSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewQueueMetadataSuite(t, store)
 	suite.Run(t, s)
@@ -887,7 +898,7 @@ func TestSQLiteFileMatchingTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewMatchingTaskSuite(t, store)
 	suite.Run(t, s)
@@ -900,7 +911,7 @@ func TestSQLiteFileMatchingTaskQueueSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewMatchingTaskQueueSuite(t, store)
 	suite.Run(t, s)
@@ -913,7 +924,7 @@ func TestSQLiteFileHistoryShardSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryShardSuite(t, store)
 	suite.Run(t, s)
@@ -926,7 +937,7 @@ func TestSQLiteFileHistoryNodeSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryNodeSuite(t, store)
 	suite.Run(t, s)
@@ -939,7 +950,7 @@ func TestSQLiteFileHistoryTreeSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryTreeSuite(t, store)
 	suite.Run(t, s)
@@ -952,7 +963,7 @@ func TestSQLiteFileHistoryCurrentExecutionSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryCurrentExecutionSuite(t, store)
 	suite.Run(t, s)
@@ -965,7 +976,7 @@ func TestSQLiteFileHistoryExecutionSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionSuite(t, store)
 	suite.Run(t, s)
@@ -978,7 +989,7 @@ func TestSQLiteFileHistoryTransferTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryTransferTaskSuite(t, store)
 	suite.Run(t, s)
@@ -991,7 +1002,7 @@ func TestSQLiteFileHistoryTimerTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryTimerTaskSuite(t, store)
 	suite.Run(t, s)
@@ -1004,7 +1015,7 @@ func TestSQLiteFileHistoryReplicationTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryReplicationTaskSuite(t, store)
 	suite.Run(t, s)
@@ -1017,7 +1028,7 @@ func TestSQLiteFileHistoryVisibilityTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryVisibilityTaskSuite(t, store)
 	suite.Run(t, s)
@@ -1030,7 +1041,7 @@ func TestSQLiteFileHistoryReplicationDLQTaskSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryReplicationDLQTaskSuite(t, store)
 	suite.Run(t, s)
@@ -1043,7 +1054,7 @@ func TestSQLiteFileHistoryExecutionBufferSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionBufferSuite(t, store)
 	suite.Run(t, s)
@@ -1056,7 +1067,7 @@ func TestSQLiteFileHistoryExecutionActivitySuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionActivitySuite(t, store)
 	suite.Run(t, s)
@@ -1069,7 +1080,7 @@ func TestSQLiteFileHistoryExecutionChildWorkflowSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionChildWorkflowSuite(t, store)
 	suite.Run(t, s)
@@ -1082,7 +1093,7 @@ func TestSQLiteFileHistoryExecutionTimerSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionTimerSuite(t, store)
 	suite.Run(t, s)
@@ -1095,7 +1106,7 @@ func TestSQLiteFileHistoryExecutionChasmSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionChasmSuite(t, store)
 	suite.Run(t, s)
@@ -1108,7 +1119,7 @@ func TestSQLiteFileHistoryExecutionRequestCancelSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionRequestCancelSuite(t, store)
 	suite.Run(t, s)
@@ -1121,7 +1132,7 @@ func TestSQLiteFileHistoryExecutionSignalSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionSignalSuite(t, store)
 	suite.Run(t, s)
@@ -1134,7 +1145,7 @@ func TestSQLiteFileHistoryExecutionSignalRequestSuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewHistoryExecutionSignalRequestSuite(t, store)
 	suite.Run(t, s)
@@ -1147,7 +1158,7 @@ func TestSQLiteFileVisibilitySuite(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
-	defer os.Remove(cfg.DatabaseName)
+	defer func() { _ = RemoveSQLiteFile(cfg.DatabaseName) }()
 
 	s := sqltests.NewVisibilitySuite(t, store)
 	suite.Run(t, s)
@@ -1166,7 +1177,7 @@ func TestSQLiteQueueV2(t *testing.T) {
 	)
 	t.Cleanup(func() {
 		factory.Close()
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	})
 	RunQueueV2TestSuiteForSQL(t, factory)
 }
@@ -1184,7 +1195,7 @@ func TestSQLiteNexusEndpointPersistence(t *testing.T) {
 	)
 	t.Cleanup(func() {
 		factory.Close()
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
+		assert.NoError(t, RemoveSQLiteFile(cfg.DatabaseName))
 	})
 	RunNexusEndpointTestSuiteForSQL(t, factory)
 }
@@ -1224,3 +1235,37 @@ func TestSQLiteTransactionContextCancellation(t *testing.T) {
 	assert.NotContains(t, err.Error(), "no such table")
 	assert.ErrorAs(t, err, &gosql.ErrNoRows)
 }
+
+func TestSQLiteFileBackupRestore(t *testing.T) {
+	dir := t.TempDir()
+	cfg := NewSQLiteFileConfig()
+	cfg.DatabaseName = path.Join(dir, cfg.DatabaseName)
+	cfg.ConnectAttributes["setup"] = "true"
+	backupFile := path.Join(dir, "backup.db")
+
+	db, err := sql.NewSQLAdminDB(sqlplugin.DbKindUnknown, cfg, resolver.NewNoopResolver(), log.NewTestLogger(), metrics.NoopMetricsHandler)
+	if err != nil {
+		t.Fatalf("unable to create SQLite admin DB: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	// the schema created on setup is versioned, so that it can be upgraded with the schema tool
+	version, err := db.ReadSchemaVersion(cfg.DatabaseName)
+	assert.NoError(t, err)
+	assert.Equal(t, sqliteschema.Version, version)
+
+	assert.NoError(t, db.Exec("CREATE TABLE backup_test (id INTEGER PRIMARY KEY)"))
+	assert.NoError(t, db.Exec("INSERT INTO backup_test (id) VALUES (1)"))
+	assert.NoError(t, sql.BackupDB(context.Background(), cfg, backupFile))
+	assert.Error(t, sql.BackupDB(context.Background(), cfg, backupFile), "backup must not overwrite an existing file")
+
+	assert.NoError(t, db.DropTable("backup_test"))
+	tables, err := db.ListTables(cfg.DatabaseName)
+	assert.NoError(t, err)
+	assert.NotContains(t, tables, "backup_test")
+
+	assert.NoError(t, sql.RestoreDB(context.Background(), cfg, backupFile))
+	tables, err = db.ListTables(cfg.DatabaseName)
+	assert.NoError(t, err)
+	assert.Contains(t, tables, "backup_test")
+}
@@ -0,0 +1,337 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunUsageRecordManagerTestSuite tests the persistence.UsageRecordManager implementation on top of a QueueV2.
+func RunUsageRecordManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewUsageRecordManager(queue)
+	t.Run("ListBeforeAppend", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerListBeforeAppend(t, manager)
+	})
+	t.Run("AppendAndList", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerAppendAndList(t, manager)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerInvalidRequest(t, manager)
+	})
+	t.Run("AppendIsAtomic", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerAppendIsAtomic(t, manager)
+	})
+	t.Run("AppendRetriesConflicts", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerAppendRetriesConflicts(t, queue)
+	})
+	t.Run("RetriedAppendIsIdempotent", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerRetriedAppendIsIdempotent(t, manager)
+	})
+	t.Run("ListByTime", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerListByTime(t, manager)
+	})
+	t.Run("Trim", func(t *testing.T) {
+		t.Parallel()
+		testUsageRecordManagerTrim(t, manager)
+	})
+}
+
+// conflictingQueue fails the first enqueues like concurrent writers to the same queue would.
+type conflictingQueue struct {
+	persistence.QueueV2
+	conflicts int
+}
+
+func (q *conflictingQueue) EnqueueMessage(
+	ctx context.Context,
+	request *persistence.InternalEnqueueMessageRequest,
+) (*persistence.InternalEnqueueMessageResponse, error) {
+	if q.conflicts > 0 {
+		q.conflicts--
+		return nil, &persistence.ConditionFailedError{Msg: "conflict"}
+	}
+	return q.QueueV2.EnqueueMessage(ctx, request)
+}
+
+func testUsageRecordManagerListBeforeAppend(t *testing.T, manager persistence.UsageRecordManager) {
+	resp, err := manager.ListUsageRecords(context.Background(), &persistence.ListUsageRecordsRequest{
+		NamespaceID: "test-namespace-" + t.Name(),
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Records)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func testUsageRecordManagerAppendAndList(t *testing.T, manager persistence.UsageRecordManager) {
+	ctx := context.Background()
+	namespaceID := "test-namespace-" + t.Name()
+
+	var records []*persistencespb.UsageRecord
+	for i := 0; i < 3; i++ {
+		records = append(records, &persistencespb.UsageRecord{
+			NamespaceId:     namespaceID,
+			WorkflowType:    "test-workflow-type",
+			CostCenter:      "test-cost-center",
+			WindowStartTime: timestamppb.New(time.Unix(int64(i), 0)),
+			Actions:         int64(i + 1),
+			HistoryBytes:    int64(100 * i),
+		})
+	}
+	// Append in two calls: the first creates the queue and the second writes to the existing one.
+	require.NoError(t, manager.AppendUsageRecords(ctx, &persistence.AppendUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		Records:     records[:1],
+	}))
+	require.NoError(t, manager.AppendUsageRecords(ctx, &persistence.AppendUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		Records:     records[1:],
+	}))
+
+	var listed []*persistencespb.UsageRecord
+	var nextPageToken []byte
+	for {
+		resp, err := manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+			NamespaceID:   namespaceID,
+			PageSize:      2,
+			NextPageToken: nextPageToken,
+		})
+		require.NoError(t, err)
+		listed = append(listed, resp.Records...)
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	require.Len(t, listed, len(records))
+	for i := range records {
+		protorequire.ProtoEqual(t, records[i], listed[i])
+	}
+}
+
+func testUsageRecordManagerInvalidRequest(t *testing.T, manager persistence.UsageRecordManager) {
+	ctx := context.Background()
+
+	err := manager.AppendUsageRecords(ctx, &persistence.AppendUsageRecordsRequest{})
+	assert.ErrorIs(t, err, persistence.ErrUsageRecordNamespaceIDEmpty)
+
+	_, err = manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: "test-namespace-" + t.Name(),
+	})
+	assert.ErrorIs(t, err, persistence.ErrListUsageRecordsNonPositivePageSize)
+}
+
+func testUsageRecordManagerAppendIsAtomic(t *testing.T, manager persistence.UsageRecordManager) {
+	ctx := context.Background()
+	namespaceID := "test-namespace-" + t.Name()
+
+	records := []*persistencespb.UsageRecord{
+		{NamespaceId: namespaceID, WorkflowType: "a", Actions: 1},
+		{NamespaceId: namespaceID, WorkflowType: "b", Actions: 2},
+		{NamespaceId: namespaceID, WorkflowType: "c", Actions: 3},
+	}
+	require.NoError(t, manager.AppendUsageRecords(ctx, &persistence.AppendUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		Records:     records,
+	}))
+
+	// All records of the append are stored together, so they're read in a single page of one message.
+	resp, err := manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, len(records))
+	for i := range records {
+		protorequire.ProtoEqual(t, records[i], resp.Records[i])
+	}
+}
+
+func testUsageRecordManagerAppendRetriesConflicts(t *testing.T, queue persistence.QueueV2) {
+	ctx := context.Background()
+	namespaceID := "test-namespace-" + t.Name()
+	manager := persistence.NewUsageRecordManager(&conflictingQueue{QueueV2: queue, conflicts: 1})
+
+	require.NoError(t, manager.AppendUsageRecords(ctx, &persistence.AppendUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		Records:     []*persistencespb.UsageRecord{{NamespaceId: namespaceID, Actions: 1}},
+	}))
+
+	resp, err := manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+}
+
+func testUsageRecordManagerRetriedAppendIsIdempotent(t *testing.T, manager persistence.UsageRecordManager) {
+	ctx := context.Background()
+	namespaceID := "test-namespace-" + t.Name()
+	start := time.Unix(0, 0).UTC()
+	appendHourlyUsageRecords(t, manager, namespaceID, start, 5)
+
+	windowEnd := start.Add(5 * time.Hour)
+	request := func(flushID string) *persistence.AppendUsageRecordsRequest {
+		return &persistence.AppendUsageRecordsRequest{
+			NamespaceID: namespaceID,
+			FlushID:     flushID,
+			Records: []*persistencespb.UsageRecord{{
+				NamespaceId:     namespaceID,
+				WindowStartTime: timestamppb.New(start),
+				WindowEndTime:   timestamppb.New(windowEnd),
+				Actions:         100,
+			}},
+			Retry: true,
+		}
+	}
+	// The first attempt of the flush was applied, so retrying it writes nothing.
+	firstAttempt := request("flush-1")
+	firstAttempt.Retry = false
+	require.NoError(t, manager.AppendUsageRecords(ctx, firstAttempt))
+	require.NoError(t, manager.AppendUsageRecords(ctx, request("flush-1")))
+	// A retried flush whose first attempt wasn't applied is written.
+	require.NoError(t, manager.AppendUsageRecords(ctx, request("flush-2")))
+
+	resp, err := manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    100,
+	})
+	require.NoError(t, err)
+	var flushed int
+	for _, record := range resp.Records {
+		if record.Actions == 100 {
+			flushed++
+		}
+	}
+	require.Equal(t, 2, flushed)
+}
+
+// appendHourlyUsageRecords appends a record per hour, each in its own append, and returns the records.
+func appendHourlyUsageRecords(
+	t *testing.T,
+	manager persistence.UsageRecordManager,
+	namespaceID string,
+	start time.Time,
+	count int,
+) []*persistencespb.UsageRecord {
+	var records []*persistencespb.UsageRecord
+	for i := 0; i < count; i++ {
+		record := &persistencespb.UsageRecord{
+			NamespaceId:     namespaceID,
+			WindowStartTime: timestamppb.New(start.Add(time.Duration(i) * time.Hour)),
+			WindowEndTime:   timestamppb.New(start.Add(time.Duration(i+1) * time.Hour)),
+			Actions:         int64(i),
+		}
+		require.NoError(t, manager.AppendUsageRecords(context.Background(), &persistence.AppendUsageRecordsRequest{
+			NamespaceID: namespaceID,
+			Records:     []*persistencespb.UsageRecord{record},
+		}))
+		records = append(records, record)
+	}
+	return records
+}
+
+func testUsageRecordManagerListByTime(t *testing.T, manager persistence.UsageRecordManager) {
+	ctx := context.Background()
+	namespaceID := "test-namespace-" + t.Name()
+	start := time.Unix(0, 0).UTC()
+	records := appendHourlyUsageRecords(t, manager, namespaceID, start, 20)
+
+	// The first page starts at the requested time instead of at the oldest record.
+	resp, err := manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    1,
+		StartTime:   start.Add(13*time.Hour + 30*time.Minute),
+		EndTime:     start.Add(15 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	protorequire.ProtoEqual(t, records[13], resp.Records[0])
+
+	// Records outside of the range are filtered out of the following pages.
+	resp, err = manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      10,
+		NextPageToken: resp.NextPageToken,
+		StartTime:     start.Add(13*time.Hour + 30*time.Minute),
+		EndTime:       start.Add(15 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	protorequire.ProtoEqual(t, records[14], resp.Records[0])
+
+	// A range past the last record is empty.
+	resp, err = manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    10,
+		StartTime:   start.Add(100 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Records)
+}
+
+func testUsageRecordManagerTrim(t *testing.T, manager persistence.UsageRecordManager) {
+	ctx := context.Background()
+	namespaceID := "test-namespace-" + t.Name()
+
+	// Trimming before anything was metered is a no-op.
+	require.NoError(t, manager.TrimUsageRecords(ctx, &persistence.TrimUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		OlderThan:   time.Now(),
+	}))
+
+	start := time.Unix(0, 0).UTC()
+	records := appendHourlyUsageRecords(t, manager, namespaceID, start, 10)
+	require.NoError(t, manager.TrimUsageRecords(ctx, &persistence.TrimUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		OlderThan:   start.Add(5 * time.Hour),
+	}))
+
+	// Only the records whose windows ended before the trim time are deleted.
+	resp, err := manager.ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		PageSize:    100,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 6)
+	protorequire.ProtoEqual(t, records[4], resp.Records[0])
+}
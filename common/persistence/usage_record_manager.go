@@ -0,0 +1,360 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgSerializeUsageRecord   = "failed to serialize usage record"
+	ErrMsgDeserializeUsageRecord = "failed to deserialize usage record"
+
+	// usageRecordEnqueueAttempts is how many times a batch is enqueued when concurrent writers to the same namespace
+	// queue conflict. A conflicting enqueue writes nothing, so it's safe to retry.
+	usageRecordEnqueueAttempts = 3
+	// usageRecordSeekSlack bounds how far out of order, by window end time, batches may be written to a namespace's
+	// queue by different history hosts. Seeking by time starts this much earlier, and trimming keeps this much more.
+	usageRecordSeekSlack = 10 * time.Minute
+	// usageRecordScanPageSize is the page size used to look for a batch which may have been written already.
+	usageRecordScanPageSize = 100
+)
+
+var (
+	ErrUsageRecordNamespaceIDEmpty         = errors.New("usage record namespace ID is empty")
+	ErrListUsageRecordsNonPositivePageSize = errors.New("page size to list usage records must be positive")
+)
+
+type (
+	usageRecordManagerImpl struct {
+		queue QueueV2
+	}
+)
+
+// NewUsageRecordManager returns a UsageRecordManager which stores records in a QueueV2 of type QueueTypeUsage. Each
+// namespace has its own queue which is created when the first record for it is appended. The records of each append
+// are stored as a single message, so that they're written atomically. Retried appends are idempotent: the batch is
+// only written if no batch with the same flush ID was written around the end of its window.
+func NewUsageRecordManager(queue QueueV2) UsageRecordManager {
+	return &usageRecordManagerImpl{
+		queue: queue,
+	}
+}
+
+func (m *usageRecordManagerImpl) AppendUsageRecords(
+	ctx context.Context,
+	request *AppendUsageRecordsRequest,
+) error {
+	if request.NamespaceID == "" {
+		return ErrUsageRecordNamespaceIDEmpty
+	}
+	if len(request.Records) == 0 {
+		return nil
+	}
+	if request.Retry {
+		written, err := m.containsBatch(ctx, request.NamespaceID, request.FlushID, request.Records[0].GetWindowEndTime().AsTime())
+		if err != nil || written {
+			return err
+		}
+	}
+	batch := &persistencespb.UsageRecordBatch{
+		Records: request.Records,
+		FlushId: request.FlushID,
+	}
+	data, err := batch.Marshal()
+	if err != nil {
+		return fmt.Errorf("%v: %w", ErrMsgSerializeUsageRecord, err)
+	}
+	blob := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         data,
+	}
+	for attempt := 1; ; attempt++ {
+		err = m.enqueue(ctx, request.NamespaceID, blob)
+		var conflict *ConditionFailedError
+		if !errors.As(err, &conflict) || attempt == usageRecordEnqueueAttempts {
+			return err
+		}
+	}
+}
+
+func (m *usageRecordManagerImpl) enqueue(
+	ctx context.Context,
+	namespaceID string,
+	blob *commonpb.DataBlob,
+) error {
+	request := &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeUsage,
+		QueueName: namespaceID,
+		Blob:      blob,
+	}
+	_, err := m.queue.EnqueueMessage(ctx, request)
+	var notFound *serviceerror.NotFound
+	if !errors.As(err, &notFound) {
+		return err
+	}
+
+	_, err = m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+		QueueType: QueueTypeUsage,
+		QueueName: namespaceID,
+	})
+	if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+		return err
+	}
+	_, err = m.queue.EnqueueMessage(ctx, request)
+	return err
+}
+
+func (m *usageRecordManagerImpl) ListUsageRecords(
+	ctx context.Context,
+	request *ListUsageRecordsRequest,
+) (*ListUsageRecordsResponse, error) {
+	if request.NamespaceID == "" {
+		return nil, ErrUsageRecordNamespaceIDEmpty
+	}
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrListUsageRecordsNonPositivePageSize, request.PageSize)
+	}
+
+	nextPageToken := request.NextPageToken
+	if len(nextPageToken) == 0 && !request.StartTime.IsZero() {
+		// Skip the batches that ended before the requested range rather than filtering them out page by page.
+		lastID, ok, err := m.lastBatchEndingBefore(ctx, request.NamespaceID, request.StartTime.Add(-usageRecordSeekSlack))
+		if isUsageQueueNotFound(err) {
+			return &ListUsageRecordsResponse{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			nextPageToken = usageRecordPageTokenAfter(lastID)
+		}
+	}
+
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeUsage,
+		QueueName:     request.NamespaceID,
+		PageSize:      request.PageSize,
+		NextPageToken: nextPageToken,
+	})
+	if isUsageQueueNotFound(err) {
+		// Nothing was metered for this namespace yet.
+		return &ListUsageRecordsResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*persistencespb.UsageRecord
+	for _, message := range response.Messages {
+		batch, err := decodeUsageRecordBatch(message)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range batch.Records {
+			if !request.StartTime.IsZero() && !record.GetWindowEndTime().AsTime().After(request.StartTime) {
+				continue
+			}
+			if !request.EndTime.IsZero() && !record.GetWindowStartTime().AsTime().Before(request.EndTime) {
+				continue
+			}
+			records = append(records, record)
+		}
+	}
+	return &ListUsageRecordsResponse{
+		Records:       records,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+func (m *usageRecordManagerImpl) TrimUsageRecords(
+	ctx context.Context,
+	request *TrimUsageRecordsRequest,
+) error {
+	if request.NamespaceID == "" {
+		return ErrUsageRecordNamespaceIDEmpty
+	}
+
+	lastID, ok, err := m.lastBatchEndingBefore(ctx, request.NamespaceID, request.OlderThan.Add(-usageRecordSeekSlack))
+	if isUsageQueueNotFound(err) || (err == nil && !ok) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeUsage,
+		QueueName:                   request.NamespaceID,
+		InclusiveMaxMessageMetadata: MessageMetadata{ID: lastID},
+	})
+	return err
+}
+
+// containsBatch returns whether the namespace's queue has a batch with the given flush ID, whose records' windows ended
+// at windowEnd. Only the batches written around windowEnd are read.
+func (m *usageRecordManagerImpl) containsBatch(
+	ctx context.Context,
+	namespaceID string,
+	flushID string,
+	windowEnd time.Time,
+) (bool, error) {
+	lastID, ok, err := m.lastBatchEndingBefore(ctx, namespaceID, windowEnd.Add(-usageRecordSeekSlack))
+	if isUsageQueueNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var nextPageToken []byte
+	if ok {
+		nextPageToken = usageRecordPageTokenAfter(lastID)
+	}
+	for {
+		response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+			QueueType:     QueueTypeUsage,
+			QueueName:     namespaceID,
+			PageSize:      usageRecordScanPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, message := range response.Messages {
+			batch, err := decodeUsageRecordBatch(message)
+			if err != nil {
+				return false, err
+			}
+			if batch.GetFlushId() == flushID {
+				return true, nil
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			return false, nil
+		}
+		nextPageToken = response.NextPageToken
+	}
+}
+
+// lastBatchEndingBefore returns the ID of the last batch in the namespace's queue whose window ended before t, and
+// false if there is none. Batches are written in roughly the order their windows end, so the queue is binary searched
+// by message ID, which takes a logarithmic number of reads.
+func (m *usageRecordManagerImpl) lastBatchEndingBefore(
+	ctx context.Context,
+	namespaceID string,
+	t time.Time,
+) (int64, bool, error) {
+	id, windowEnd, ok, err := m.readBatchAfter(ctx, namespaceID, nil)
+	if err != nil || !ok || !windowEnd.Before(t) {
+		return 0, false, err
+	}
+
+	// lo is always a batch which ended before t, and the first batch at or after hi, if any, didn't. Grow hi
+	// exponentially to find an upper bound before searching between the two.
+	lo, hi := id, int64(-1)
+	for step := int64(1); hi < 0; step *= 2 {
+		id, windowEnd, ok, err = m.readBatchAfter(ctx, namespaceID, usageRecordPageTokenAfter(lo+step-1))
+		if err != nil {
+			return 0, false, err
+		}
+		if ok && windowEnd.Before(t) {
+			lo = id
+		} else {
+			hi = lo + step
+		}
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		id, windowEnd, ok, err = m.readBatchAfter(ctx, namespaceID, usageRecordPageTokenAfter(mid-1))
+		if err != nil {
+			return 0, false, err
+		}
+		if ok && id < hi && windowEnd.Before(t) {
+			lo = id
+		} else {
+			hi = mid
+		}
+	}
+	return lo, true, nil
+}
+
+// readBatchAfter reads the first batch of the namespace's queue from nextPageToken, returning its message ID and the
+// end of its window.
+func (m *usageRecordManagerImpl) readBatchAfter(
+	ctx context.Context,
+	namespaceID string,
+	nextPageToken []byte,
+) (int64, time.Time, bool, error) {
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeUsage,
+		QueueName:     namespaceID,
+		PageSize:      1,
+		NextPageToken: nextPageToken,
+	})
+	if err != nil || len(response.Messages) == 0 {
+		return 0, time.Time{}, false, err
+	}
+	message := response.Messages[0]
+	batch, err := decodeUsageRecordBatch(message)
+	if err != nil {
+		return 0, time.Time{}, false, err
+	}
+	// All records of a batch are from the same flush, so share the end of their windows.
+	return message.MetaData.ID, batch.GetRecords()[0].GetWindowEndTime().AsTime(), true, nil
+}
+
+func decodeUsageRecordBatch(message QueueV2Message) (*persistencespb.UsageRecordBatch, error) {
+	batch := &persistencespb.UsageRecordBatch{}
+	if err := serialization.Proto3Decode(message.Data.Data, message.Data.EncodingType, batch); err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeUsageRecord, err)
+	}
+	if len(batch.Records) == 0 {
+		return nil, fmt.Errorf("%v: empty batch", ErrMsgDeserializeUsageRecord)
+	}
+	return batch, nil
+}
+
+// usageRecordPageTokenAfter returns a page token which reads the messages after the given message ID.
+func usageRecordPageTokenAfter(messageID int64) []byte {
+	return GetNextPageTokenForReadMessages([]QueueV2Message{{MetaData: MessageMetadata{ID: messageID}}})
+}
+
+// isUsageQueueNotFound returns whether err is because nothing was metered for the namespace yet, so its queue
+// doesn't exist.
+func isUsageQueueNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound)
+}
+
+func (m *usageRecordManagerImpl) Close() {
+}
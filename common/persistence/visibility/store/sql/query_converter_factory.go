@@ -43,7 +43,7 @@ func NewQueryConverter(
 	switch pluginName {
 	case mysql.PluginName:
 		return newMySQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
-	case postgresql.PluginName, postgresql.PluginNamePGX:
+	case postgresql.PluginName, postgresql.PluginNamePGX, postgresql.PluginNameCockroachDB, postgresql.PluginNameYugabyteDB:
 		return newPostgreSQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
 	case sqlite.PluginName:
 		return newSqliteQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString)
@@ -213,7 +213,8 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 		return nil, err
 	}
 
-	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
+	// Visibility is eventually consistent, list queries may be served by a read replica.
+	rows, err := s.sqlStore.Db.SelectFromVisibility(persistence.WithStaleReadsAllowed(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("ListWorkflowExecutions operation failed. Select failed: %v", err))
@@ -295,7 +296,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		return s.countGroupByWorkflowExecutions(ctx, selectFilter, saTypeMap)
 	}
 
-	count, err := s.sqlStore.Db.CountFromVisibility(ctx, *selectFilter)
+	count, err := s.sqlStore.Db.CountFromVisibility(persistence.WithStaleReadsAllowed(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
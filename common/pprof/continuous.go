@@ -0,0 +1,224 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	ProfileCPU       = "cpu"
+	ProfileHeap      = "heap"
+	ProfileGoroutine = "goroutine"
+	ProfileMutex     = "mutex"
+
+	// LabelNamespace and LabelShardID are the goroutine labels samples are attributed with.
+	LabelNamespace = "namespace"
+	LabelShardID   = "shard_id"
+
+	defaultInterval             = time.Minute
+	defaultCPUDuration          = 10 * time.Second
+	defaultMutexProfileFraction = 10
+)
+
+var (
+	allProfiles = []string{ProfileCPU, ProfileHeap, ProfileGoroutine, ProfileMutex}
+
+	// labelsEnabled is set while the continuous profiler runs, so that goroutine labels are only
+	// paid for when they're used.
+	labelsEnabled atomic.Bool
+)
+
+type (
+	// ContinuousProfiler periodically captures profiles of the process and writes them to a Sink.
+	ContinuousProfiler struct {
+		sink                 Sink
+		logger               log.Logger
+		interval             time.Duration
+		cpuDuration          time.Duration
+		profiles             []string
+		mutexProfileFraction int
+
+		ctx                      context.Context
+		cancel                   context.CancelFunc
+		wg                       sync.WaitGroup
+		prevMutexProfileFraction int
+	}
+)
+
+// NewContinuousProfiler creates a continuous profiler from cfg which writes to sink. The profiler
+// does nothing if sink is nil.
+func NewContinuousProfiler(
+	cfg *config.PProf,
+	sink Sink,
+	logger log.Logger,
+) (*ContinuousProfiler, error) {
+	p := &ContinuousProfiler{
+		sink:                 sink,
+		logger:               logger,
+		interval:             defaultInterval,
+		cpuDuration:          defaultCPUDuration,
+		profiles:             allProfiles,
+		mutexProfileFraction: defaultMutexProfileFraction,
+	}
+	c := cfg.Continuous
+	if c == nil || sink == nil {
+		return p, nil
+	}
+	if c.Interval > 0 {
+		p.interval = c.Interval
+	}
+	if c.CPUDuration > 0 {
+		p.cpuDuration = c.CPUDuration
+	}
+	if p.cpuDuration >= p.interval {
+		return nil, fmt.Errorf("continuous profiling: cpuDuration %v must be shorter than interval %v", p.cpuDuration, p.interval)
+	}
+	if len(c.Profiles) > 0 {
+		for _, name := range c.Profiles {
+			switch name {
+			case ProfileCPU, ProfileHeap, ProfileGoroutine, ProfileMutex:
+			default:
+				return nil, fmt.Errorf("continuous profiling: unknown profile %q", name)
+			}
+		}
+		p.profiles = c.Profiles
+	}
+	if c.MutexProfileFraction > 0 {
+		p.mutexProfileFraction = c.MutexProfileFraction
+	}
+	return p, nil
+}
+
+// Start starts capturing profiles.
+func (p *ContinuousProfiler) Start() {
+	if p.sink == nil {
+		return
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	if slices.Contains(p.profiles, ProfileMutex) {
+		p.prevMutexProfileFraction = runtime.SetMutexProfileFraction(p.mutexProfileFraction)
+	}
+	labelsEnabled.Store(true)
+
+	p.wg.Add(1)
+	go p.run()
+	p.logger.Info("Continuous profiler started",
+		tag.NewDurationTag("interval", p.interval),
+		tag.NewStringsTag("profiles", p.profiles))
+}
+
+// Stop stops capturing profiles and waits for the profile being captured to be written.
+func (p *ContinuousProfiler) Stop() {
+	if p.sink == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+	labelsEnabled.Store(false)
+	if slices.Contains(p.profiles, ProfileMutex) {
+		runtime.SetMutexProfileFraction(p.prevMutexProfileFraction)
+	}
+}
+
+func (p *ContinuousProfiler) run() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			p.capture()
+		}
+	}
+}
+
+func (p *ContinuousProfiler) capture() {
+	for _, name := range p.profiles {
+		profile, err := p.captureProfile(name)
+		if p.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			p.logger.Warn("Unable to capture profile", tag.NewStringTag("profile", name), tag.Error(err))
+			continue
+		}
+		if err := p.sink.Write(p.ctx, profile); err != nil {
+			p.logger.Warn("Unable to write profile", tag.NewStringTag("profile", name), tag.Error(err))
+		}
+	}
+}
+
+func (p *ContinuousProfiler) captureProfile(name string) (Profile, error) {
+	var buf bytes.Buffer
+	start := time.Now()
+	if name == ProfileCPU {
+		// This fails if a CPU profile is already being captured, e.g. through the pprof endpoint.
+		if err := runtimepprof.StartCPUProfile(&buf); err != nil {
+			return Profile{}, err
+		}
+		timer := time.NewTimer(p.cpuDuration)
+		select {
+		case <-p.ctx.Done():
+		case <-timer.C:
+		}
+		timer.Stop()
+		runtimepprof.StopCPUProfile()
+	} else if err := runtimepprof.Lookup(name).WriteTo(&buf, 0); err != nil {
+		return Profile{}, err
+	}
+	return Profile{
+		Kind:  name,
+		Start: start,
+		End:   time.Now(),
+		Data:  buf.Bytes(),
+	}, nil
+}
+
+// Do calls f with ctx. While the continuous profiler runs, the goroutine labels returned by labels
+// are added to ctx and to the goroutine for the duration of f, as in runtime/pprof.Do, so that the
+// samples taken in f are attributed to them. labels returns key-value pairs.
+func Do(ctx context.Context, labels func() []string, f func(context.Context)) {
+	if !labelsEnabled.Load() {
+		f(ctx)
+		return
+	}
+	runtimepprof.Do(ctx, runtimepprof.Labels(labels()...), f)
+}
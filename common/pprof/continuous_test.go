@@ -0,0 +1,193 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	runtimepprof "runtime/pprof"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+type recordingSink struct {
+	sync.Mutex
+	profiles []Profile
+}
+
+func (s *recordingSink) Write(_ context.Context, profile Profile) error {
+	s.Lock()
+	defer s.Unlock()
+	s.profiles = append(s.profiles, profile)
+	return nil
+}
+
+func (s *recordingSink) kinds() map[string]bool {
+	s.Lock()
+	defer s.Unlock()
+	kinds := make(map[string]bool)
+	for _, p := range s.profiles {
+		kinds[p.Kind] = true
+	}
+	return kinds
+}
+
+func TestDirectorySink_Rotates(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewDirectorySink(dir, 2)
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	for i := range 3 {
+		require.NoError(t, sink.Write(context.Background(), Profile{
+			Kind:  ProfileHeap,
+			Start: start.Add(time.Duration(i) * time.Minute),
+			Data:  []byte{byte(i)},
+		}))
+	}
+	require.NoError(t, sink.Write(context.Background(), Profile{Kind: ProfileCPU, Start: start, Data: []byte{9}}))
+
+	heapFiles, err := filepath.Glob(filepath.Join(dir, "heap-*.pb.gz"))
+	require.NoError(t, err)
+	require.Len(t, heapFiles, 2)
+	data, err := os.ReadFile(heapFiles[1])
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, data, "the most recent files should be kept")
+
+	cpuFiles, err := filepath.Glob(filepath.Join(dir, "cpu-*.pb.gz"))
+	require.NoError(t, err)
+	require.Len(t, cpuFiles, 1)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var gotQuery map[string][]string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		gotQuery = r.URL.Query()
+		gotBody, _ = io.ReadAll(r.Body)
+		if gotQuery["name"][0] == ProfileMutex {
+			http.Error(w, "rejected", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewHTTPSink(server.URL+"/ingest", map[string]string{"cluster": "active"}, 0)
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	require.NoError(t, sink.Write(context.Background(), Profile{
+		Kind:  ProfileCPU,
+		Start: start,
+		End:   start.Add(10 * time.Second),
+		Data:  []byte("profile"),
+	}))
+	require.Equal(t, []string{"cpu"}, gotQuery["name"])
+	require.Equal(t, []string{"1700000000"}, gotQuery["from"])
+	require.Equal(t, []string{"1700000010"}, gotQuery["until"])
+	require.Equal(t, []string{"active"}, gotQuery["cluster"])
+	require.Equal(t, []byte("profile"), gotBody)
+
+	err = sink.Write(context.Background(), Profile{Kind: ProfileMutex, Start: start, End: start})
+	require.ErrorContains(t, err, "rejected")
+}
+
+func TestNewSink(t *testing.T) {
+	sink, err := NewSink(&config.PProf{})
+	require.NoError(t, err)
+	require.Nil(t, sink)
+
+	_, err = NewSink(&config.PProf{Continuous: &config.ContinuousProfiling{}})
+	require.Error(t, err)
+
+	_, err = NewSink(&config.PProf{Continuous: &config.ContinuousProfiling{
+		Directory: t.TempDir(),
+		PushURL:   "http://localhost",
+	}})
+	require.Error(t, err)
+
+	sink, err = NewSink(&config.PProf{Continuous: &config.ContinuousProfiling{Directory: t.TempDir()}})
+	require.NoError(t, err)
+	require.IsType(t, &DirectorySink{}, sink)
+}
+
+func TestNewContinuousProfiler_Validates(t *testing.T) {
+	sink := &recordingSink{}
+	_, err := NewContinuousProfiler(&config.PProf{Continuous: &config.ContinuousProfiling{
+		Interval:    time.Second,
+		CPUDuration: time.Second,
+	}}, sink, log.NewNoopLogger())
+	require.Error(t, err)
+
+	_, err = NewContinuousProfiler(&config.PProf{Continuous: &config.ContinuousProfiling{
+		Profiles: []string{"threadcreate"},
+	}}, sink, log.NewNoopLogger())
+	require.Error(t, err)
+}
+
+func TestContinuousProfiler(t *testing.T) {
+	sink := &recordingSink{}
+	profiler, err := NewContinuousProfiler(&config.PProf{Continuous: &config.ContinuousProfiling{
+		Interval:    50 * time.Millisecond,
+		CPUDuration: 10 * time.Millisecond,
+	}}, sink, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	profiler.Start()
+	require.Eventually(t, func() bool {
+		return len(sink.kinds()) == len(allProfiles)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var label string
+	Do(context.Background(), func() []string {
+		return []string{LabelNamespace, "test-namespace"}
+	}, func(ctx context.Context) {
+		label, _ = runtimepprof.Label(ctx, LabelNamespace)
+	})
+	require.Equal(t, "test-namespace", label)
+
+	profiler.Stop()
+
+	Do(context.Background(), func() []string {
+		t.Fatal("labels shouldn't be evaluated while the profiler is stopped")
+		return nil
+	}, func(ctx context.Context) {})
+}
+
+func TestContinuousProfiler_Disabled(t *testing.T) {
+	profiler, err := NewContinuousProfiler(&config.PProf{}, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	profiler.Start()
+	profiler.Stop()
+}
@@ -30,16 +30,21 @@ import (
 	"go.uber.org/fx"
 )
 
-// Requires *config.PProf available in container.
+// Requires *config.PProf available in container. The Sink of the continuous profiler can be
+// replaced with fx.Decorate.
 var Module = fx.Options(
 	fx.Provide(NewInitializer),
+	fx.Provide(NewSink),
+	fx.Provide(NewContinuousProfiler),
 	fx.Invoke(LifetimeHooks),
 )
 
 func LifetimeHooks(
 	lc fx.Lifecycle,
 	pprof *PProfInitializerImpl,
+	profiler *ContinuousProfiler,
 ) {
+	lc.Append(fx.StartStopHook(profiler.Start, profiler.Stop))
 	lc.Append(
 		fx.Hook{
 			OnStart: func(context.Context) error {
@@ -0,0 +1,187 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultMaxFiles    = 10
+	defaultPushTimeout = 10 * time.Second
+)
+
+type (
+	// Profile is a profile captured by the continuous profiler, in the gzipped protobuf format of
+	// runtime/pprof.
+	Profile struct {
+		// Kind is the name of the profile, e.g. "cpu" or "heap".
+		Kind string
+		// Start and End delimit the time the profile covers. They're equal for snapshot profiles
+		// such as the goroutine profile.
+		Start time.Time
+		End   time.Time
+		Data  []byte
+	}
+
+	// Sink receives the profiles captured by the continuous profiler. Sinks are called from a
+	// single goroutine.
+	Sink interface {
+		Write(ctx context.Context, profile Profile) error
+	}
+
+	// DirectorySink writes profiles to files in a local directory and keeps the most recent files
+	// of each profile.
+	DirectorySink struct {
+		dir      string
+		maxFiles int
+	}
+
+	// HTTPSink sends each profile in the body of a POST request to a URL. The kind of the profile,
+	// the unix timestamps of its start and end and the configured labels are passed in the query
+	// string as "name", "from" and "until" and the label names, which is understood by common
+	// profile collectors.
+	HTTPSink struct {
+		url    string
+		labels map[string]string
+		client *http.Client
+	}
+)
+
+// NewSink returns the sink for the continuous profiler configured in cfg, or nil if the
+// continuous profiler is disabled.
+func NewSink(cfg *config.PProf) (Sink, error) {
+	c := cfg.Continuous
+	switch {
+	case c == nil:
+		return nil, nil
+	case c.Directory != "" && c.PushURL != "":
+		return nil, fmt.Errorf("continuous profiling: only one of directory and pushURL can be set")
+	case c.Directory != "":
+		return NewDirectorySink(c.Directory, c.MaxFiles)
+	case c.PushURL != "":
+		return NewHTTPSink(c.PushURL, c.Labels, c.PushTimeout)
+	default:
+		return nil, fmt.Errorf("continuous profiling: one of directory and pushURL must be set")
+	}
+}
+
+// NewDirectorySink returns a sink that writes profiles to dir, keeping the maxFiles most recent
+// files of each profile. maxFiles defaults to 10 if it's not positive.
+func NewDirectorySink(dir string, maxFiles int) (*DirectorySink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("continuous profiling: unable to create directory: %w", err)
+	}
+	if maxFiles <= 0 {
+		maxFiles = defaultMaxFiles
+	}
+	return &DirectorySink{dir: dir, maxFiles: maxFiles}, nil
+}
+
+func (s *DirectorySink) Write(_ context.Context, profile Profile) error {
+	// The zero padded timestamp makes the files of a profile sort by age.
+	name := fmt.Sprintf("%s-%020d.pb.gz", profile.Kind, profile.Start.UnixNano())
+	if err := os.WriteFile(filepath.Join(s.dir, name), profile.Data, 0o644); err != nil {
+		return err
+	}
+	return s.rotate(profile.Kind)
+}
+
+func (s *DirectorySink) rotate(kind string) error {
+	files, err := filepath.Glob(filepath.Join(s.dir, kind+"-*.pb.gz"))
+	if err != nil {
+		return err
+	}
+	if len(files) <= s.maxFiles {
+		return nil
+	}
+	slices.Sort(files)
+	for _, file := range files[:len(files)-s.maxFiles] {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewHTTPSink returns a sink that sends profiles to pushURL. timeout defaults to 10 seconds if
+// it's not positive.
+func NewHTTPSink(pushURL string, labels map[string]string, timeout time.Duration) (*HTTPSink, error) {
+	if _, err := url.Parse(pushURL); err != nil {
+		return nil, fmt.Errorf("continuous profiling: invalid pushURL: %w", err)
+	}
+	if timeout <= 0 {
+		timeout = defaultPushTimeout
+	}
+	return &HTTPSink{
+		url:    pushURL,
+		labels: labels,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *HTTPSink) Write(ctx context.Context, profile Profile) error {
+	u, err := url.Parse(s.url)
+	if err != nil {
+		return err
+	}
+	query := u.Query()
+	for k, v := range s.labels {
+		query.Set(k, v)
+	}
+	query.Set("name", profile.Kind)
+	query.Set("from", strconv.FormatInt(profile.Start.Unix(), 10))
+	query.Set("until", strconv.FormatInt(profile.End.Unix(), 10))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(profile.Data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("profile push failed with status %v: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
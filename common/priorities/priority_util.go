@@ -26,6 +26,15 @@ import (
 	"cmp"
 
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// FairnessKeyHeaderName is the header field that sets the fairness key of a workflow's or activity's tasks.
+	// Its value must be a string payload.
+	FairnessKeyHeaderName = "temporal-fairness-key"
+	// MaxFairnessKeyLength is the maximum length of a fairness key, longer keys are ignored.
+	MaxFairnessKeyLength = 64
 )
 
 func Merge(
@@ -40,3 +49,16 @@ func Merge(
 		PriorityKey: cmp.Or(override.PriorityKey, base.PriorityKey),
 	}
 }
+
+// FairnessKeyFromHeader returns the fairness key set in header, or the empty key if it's not set or not a valid key.
+func FairnessKeyFromHeader(header *commonpb.Header) string {
+	p, ok := header.GetFields()[FairnessKeyHeaderName]
+	if !ok {
+		return ""
+	}
+	var key string
+	if err := payload.Decode(p, &key); err != nil || len(key) > MaxFairnessKeyLength {
+		return ""
+	}
+	return key
+}
@@ -23,10 +23,12 @@
 package priorities
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
 )
 
 func TestMerge(t *testing.T) {
@@ -77,3 +79,16 @@ func TestMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestFairnessKeyFromHeader(t *testing.T) {
+	header := func(p *commonpb.Payload) *commonpb.Header {
+		return &commonpb.Header{Fields: map[string]*commonpb.Payload{FairnessKeyHeaderName: p}}
+	}
+
+	require.Equal(t, "", FairnessKeyFromHeader(nil))
+	require.Equal(t, "", FairnessKeyFromHeader(&commonpb.Header{}))
+	require.Equal(t, "tenant-a", FairnessKeyFromHeader(header(payload.EncodeString("tenant-a"))))
+	// Keys that aren't strings or are too long are ignored.
+	require.Equal(t, "", FairnessKeyFromHeader(header(payload.EncodeBytes([]byte("tenant-a")))))
+	require.Equal(t, "", FairnessKeyFromHeader(header(payload.EncodeString(strings.Repeat("a", MaxFairnessKeyLength+1)))))
+}
@@ -45,6 +45,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.AddTasksResponse:
 		return nil
+	case *adminservice.BackupPersistenceRequest:
+		return nil
+	case *adminservice.BackupPersistenceResponse:
+		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
 	case *adminservice.CancelDLQJobResponse:
@@ -57,6 +61,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteTaskQueueBacklogTasksRequest:
+		return nil
+	case *adminservice.DeleteTaskQueueBacklogTasksResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -86,6 +94,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeNexusEndpointRequest:
+		return nil
+	case *adminservice.DescribeNexusEndpointResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
@@ -133,6 +145,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetShardResponse:
 		return nil
+	case *adminservice.GetTaskQueueScalingRecommendationRequest:
+		return nil
+	case *adminservice.GetTaskQueueScalingRecommendationResponse:
+		return nil
 	case *adminservice.GetTaskQueueTasksRequest:
 		return nil
 	case *adminservice.GetTaskQueueTasksResponse:
@@ -174,6 +190,20 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListTaskQueueBacklogRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *adminservice.ListTaskQueueBacklogResponse:
+		return nil
+	case *adminservice.ListTaskQueueWorkersRequest:
+		return nil
+	case *adminservice.ListTaskQueueWorkersResponse:
+		return nil
+	case *adminservice.ListUsageRecordsRequest:
+		return nil
+	case *adminservice.ListUsageRecordsResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -237,6 +267,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateNexusEndpointOutboundSettingsRequest:
+		return nil
+	case *adminservice.UpdateNexusEndpointOutboundSettingsResponse:
+		return nil
+	case *adminservice.UpdateTaskQueueConfigRequest:
+		return nil
+	case *adminservice.UpdateTaskQueueConfigResponse:
+		return nil
 	default:
 		return nil
 	}
@@ -63,6 +63,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.CreateNexusEndpointResponse:
 		return nil
+	case *matchingservice.DeleteBacklogTasksRequest:
+		return nil
+	case *matchingservice.DeleteBacklogTasksResponse:
+		return nil
 	case *matchingservice.DeleteNexusEndpointRequest:
 		return nil
 	case *matchingservice.DeleteNexusEndpointResponse:
@@ -107,6 +111,12 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.GetWorkerVersioningRulesResponse:
 		return nil
+	case *matchingservice.ListBacklogTasksRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *matchingservice.ListBacklogTasksResponse:
+		return nil
 	case *matchingservice.ListNexusEndpointsRequest:
 		return nil
 	case *matchingservice.ListNexusEndpointsResponse:
@@ -115,6 +125,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.ListTaskQueuePartitionsResponse:
 		return nil
+	case *matchingservice.ListTaskQueueWorkersRequest:
+		return nil
+	case *matchingservice.ListTaskQueueWorkersResponse:
+		return nil
 	case *matchingservice.PollActivityTaskQueueRequest:
 		return nil
 	case *matchingservice.PollActivityTaskQueueResponse:
@@ -164,6 +178,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateNexusEndpointResponse:
 		return nil
+	case *matchingservice.UpdateTaskQueueConfigRequest:
+		return nil
+	case *matchingservice.UpdateTaskQueueConfigResponse:
+		return nil
 	case *matchingservice.UpdateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.UpdateTaskQueueUserDataResponse:
@@ -79,7 +79,8 @@ func (nli *NamespaceLogInterceptor) Intercept(
 			tag.WorkflowNamespace(namespace.String()),
 			tag.Operation(methodName),
 			tag.ServerName(serverName),
-			tag.CertThumbprint(certThumbprint))
+			tag.CertThumbprint(certThumbprint),
+			tag.TraceContext(ctx))
 	}
 	return handler(ctx, req)
 }
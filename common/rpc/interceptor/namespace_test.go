@@ -81,6 +81,7 @@ var (
 		"ListTasks":                 {},
 		"CompleteNexusOperation":    {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":           {},
+		"DescribeNexusEndpoint":     {}, // Nexus endpoints are not scoped to a namespace.
 	}
 )
 
@@ -188,6 +188,7 @@ func (ti *TelemetryInterceptor) UnaryIntercept(
 	nsName := MustGetNamespaceName(ti.namespaceRegistry, req)
 
 	metricsHandler, logTags := ti.unaryMetricsHandlerLogTags(req, info.FullMethod, methodName, nsName)
+	logTags = append(logTags, tag.TraceContext(ctx))
 
 	ctx = AddTelemetryContext(ctx, metricsHandler)
 	metrics.ServiceRequests.With(metricsHandler).Record(1)
@@ -217,13 +218,13 @@ func (ti *TelemetryInterceptor) RecordLatencyMetrics(ctx context.Context, startT
 	userLatencyDuration := time.Duration(0)
 	if val, ok := metrics.ContextCounterGet(ctx, metrics.HistoryWorkflowExecutionCacheLatency.Name()); ok {
 		userLatencyDuration = time.Duration(val)
-		metrics.ServiceLatencyUserLatency.With(metricsHandler).Record(userLatencyDuration)
+		metrics.ServiceLatencyUserLatency.With(metricsHandler).RecordContext(ctx, userLatencyDuration)
 	}
 
 	latency := time.Since(startTime)
-	metrics.ServiceLatency.With(metricsHandler).Record(latency)
+	metrics.ServiceLatency.With(metricsHandler).RecordContext(ctx, latency)
 	noUserLatency := max(0, latency-userLatencyDuration)
-	metrics.ServiceLatencyNoUserLatency.With(metricsHandler).Record(noUserLatency)
+	metrics.ServiceLatencyNoUserLatency.With(metricsHandler).RecordContext(ctx, noUserLatency)
 }
 
 func (ti *TelemetryInterceptor) StreamIntercept(
@@ -234,6 +235,7 @@ func (ti *TelemetryInterceptor) StreamIntercept(
 ) error {
 	methodName := api.MethodName(info.FullMethod)
 	metricsHandler, logTags := ti.streamMetricsHandlerLogTags(info.FullMethod, methodName)
+	logTags = append(logTags, tag.TraceContext(serverStream.Context()))
 	metrics.ServiceRequests.With(metricsHandler).Record(1)
 
 	err := handler(service, serverStream)
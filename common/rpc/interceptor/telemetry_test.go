@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -46,6 +47,10 @@ import (
 	"go.temporal.io/server/common/namespace"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -468,6 +473,38 @@ func TestHandleError(t *testing.T) {
 	}
 }
 
+func TestUnaryIntercept_LogsTraceContext(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	telemetry := NewTelemetryInterceptor(
+		namespace.NewMockRegistry(gomock.NewController(t)),
+		metrics.NoopMetricsHandler,
+		log.NewZapLogger(zap.New(core)),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+	)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	_, err := telemetry.UnaryIntercept(
+		ctx,
+		&workflowservice.GetSystemInfoRequest{},
+		&grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "GetSystemInfo"},
+		func(context.Context, any) (any, error) {
+			return nil, serviceerror.NewInternal("boom")
+		},
+	)
+	assert.Error(t, err)
+
+	entries := logs.FilterMessage("service failures").All()
+	if assert.Len(t, entries, 1) {
+		fields := entries[0].ContextMap()
+		assert.Equal(t, spanContext.TraceID().String(), fields[tag.TraceIDKey])
+		assert.Equal(t, spanContext.SpanID().String(), fields[tag.SpanIDKey])
+	}
+}
+
 func TestOperationOverwrite(t *testing.T) {
 	testCases := []struct {
 		methodName        string
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/telemetry"
+	"google.golang.org/grpc"
+)
+
+type (
+	// TraceInterceptor makes the sampling decision for inbound requests once their namespace is known. The
+	// namespace sampler defers the decision for the span otelgrpc starts for a request without a sampled
+	// parent; this interceptor replaces that span with a new root span tagged with the namespace of the
+	// request, which is sampled with the rate of the namespace.
+	TraceInterceptor struct {
+		namespaceRegistry namespace.Registry
+		tracer            trace.Tracer
+	}
+
+	tracedServerStream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*TraceInterceptor)(nil).UnaryIntercept
+var _ grpc.StreamServerInterceptor = (*TraceInterceptor)(nil).StreamIntercept
+
+func NewTraceInterceptor(
+	namespaceRegistry namespace.Registry,
+	tracerProvider trace.TracerProvider,
+) *TraceInterceptor {
+	return &TraceInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		tracer:            tracerProvider.Tracer(telemetry.ComponentGRPCServer),
+	}
+}
+
+func (ti *TraceInterceptor) UnaryIntercept(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if !telemetry.IsSamplingDeferred(trace.SpanFromContext(ctx)) {
+		return handler(ctx, req)
+	}
+	nsName := MustGetNamespaceName(ti.namespaceRegistry, req)
+	ctx, span := ti.startRootSpan(ctx, info.FullMethod, nsName)
+	resp, err := handler(ctx, req)
+	endTraceSpan(span, err)
+	return resp, err
+}
+
+func (ti *TraceInterceptor) StreamIntercept(
+	service any,
+	serverStream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if !telemetry.IsSamplingDeferred(trace.SpanFromContext(serverStream.Context())) {
+		return handler(service, serverStream)
+	}
+	// streams aren't bound to a namespace
+	ctx, span := ti.startRootSpan(serverStream.Context(), info.FullMethod, "")
+	err := handler(service, &tracedServerStream{ServerStream: serverStream, ctx: ctx})
+	endTraceSpan(span, err)
+	return err
+}
+
+// startRootSpan starts a new root span in place of the deferred span in ctx, with the same name and
+// attributes and the namespace of the request.
+func (ti *TraceInterceptor) startRootSpan(
+	ctx context.Context,
+	fullMethod string,
+	nsName namespace.Name,
+) (context.Context, trace.Span) {
+	name := fullMethod
+	var attrs []attribute.KeyValue
+	if deferred, ok := trace.SpanFromContext(ctx).(otelsdktrace.ReadOnlySpan); ok {
+		name = deferred.Name()
+		attrs = deferred.Attributes()
+	}
+	attrs = append(attrs, attribute.String(telemetry.NamespaceKey, nsName.String()))
+	return ti.tracer.Start(ctx, name,
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func endTraceSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/telemetry"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+func TestTraceInterceptor_SamplesByNamespace(t *testing.T) {
+	rates := map[string]float64{"sampled": 1}
+	spanRecorder := tracetest.NewSpanRecorder()
+	tp := otelsdktrace.NewTracerProvider(
+		otelsdktrace.WithSampler(telemetry.NewNamespaceSampler(func(ns string) float64 { return rates[ns] })),
+		otelsdktrace.WithSpanProcessor(spanRecorder),
+	)
+	registry := namespace.NewMockRegistry(gomock.NewController(t))
+	registry.EXPECT().GetNamespace(gomock.Any()).Return(nil, nil).AnyTimes()
+	ti := NewTraceInterceptor(registry, tp)
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "StartWorkflowExecution"}
+
+	for ns, sampled := range map[string]bool{"sampled": true, "unsampled": false} {
+		t.Run(ns, func(t *testing.T) {
+			spanRecorder.Reset()
+			// like the span otelgrpc starts for a request without a parent
+			ctx, inbound := tp.Tracer("otelgrpc").Start(context.Background(), "inbound",
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", "StartWorkflowExecution")))
+			require.True(t, telemetry.IsSamplingDeferred(inbound))
+
+			_, err := ti.UnaryIntercept(ctx, &workflowservice.StartWorkflowExecutionRequest{Namespace: ns}, info,
+				func(ctx context.Context, _ any) (any, error) {
+					span := trace.SpanFromContext(ctx)
+					require.False(t, telemetry.IsSamplingDeferred(span))
+					require.Equal(t, sampled, span.SpanContext().IsSampled())
+					return nil, nil
+				})
+			require.NoError(t, err)
+			inbound.End()
+
+			ended := spanRecorder.Ended()
+			if !sampled {
+				require.Len(t, ended, 1) // only the deferred span, which is recorded but not exported
+				return
+			}
+			require.Len(t, ended, 2)
+			root := ended[0]
+			require.Equal(t, "inbound", root.Name())
+			require.Equal(t, trace.SpanKindServer, root.SpanKind())
+			require.Contains(t, root.Attributes(), attribute.String(telemetry.NamespaceKey, ns))
+			require.Contains(t, root.Attributes(), attribute.String("rpc.method", "StartWorkflowExecution"))
+			require.NotEqual(t, inbound.SpanContext().TraceID(), root.SpanContext().TraceID())
+		})
+	}
+}
+
+func TestTraceInterceptor_KeepsSampledSpan(t *testing.T) {
+	tp := otelsdktrace.NewTracerProvider()
+	ti := NewTraceInterceptor(namespace.NewMockRegistry(gomock.NewController(t)), tp)
+	ctx, inbound := tp.Tracer("otelgrpc").Start(context.Background(), "inbound", trace.WithSpanKind(trace.SpanKindServer))
+	defer inbound.End()
+
+	_, err := ti.UnaryIntercept(ctx, &workflowservice.StartWorkflowExecutionRequest{Namespace: "ns"},
+		&grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "StartWorkflowExecution"},
+		func(ctx context.Context, _ any) (any, error) {
+			require.Equal(t, inbound.SpanContext(), trace.SpanFromContext(ctx).SpanContext())
+			return nil, nil
+		})
+	require.NoError(t, err)
+}
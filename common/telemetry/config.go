@@ -186,6 +186,16 @@ func (ec *ExportConfig) MetricExporters() ([]metric.Exporter, error) {
 	return ec.inner.MetricExporters()
 }
 
+// Dial returns the gRPC connection with the given name, which is shared with the exporters that refer
+// to it, e.g. to export logs over the same connection as traces.
+func (ec *ExportConfig) Dial(name string) (*grpc.ClientConn, error) {
+	conncfg, ok := ec.inner.findNamedGrpcConnCfg(name)
+	if !ok {
+		return nil, fmt.Errorf("OTEL exporter connection %q not found", name)
+	}
+	return conncfg.Dial()
+}
+
 // Dial returns the cached *grpc.ClientConn instance or creates a new one,
 // caches and then returns it. This function is not threadsafe.
 func (g *grpcconn) Dial() (*grpc.ClientConn, error) {
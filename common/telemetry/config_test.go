@@ -100,6 +100,30 @@ func TestExportersWithSharedConn(t *testing.T) {
 	require.Len(t, metricExporters, 1)
 }
 
+func TestDialSharedConn(t *testing.T) {
+	var cfg telemetry.ExportConfig
+	err := yaml.Unmarshal([]byte(`
+connections:
+  - kind: grpc
+    metadata:
+      name: conn1
+    spec:
+      endpoint: localhost:4317
+      insecure: true
+`), &cfg)
+	require.NoError(t, err)
+
+	conn, err := cfg.Dial("conn1")
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	again, err := cfg.Dial("conn1")
+	require.NoError(t, err)
+	require.Same(t, conn, again)
+
+	_, err = cfg.Dial("unknown")
+	require.Error(t, err)
+}
+
 func TestSharedConn(t *testing.T) {
 	root := struct{ Otel telemetry.PrivateExportConfig }{}
 	err := yaml.Unmarshal([]byte(sharedConnOTLPConfig), &root)
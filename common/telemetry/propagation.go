@@ -0,0 +1,68 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// taskPropagator encodes the trace context persisted with tasks. It uses the same W3C format as the propagator
+// used for gRPC calls so that a trace can be continued across both.
+var taskPropagator = propagation.TraceContext{}
+
+// InjectTraceContext returns the context of the span in ctx in a form that can be persisted with a task, or nil
+// if ctx has no span. Unsampled span contexts are included as well so the sampling decision is kept when the
+// trace is continued.
+func InjectTraceContext(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	taskPropagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// ExtractTraceContext returns the span context persisted by InjectTraceContext. The returned span context is
+// invalid if there is none.
+func ExtractTraceContext(traceContext map[string]string) trace.SpanContext {
+	if len(traceContext) == 0 {
+		return trace.SpanContext{}
+	}
+	ctx := taskPropagator.Extract(context.Background(), propagation.MapCarrier(traceContext))
+	return trace.SpanContextFromContext(ctx)
+}
+
+// ContinueTrace returns ctx with the span context persisted by InjectTraceContext as the remote parent of spans
+// started from it. ctx is returned unchanged if there is no valid span context.
+func ContinueTrace(ctx context.Context, traceContext map[string]string) context.Context {
+	sc := ExtractTraceContext(traceContext)
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/common/telemetry"
+)
+
+func TestTraceContext_RoundTrip(t *testing.T) {
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSpanProcessor(tracetest.NewSpanRecorder()))
+	ctx, span := tp.Tracer("test").Start(context.Background(), "producer")
+	defer span.End()
+
+	traceContext := telemetry.InjectTraceContext(ctx)
+	require.NotEmpty(t, traceContext)
+
+	sc := telemetry.ExtractTraceContext(traceContext)
+	require.True(t, sc.IsValid())
+	require.True(t, sc.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), sc.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), sc.SpanID())
+
+	_, child := tp.Tracer("test").Start(telemetry.ContinueTrace(context.Background(), traceContext), "consumer")
+	defer child.End()
+	require.Equal(t, span.SpanContext().TraceID(), child.SpanContext().TraceID())
+}
+
+func TestTraceContext_NoSpan(t *testing.T) {
+	require.Nil(t, telemetry.InjectTraceContext(context.Background()))
+	require.False(t, telemetry.ExtractTraceContext(nil).IsValid())
+	require.False(t, telemetry.ExtractTraceContext(map[string]string{"traceparent": "invalid"}).IsValid())
+
+	ctx := context.Background()
+	require.Equal(t, ctx, telemetry.ContinueTrace(ctx, nil))
+}
+
+func TestTraceContext_Unsampled(t *testing.T) {
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSampler(otelsdktrace.NeverSample()))
+	ctx, span := tp.Tracer("test").Start(context.Background(), "producer")
+	defer span.End()
+
+	// The sampling decision is kept so that the continued trace isn't sampled either.
+	sc := telemetry.ExtractTraceContext(telemetry.InjectTraceContext(ctx))
+	require.True(t, sc.IsValid())
+	require.False(t, sc.IsSampled())
+
+	sampledTP := otelsdktrace.NewTracerProvider(otelsdktrace.WithSampler(telemetry.NewNamespaceSampler(
+		func(string) float64 { return 1 },
+	)))
+	_, child := sampledTP.Tracer("test").Start(trace.ContextWithRemoteSpanContext(context.Background(), sc), "consumer")
+	defer child.End()
+	require.False(t, child.SpanContext().IsSampled())
+}
@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry
+
+import (
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// rpcSystemKey is the attribute otelgrpc sets on gRPC spans.
+const rpcSystemKey = "rpc.system"
+
+type namespaceSampler struct {
+	samplingRate func(namespace string) float64
+}
+
+// NewNamespaceSampler returns a sampler that samples new traces with the rate returned for their namespace,
+// which is taken from the NamespaceKey attribute of the root span. Traces without the attribute use the rate
+// of the empty namespace. Spans with a parent follow the sampling decision of the parent.
+//
+// Inbound gRPC requests don't have the attribute when their span is started, so the decision for them is
+// deferred: their span is recorded but not sampled, and the inbound TraceInterceptor replaces it with a new
+// root span that has the namespace of the request.
+func NewNamespaceSampler(samplingRate func(namespace string) float64) otelsdktrace.Sampler {
+	return otelsdktrace.ParentBased(namespaceSampler{samplingRate: samplingRate})
+}
+
+// IsSamplingDeferred returns true if the sampling decision for span was deferred by the sampler returned
+// by NewNamespaceSampler.
+func IsSamplingDeferred(span trace.Span) bool {
+	return span.IsRecording() && !span.SpanContext().IsSampled()
+}
+
+func (s namespaceSampler) ShouldSample(p otelsdktrace.SamplingParameters) otelsdktrace.SamplingResult {
+	var namespace string
+	var hasNamespace, isGRPC bool
+	for _, attr := range p.Attributes {
+		switch attr.Key {
+		case NamespaceKey:
+			namespace = attr.Value.AsString()
+			hasNamespace = true
+		case rpcSystemKey:
+			isGRPC = attr.Value.AsString() == "grpc"
+		}
+	}
+	if !hasNamespace && isGRPC && p.Kind == trace.SpanKindServer {
+		return otelsdktrace.SamplingResult{
+			Decision:   otelsdktrace.RecordOnly,
+			Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+		}
+	}
+	return otelsdktrace.TraceIDRatioBased(s.samplingRate(namespace)).ShouldSample(p)
+}
+
+func (namespaceSampler) Description() string {
+	return "NamespaceSampler"
+}
@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package telemetry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/server/common/telemetry"
+)
+
+func TestNamespaceSampler(t *testing.T) {
+	rates := map[string]float64{
+		"sampled":   1,
+		"unsampled": 0,
+	}
+	var requested []string
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSampler(telemetry.NewNamespaceSampler(
+		func(namespace string) float64 {
+			requested = append(requested, namespace)
+			return rates[namespace]
+		},
+	)))
+	tracer := tp.Tracer("test")
+
+	start := func(ctx context.Context, namespace string) trace.Span {
+		var opts []trace.SpanStartOption
+		if namespace != "" {
+			opts = append(opts, trace.WithAttributes(attribute.String(telemetry.NamespaceKey, namespace)))
+		}
+		_, span := tracer.Start(ctx, "span", opts...)
+		span.End()
+		return span
+	}
+
+	sampled := start(context.Background(), "sampled")
+	require.True(t, sampled.SpanContext().IsSampled())
+	require.False(t, start(context.Background(), "unsampled").SpanContext().IsSampled())
+	require.False(t, start(context.Background(), "").SpanContext().IsSampled())
+	require.Equal(t, []string{"sampled", "unsampled", ""}, requested)
+
+	// Spans with a parent follow the parent regardless of their namespace.
+	parent := trace.ContextWithSpanContext(context.Background(), sampled.SpanContext())
+	require.True(t, start(parent, "unsampled").SpanContext().IsSampled())
+	require.Len(t, requested, 3)
+}
+
+func TestNamespaceSampler_DefersInboundGRPC(t *testing.T) {
+	var requested []string
+	tp := otelsdktrace.NewTracerProvider(otelsdktrace.WithSampler(telemetry.NewNamespaceSampler(
+		func(namespace string) float64 {
+			requested = append(requested, namespace)
+			return 1
+		},
+	)))
+	tracer := tp.Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc")))
+	require.True(t, telemetry.IsSamplingDeferred(span))
+	span.End()
+	require.Empty(t, requested)
+
+	// once the namespace is known, the rate of the namespace applies
+	_, span = tracer.Start(context.Background(), "temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String(telemetry.NamespaceKey, "ns")))
+	require.False(t, telemetry.IsSamplingDeferred(span))
+	require.True(t, span.SpanContext().IsSampled())
+	span.End()
+	require.Equal(t, []string{"ns"}, requested)
+}
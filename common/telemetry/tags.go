@@ -25,15 +25,19 @@
 package telemetry
 
 const (
-	ComponentPersistence     = "persistence"
-	ComponentQueueArchival   = "queue.archival"
-	ComponentQueueMemory     = "queue.memory"
-	ComponentQueueOutbound   = "queue.outbound"
-	ComponentQueueTimer      = "queue.timer"
-	ComponentQueueTransfer   = "queue.transfer"
-	ComponentQueueVisibility = "queue.visibility"
-	ComponentUpdateRegistry  = "update.registry"
+	ComponentGRPCServer       = "grpc.server"
+	ComponentMatchingDispatch = "matching.dispatch"
+	ComponentPersistence      = "persistence"
+	ComponentQueueArchival    = "queue.archival"
+	ComponentQueueMemory      = "queue.memory"
+	ComponentQueueOutbound    = "queue.outbound"
+	ComponentQueueTimer       = "queue.timer"
+	ComponentQueueTransfer    = "queue.transfer"
+	ComponentQueueVisibility  = "queue.visibility"
+	ComponentReplicationApply = "replication.apply"
+	ComponentUpdateRegistry   = "update.registry"
 
+	NamespaceKey     = "temporalNamespace"
 	WorkflowIDKey    = "temporalWorkflowID"
 	WorkflowRunIDKey = "temporalRunID"
 )
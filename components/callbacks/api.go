@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CallbackKindHeader may be set on a public Nexus callback to select how the callback is delivered. Setting it to
+	// CallbackKindWebhook delivers a JSON description of the completed workflow to the callback URL instead of a Nexus
+	// completion.
+	CallbackKindHeader = "Temporal-Callback-Kind"
+	// CallbackKindWebhook is the CallbackKindHeader value for webhook callbacks.
+	CallbackKindWebhook = "webhook"
+	// CallbackRetryPolicyHeader may be set on a public Nexus callback to a JSON encoded
+	// [go.temporal.io/api/common/v1.RetryPolicy] that overrides the retry policy from dynamic config.
+	CallbackRetryPolicyHeader = "Temporal-Callback-Retry-Policy"
+)
+
+// CallbackFromAPI converts a callback attached through the public API into its persisted representation.
+//
+// The public API has no dedicated variant for webhook callbacks or per callback retry policies, these are set on the
+// Nexus variant with the CallbackKindHeader, WebhookKeyIDHeader and CallbackRetryPolicyHeader headers, which are
+// stripped from the header that is sent to the callback URL.
+func CallbackFromAPI(cb *commonpb.Callback) (*persistencespb.Callback, error) {
+	switch variant := cb.GetVariant().(type) {
+	case *commonpb.Callback_Nexus_:
+		var kind, keyID, retryPolicy string
+		header := make(map[string]string, len(variant.Nexus.GetHeader()))
+		for k, v := range variant.Nexus.GetHeader() {
+			switch {
+			case strings.EqualFold(k, CallbackKindHeader):
+				kind = v
+			case strings.EqualFold(k, WebhookKeyIDHeader):
+				keyID = v
+			case strings.EqualFold(k, CallbackRetryPolicyHeader):
+				retryPolicy = v
+			default:
+				header[k] = v
+			}
+		}
+
+		persistenceCB := &persistencespb.Callback{}
+		if retryPolicy != "" {
+			persistenceCB.RetryPolicy = &commonpb.RetryPolicy{}
+			if err := protojson.Unmarshal([]byte(retryPolicy), persistenceCB.RetryPolicy); err != nil {
+				return nil, fmt.Errorf("invalid %s header: %w", CallbackRetryPolicyHeader, err)
+			}
+		}
+		switch kind {
+		case "":
+			if keyID != "" {
+				return nil, fmt.Errorf("%s header is only supported for webhook callbacks", WebhookKeyIDHeader)
+			}
+			persistenceCB.Variant = &persistencespb.Callback_Nexus_{
+				Nexus: &persistencespb.Callback_Nexus{
+					Url:    variant.Nexus.GetUrl(),
+					Header: header,
+				},
+			}
+		case CallbackKindWebhook:
+			persistenceCB.Variant = &persistencespb.Callback_Webhook_{
+				Webhook: &persistencespb.Callback_Webhook{
+					Url:          variant.Nexus.GetUrl(),
+					Header:       header,
+					SigningKeyId: keyID,
+				},
+			}
+		default:
+			return nil, fmt.Errorf("invalid %s header: unknown callback kind %q", CallbackKindHeader, kind)
+		}
+		return persistenceCB, nil
+	case *commonpb.Callback_Internal_:
+		persistenceCB := &persistencespb.Callback{}
+		if err := proto.Unmarshal(variant.Internal.GetData(), persistenceCB); err != nil {
+			return nil, err
+		}
+		return persistenceCB, nil
+	default:
+		return nil, fmt.Errorf("unknown callback variant: %T", variant)
+	}
+}
+
+// CallbackToAPI converts a persisted callback into its public API representation, the inverse of CallbackFromAPI.
+// Callbacks without a public representation are returned as opaque internal callbacks.
+func CallbackToAPI(cb *persistencespb.Callback) (*commonpb.Callback, error) {
+	var url string
+	var header map[string]string
+	switch variant := cb.GetVariant().(type) {
+	case *persistencespb.Callback_Nexus_:
+		url = variant.Nexus.GetUrl()
+		header = maps.Clone(variant.Nexus.GetHeader())
+	case *persistencespb.Callback_Webhook_:
+		url = variant.Webhook.GetUrl()
+		header = maps.Clone(variant.Webhook.GetHeader())
+		if header == nil {
+			header = make(map[string]string)
+		}
+		header[CallbackKindHeader] = CallbackKindWebhook
+		if keyID := variant.Webhook.GetSigningKeyId(); keyID != "" {
+			header[WebhookKeyIDHeader] = keyID
+		}
+	default:
+		data, err := proto.Marshal(cb)
+		if err != nil {
+			return nil, err
+		}
+		return &commonpb.Callback{
+			Variant: &commonpb.Callback_Internal_{
+				Internal: &commonpb.Callback_Internal{
+					Data: data,
+				},
+			},
+		}, nil
+	}
+
+	if cb.GetRetryPolicy() != nil {
+		retryPolicy, err := protojson.Marshal(cb.GetRetryPolicy())
+		if err != nil {
+			return nil, err
+		}
+		if header == nil {
+			header = make(map[string]string)
+		}
+		header[CallbackRetryPolicyHeader] = string(retryPolicy)
+	}
+	return &commonpb.Callback{
+		Variant: &commonpb.Callback_Nexus_{
+			Nexus: &commonpb.Callback_Nexus{
+				Url:    url,
+				Header: header,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/components/callbacks"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestCallbackFromAPI_Webhook(t *testing.T) {
+	cb, err := callbacks.CallbackFromAPI(&commonpb.Callback{
+		Variant: &commonpb.Callback_Nexus_{
+			Nexus: &commonpb.Callback_Nexus{
+				Url: "https://example.com/hook",
+				Header: map[string]string{
+					"temporal-callback-kind":         callbacks.CallbackKindWebhook,
+					"temporal-webhook-key-id":        "key-1",
+					"temporal-callback-retry-policy": `{"initialInterval":"2s","maximumAttempts":3}`,
+					"x-custom":                       "value",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&persistencespb.Callback{
+		Variant: &persistencespb.Callback_Webhook_{
+			Webhook: &persistencespb.Callback_Webhook{
+				Url:          "https://example.com/hook",
+				Header:       map[string]string{"x-custom": "value"},
+				SigningKeyId: "key-1",
+			},
+		},
+		RetryPolicy: &commonpb.RetryPolicy{
+			InitialInterval: durationpb.New(2 * time.Second),
+			MaximumAttempts: 3,
+		},
+	}, cb))
+
+	// Converting back to the public API round trips.
+	apiCB, err := callbacks.CallbackToAPI(cb)
+	require.NoError(t, err)
+	roundTripped, err := callbacks.CallbackFromAPI(apiCB)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(cb, roundTripped))
+}
+
+func TestCallbackFromAPI_Nexus(t *testing.T) {
+	apiCB := &commonpb.Callback{
+		Variant: &commonpb.Callback_Nexus_{
+			Nexus: &commonpb.Callback_Nexus{
+				Url:    "https://example.com/nexus",
+				Header: map[string]string{"x-custom": "value"},
+			},
+		},
+	}
+	cb, err := callbacks.CallbackFromAPI(apiCB)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/nexus", cb.GetNexus().GetUrl())
+	require.Equal(t, map[string]string{"x-custom": "value"}, cb.GetNexus().GetHeader())
+	require.Nil(t, cb.GetRetryPolicy())
+
+	roundTripped, err := callbacks.CallbackToAPI(cb)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(apiCB, roundTripped))
+}
+
+func TestCallbackFromAPI_Invalid(t *testing.T) {
+	for name, header := range map[string]map[string]string{
+		"unknown-kind":         {callbacks.CallbackKindHeader: "carrier-pigeon"},
+		"nexus-signing-key":    {callbacks.WebhookKeyIDHeader: "key-1"},
+		"invalid-retry-policy": {callbacks.CallbackRetryPolicyHeader: "not json"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := callbacks.CallbackFromAPI(&commonpb.Callback{
+				Variant: &commonpb.Callback_Nexus_{
+					Nexus: &commonpb.Callback_Nexus{
+						Url:    "https://example.com",
+						Header: header,
+					},
+				},
+			})
+			require.Error(t, err)
+		})
+	}
+}
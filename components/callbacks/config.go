@@ -49,14 +49,24 @@ var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var WebhookSigningKeysDir = dynamicconfig.NewGlobalStringSetting(
+	"component.callbacks.webhook.signingKeysDir",
+	"",
+	`The directory containing the secrets used to sign webhook callback requests with HMAC-SHA256, typically a mounted
+secret volume. The secret for a namespace's key is read from <dir>/<namespace>/<key ID>. Webhook callbacks reference a
+key by its ID. Keys may be rotated by adding a new ID and updating callers to use it.`,
+)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout        dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy           func() backoff.RetryPolicy
+	WebhookSigningKeysDir dynamicconfig.StringPropertyFn
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		RequestTimeout: RequestTimeout.Get(dc),
+		RequestTimeout:        RequestTimeout.Get(dc),
+		WebhookSigningKeysDir: WebhookSigningKeysDir.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
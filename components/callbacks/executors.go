@@ -24,15 +24,19 @@ package callbacks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/queues"
 	"go.uber.org/fx"
@@ -69,6 +73,7 @@ type TaskExecutorOptions struct {
 	HTTPCallerProvider HTTPCallerProvider
 	HTTPTraceProvider  commonnexus.HTTPClientTraceProvider
 	HistoryClient      resource.HistoryClient
+	SigningKeyProvider WebhookSigningKeyProvider
 }
 
 type taskExecutor struct {
@@ -190,6 +195,20 @@ func (e taskExecutor) loadInvocationArgs(
 				return err
 			}
 			invokable = hsmInvokable
+		case *persistencespb.Callback_Webhook_:
+			target, err := hsm.MachineData[CanGetHSMCompletionCallbackArg](node.Parent)
+			if err != nil {
+				return err
+			}
+			// variant struct is immutable and ok to reference without copying
+			webhookInvokable := webhookInvocation{}
+			webhookInvokable.webhook = variant.Webhook
+			webhookInvokable.attempt = callback.Attempt
+			webhookInvokable.callbackArg, err = target.GetHSMCompletionCallbackArg(ctx)
+			if err != nil {
+				return err
+			}
+			invokable = webhookInvokable
 		default:
 			return queues.NewUnprocessableTaskError(
 				fmt.Sprintf("unprocessable callback variant: %v", variant),
@@ -206,7 +225,8 @@ func (e taskExecutor) saveResult(
 	ref hsm.Ref,
 	result invocationResult,
 ) error {
-	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+	var exhaustedErr error
+	err := env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		return hsm.MachineTransition(node, func(callback Callback) (hsm.TransitionOutput, error) {
 			switch result.(type) {
 			case invocationResultOK:
@@ -214,10 +234,19 @@ func (e taskExecutor) saveResult(
 					Time: env.Now(),
 				})
 			case invocationResultRetry:
+				retryPolicy := e.retryPolicy(callback)
+				// A replayed callback gets a single attempt.
+				if callback.retriesExhausted() || retryPolicy.ComputeNextDelay(0, int(callback.Attempt)+1, result.error()) < 0 {
+					exhaustedErr = retriesExhaustedError{err: result.error()}
+					return TransitionRetriesExhausted.Apply(callback, EventRetriesExhausted{
+						Time: env.Now(),
+						Err:  result.error(),
+					})
+				}
 				return TransitionAttemptFailed.Apply(callback, EventAttemptFailed{
 					Time:        env.Now(),
 					Err:         result.error(),
-					RetryPolicy: e.Config.RetryPolicy(),
+					RetryPolicy: retryPolicy,
 				})
 			case invocationResultFail:
 				return TransitionFailed.Apply(callback, EventFailed{
@@ -229,6 +258,53 @@ func (e taskExecutor) saveResult(
 			}
 		})
 	})
+	if err != nil {
+		return err
+	}
+	// The callback is failed, but the task is still sent to the DLQ, where it can be inspected and replayed.
+	return exhaustedErr
+}
+
+// retryPolicy returns the callback's own retry policy, falling back to the policy from dynamic config.
+func (e taskExecutor) retryPolicy(callback Callback) backoff.RetryPolicy {
+	if callback.GetCallback().GetRetryPolicy() == nil {
+		return e.Config.RetryPolicy()
+	}
+	policy := common.CloneProto(callback.GetCallback().GetRetryPolicy())
+	retrypolicy.EnsureDefaults(policy, retrypolicy.DefaultDefaultRetrySettings)
+	return backoff.NewExponentialRetryPolicy(
+		policy.GetInitialInterval().AsDuration(),
+	).WithBackoffCoefficient(
+		policy.GetBackoffCoefficient(),
+	).WithMaximumInterval(
+		policy.GetMaximumInterval().AsDuration(),
+	).WithMaximumAttempts(
+		int(policy.GetMaximumAttempts()),
+	).WithExpirationInterval(
+		backoff.NoInterval,
+	)
+}
+
+// retriesExhaustedError is returned when a callback has exhausted its retry policy. It is a terminal task error, which
+// sends the invocation task to the DLQ, where it can be inspected and replayed by an operator.
+type retriesExhaustedError struct {
+	err error
+}
+
+func (e retriesExhaustedError) Error() string {
+	return fmt.Sprintf("callback exhausted its retry policy: %v", e.err)
+}
+
+func (e retriesExhaustedError) Unwrap() error {
+	return e.err
+}
+
+func (retriesExhaustedError) IsTerminalTaskError() bool {
+	return true
+}
+
+func isRetriesExhaustedError(err error) bool {
+	return errors.As(err, new(retriesExhaustedError))
 }
 
 func (e taskExecutor) executeBackoffTask(
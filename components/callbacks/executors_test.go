@@ -24,14 +24,19 @@ package callbacks_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -45,6 +50,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
 	"go.temporal.io/server/service/history/queues"
@@ -55,6 +61,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type fakeEnv struct {
@@ -88,7 +95,9 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 	cases := []struct {
 		name                  string
 		caller                callbacks.HTTPCaller
+		retryPolicy           *commonpb.RetryPolicy
 		destinationDown       bool
+		retriesExhausted      bool
 		expectedMetricOutcome string
 		assertOutcome         func(*testing.T, callbacks.Callback)
 	}{
@@ -125,6 +134,22 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				require.Equal(t, enumsspb.CALLBACK_STATE_BACKING_OFF, cb.State())
 			},
 		},
+		{
+			name: "retries-exhausted",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 500, Body: http.NoBody}, nil
+			},
+			retryPolicy: &commonpb.RetryPolicy{
+				MaximumAttempts: 1,
+			},
+			retriesExhausted:      true,
+			expectedMetricOutcome: "status:500",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				// The callback is failed, and its task is sent to the DLQ from where it can be replayed.
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+				require.NotNil(t, cb.LastAttemptFailure)
+			},
+		},
 		{
 			name: "non-retryable-error",
 			caller: func(r *http.Request) (*http.Response, error) {
@@ -175,6 +200,7 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 								Url: "http://localhost",
 							},
 						},
+						RetryPolicy: tc.retryPolicy,
 					},
 					State: enumsspb.CALLBACK_STATE_SCHEDULED,
 				},
@@ -221,10 +247,18 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				callbacks.NewInvocationTask("http://localhost"),
 			)
 
-			if tc.destinationDown {
+			switch {
+			case tc.retriesExhausted:
+				// A terminal error, rather than a destination down error, sends the task to the DLQ.
+				var destinationDownErr *queues.DestinationDownError
+				require.False(t, errors.As(err, &destinationDownErr))
+				var terminalErr queues.MaybeTerminalTaskError
+				require.ErrorAs(t, err, &terminalErr)
+				require.True(t, terminalErr.IsTerminalTaskError())
+			case tc.destinationDown:
 				var destinationDownErr *queues.DestinationDownError
 				require.ErrorAs(t, err, &destinationDownErr)
-			} else {
+			default:
 				require.NoError(t, err)
 			}
 
@@ -389,6 +423,307 @@ func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTaskWebhook_Outcomes(t *testing.T) {
+	cases := []struct {
+		name                  string
+		statusCode            int
+		retryPolicy           *commonpb.RetryPolicy
+		expectedMetricOutcome string
+		assertError           func(*testing.T, error)
+		assertOutcome         func(*testing.T, callbacks.Callback)
+	}{
+		{
+			name:                  "success",
+			statusCode:            200,
+			expectedMetricOutcome: "status:200",
+			assertError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
+			},
+		},
+		{
+			name:       "retryable-error",
+			statusCode: 500,
+			retryPolicy: &commonpb.RetryPolicy{
+				InitialInterval: durationpb.New(time.Hour),
+			},
+			expectedMetricOutcome: "status:500",
+			assertError: func(t *testing.T, err error) {
+				var destinationDownErr *queues.DestinationDownError
+				require.ErrorAs(t, err, &destinationDownErr)
+			},
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_BACKING_OFF, cb.State())
+				// The callback's own retry policy takes precedence over the one from dynamic config.
+				require.Greater(t, cb.NextAttemptScheduleTime.AsTime().Sub(cb.LastAttemptCompleteTime.AsTime()), time.Minute)
+			},
+		},
+		{
+			name:       "retries-exhausted",
+			statusCode: 500,
+			retryPolicy: &commonpb.RetryPolicy{
+				MaximumAttempts: 1,
+			},
+			expectedMetricOutcome: "status:500",
+			assertError: func(t *testing.T, err error) {
+				var terminalErr queues.MaybeTerminalTaskError
+				require.ErrorAs(t, err, &terminalErr)
+				require.True(t, terminalErr.IsTerminalTaskError())
+			},
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				// The callback is failed, and its task is sent to the DLQ from where it can be replayed.
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+				require.NotNil(t, cb.LastAttemptFailure)
+			},
+		},
+		{
+			name:                  "non-retryable-error",
+			statusCode:            400,
+			expectedMetricOutcome: "status:400",
+			assertError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+			namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+				namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+					Info: &persistencespb.NamespaceInfo{
+						Id:   "namespace-id",
+						Name: "namespace-name",
+					},
+					Config: &persistencespb.NamespaceConfig{},
+				}),
+				nil,
+			)
+			metricsHandler := metrics.NewMockHandler(ctrl)
+			counter := metrics.NewMockCounterIface(ctrl)
+			timer := metrics.NewMockTimerIface(ctrl)
+			metricsHandler.EXPECT().Counter(callbacks.RequestCounter.Name()).Return(counter)
+			counter.EXPECT().Record(int64(1),
+				metrics.NamespaceTag("namespace-name"),
+				metrics.DestinationTag("http://localhost"),
+				metrics.OutcomeTag(tc.expectedMetricOutcome))
+			metricsHandler.EXPECT().Timer(callbacks.RequestLatencyHistogram.Name()).Return(timer)
+			timer.EXPECT().Record(gomock.Any(),
+				metrics.NamespaceTag("namespace-name"),
+				metrics.DestinationTag("http://localhost"),
+				metrics.OutcomeTag(tc.expectedMetricOutcome))
+
+			root := newRoot(t)
+			cb := callbacks.Callback{
+				CallbackInfo: &persistencespb.CallbackInfo{
+					Callback: &persistencespb.Callback{
+						Variant: &persistencespb.Callback_Webhook_{
+							Webhook: &persistencespb.Callback_Webhook{
+								Url:          "http://localhost/hook",
+								Header:       map[string]string{"x-custom": "value"},
+								SigningKeyId: "key-1",
+							},
+						},
+						RetryPolicy: tc.retryPolicy,
+					},
+					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+				},
+			}
+			coll := callbacks.MachineCollection(root)
+			node, err := coll.Add("ID", cb)
+			require.NoError(t, err)
+			env := fakeEnv{node}
+
+			caller := func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "http://localhost/hook", r.URL.String())
+				require.Equal(t, "value", r.Header.Get("x-custom"))
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.Equal(t, "key-1", r.Header.Get(callbacks.WebhookKeyIDHeader))
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var payload map[string]any
+				require.NoError(t, json.Unmarshal(body, &payload))
+				require.Equal(t, "namespace-name", payload["namespace"])
+				require.Equal(t, "mywid", payload["workflowId"])
+				require.Equal(t, "myrid", payload["runId"])
+
+				signature := r.Header.Get(callbacks.WebhookSignatureHeader)
+				var ts int64
+				_, err = fmt.Sscanf(signature, "t=%d,", &ts)
+				require.NoError(t, err)
+				require.Equal(t, callbacks.SignWebhookPayload([]byte("secret"), time.Unix(ts, 0), body), signature)
+
+				return &http.Response{StatusCode: tc.statusCode, Status: http.StatusText(tc.statusCode), Body: http.NoBody}, nil
+			}
+
+			keysDir := t.TempDir()
+			require.NoError(t, os.Mkdir(filepath.Join(keysDir, "namespace-name"), 0o700))
+			require.NoError(t, os.WriteFile(filepath.Join(keysDir, "namespace-name", "key-1"), []byte("secret\n"), 0o600))
+			signingKeys := callbacks.NewFileWebhookSigningKeyProvider(dynamicconfig.GetStringPropertyFn(keysDir))
+
+			key := definition.NewWorkflowKey("namespace-id", "", "")
+			reg := hsm.NewRegistry()
+			require.NoError(t, callbacks.RegisterExecutor(
+				reg,
+				callbacks.TaskExecutorOptions{
+					NamespaceRegistry: namespaceRegistryMock,
+					MetricsHandler:    metricsHandler,
+					HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+						return caller
+					},
+					Logger: log.NewNoopLogger(),
+					Config: &callbacks.Config{
+						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+						RetryPolicy: func() backoff.RetryPolicy {
+							return backoff.NewExponentialRetryPolicy(5 * time.Second)
+						},
+					},
+					SigningKeyProvider: signingKeys,
+				},
+			))
+
+			err = reg.ExecuteImmediateTask(
+				context.Background(),
+				env,
+				hsm.Ref{
+					WorkflowKey: key,
+					StateMachineRef: &persistencespb.StateMachineRef{
+						Path: []*persistencespb.StateMachineKey{
+							{
+								Type: callbacks.StateMachineType,
+								Id:   "ID",
+							},
+						},
+					},
+				},
+				callbacks.NewInvocationTask("http://localhost"),
+			)
+			tc.assertError(t, err)
+
+			cb, err = coll.Data("ID")
+			require.NoError(t, err)
+			tc.assertOutcome(t, cb)
+		})
+	}
+}
+
+func TestProcessInvocationTaskWebhook_ReplayAfterRetriesExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+	namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+		namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "namespace-id",
+				Name: "namespace-name",
+			},
+			Config: &persistencespb.NamespaceConfig{},
+		}),
+		nil,
+	).AnyTimes()
+
+	root := newRoot(t)
+	cb := callbacks.Callback{
+		CallbackInfo: &persistencespb.CallbackInfo{
+			Callback: &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Webhook_{
+					Webhook: &persistencespb.Callback_Webhook{
+						Url: "http://localhost/hook",
+					},
+				},
+				RetryPolicy: &commonpb.RetryPolicy{
+					MaximumAttempts: 1,
+				},
+			},
+			State: enumsspb.CALLBACK_STATE_SCHEDULED,
+		},
+	}
+	coll := callbacks.MachineCollection(root)
+	node, err := coll.Add("ID", cb)
+	require.NoError(t, err)
+	env := fakeEnv{node}
+
+	statusCode := 500
+	reg := hsm.NewRegistry()
+	require.NoError(t, callbacks.RegisterExecutor(
+		reg,
+		callbacks.TaskExecutorOptions{
+			NamespaceRegistry: namespaceRegistryMock,
+			MetricsHandler:    metrics.NoopMetricsHandler,
+			HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+				return func(r *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: statusCode, Status: http.StatusText(statusCode), Body: http.NoBody}, nil
+				}
+			},
+			Logger: log.NewNoopLogger(),
+			Config: &callbacks.Config{
+				RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+				RetryPolicy: func() backoff.RetryPolicy {
+					return backoff.NewExponentialRetryPolicy(5 * time.Second)
+				},
+			},
+		},
+	))
+	execute := func() error {
+		return reg.ExecuteImmediateTask(
+			context.Background(),
+			env,
+			hsm.Ref{
+				WorkflowKey: definition.NewWorkflowKey("namespace-id", "", ""),
+				StateMachineRef: &persistencespb.StateMachineRef{
+					Path: []*persistencespb.StateMachineKey{
+						{
+							Type: callbacks.StateMachineType,
+							Id:   "ID",
+						},
+					},
+				},
+			},
+			callbacks.NewInvocationTask("http://localhost"),
+		)
+	}
+
+	// The only attempt fails, which fails the callback and sends its task to the DLQ.
+	var terminalErr queues.MaybeTerminalTaskError
+	require.ErrorAs(t, execute(), &terminalErr)
+	require.True(t, terminalErr.IsTerminalTaskError())
+	cb, err = coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+	require.NoError(t, callbacks.InvocationTask{}.Validate(nil, node))
+
+	// A replay that fails again goes straight back to the DLQ.
+	require.ErrorAs(t, execute(), &terminalErr)
+	cb, err = coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+	require.Equal(t, int32(2), cb.Attempt)
+
+	// A successful replay completes the callback.
+	statusCode = 200
+	require.NoError(t, execute())
+	cb, err = coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CALLBACK_STATE_SUCCEEDED, cb.State())
+
+	// Callbacks that failed with a non retryable error aren't replayable.
+	failed, err := coll.Add("ID2", callbacks.Callback{
+		CallbackInfo: &persistencespb.CallbackInfo{
+			Callback: cb.Callback,
+			State:    enumsspb.CALLBACK_STATE_FAILED,
+		},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, callbacks.InvocationTask{}.Validate(nil, failed), consts.ErrStaleReference)
+}
+
 func TestProcessBackoffTask(t *testing.T) {
 	root := newRoot(t)
 	cb := callbacks.Callback{
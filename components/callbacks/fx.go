@@ -42,6 +42,7 @@ var Module = fx.Module(
 	"component.callbacks",
 	fx.Provide(ConfigProvider),
 	fx.Provide(HTTPCallerProviderProvider),
+	fx.Provide(WebhookSigningKeyProviderProvider),
 	fx.Invoke(RegisterTaskSerializers),
 	fx.Invoke(RegisterStateMachine),
 	fx.Invoke(RegisterExecutor),
@@ -72,8 +72,11 @@ func isRetryableRpcResponse(err error) bool {
 	}
 }
 
-func (s hsmInvocation) WrapError(invocationResult, error) error {
-	// No short-circuit
+func (s hsmInvocation) WrapError(_ invocationResult, err error) error {
+	// No short-circuit. Only exhausted retries fail the task, so that it's sent to the DLQ.
+	if isRetriesExhaustedError(err) {
+		return err
+	}
 	return nil
 }
 
@@ -75,7 +75,7 @@ func outcomeTag(callCtx context.Context, response *http.Response, callErr error)
 }
 
 func (n nexusInvocation) WrapError(result invocationResult, err error) error {
-	if failure, ok := result.(invocationResultRetry); ok {
+	if failure, ok := result.(invocationResultRetry); ok && !isRetriesExhaustedError(err) {
 		return queues.NewDestinationDownError(failure.err.Error(), err)
 	}
 	return err
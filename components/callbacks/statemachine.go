@@ -102,6 +102,12 @@ func (c Callback) RegenerateTasks(*hsm.Node) ([]hsm.Task, error) {
 				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
 			}
 			return []hsm.Task{InvocationTask{destination: u.Scheme + "://" + u.Host}}, nil
+		case *persistencespb.Callback_Webhook_:
+			u, err := url.Parse(c.Callback.GetWebhook().Url)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse URL: %v: %w", &c, err)
+			}
+			return []hsm.Task{InvocationTask{destination: u.Scheme + "://" + u.Host}}, nil
 		case *persistencespb.Callback_Hsm:
 			// Destination is empty on the internal queue.
 			return []hsm.Task{InvocationTask{"TODO(bergundy): make this empty"}}, nil
@@ -254,8 +260,9 @@ type EventFailed struct {
 	Err  error
 }
 
+// Callbacks that exhausted their retries may be replayed from the DLQ, which is why FAILED is a valid source state.
 var TransitionFailed = hsm.NewTransition(
-	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED},
+	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED, enumsspb.CALLBACK_STATE_FAILED},
 	enumsspb.CALLBACK_STATE_FAILED,
 	func(cb Callback, event EventFailed) (hsm.TransitionOutput, error) {
 		cb.recordAttempt(event.Time)
@@ -271,13 +278,48 @@ var TransitionFailed = hsm.NewTransition(
 	},
 )
 
+// EventRetriesExhausted is triggered when an attempt is failed with a retryable error after the callback has exhausted
+// its retry policy.
+type EventRetriesExhausted struct {
+	Time time.Time
+	Err  error
+}
+
+// retriesExhaustedFailureType marks the last attempt failure of a callback that exhausted its retry policy.
+const retriesExhaustedFailureType = "CallbackRetriesExhausted"
+
+var TransitionRetriesExhausted = hsm.NewTransition(
+	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED, enumsspb.CALLBACK_STATE_FAILED},
+	enumsspb.CALLBACK_STATE_FAILED,
+	func(cb Callback, event EventRetriesExhausted) (hsm.TransitionOutput, error) {
+		cb.recordAttempt(event.Time)
+		cb.CallbackInfo.LastAttemptFailure = &failurepb.Failure{
+			Message: event.Err.Error(),
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+				ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+					Type:         retriesExhaustedFailureType,
+					NonRetryable: true,
+				},
+			},
+		}
+		return cb.output()
+	},
+)
+
+// retriesExhausted reports whether the callback failed after exhausting its retry policy.
+func (c Callback) retriesExhausted() bool {
+	return c.State() == enumsspb.CALLBACK_STATE_FAILED &&
+		c.GetLastAttemptFailure().GetApplicationFailureInfo().GetType() == retriesExhaustedFailureType
+}
+
 // EventSucceeded is triggered when an attempt succeeds.
 type EventSucceeded struct {
 	Time time.Time
 }
 
+// Callbacks that exhausted their retries may be replayed from the DLQ, which is why FAILED is a valid source state.
 var TransitionSucceeded = hsm.NewTransition(
-	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED},
+	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_SCHEDULED, enumsspb.CALLBACK_STATE_FAILED},
 	enumsspb.CALLBACK_STATE_SUCCEEDED,
 	func(cb Callback, event EventSucceeded) (hsm.TransitionOutput, error) {
 		cb.recordAttempt(event.Time)
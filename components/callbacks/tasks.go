@@ -60,6 +60,10 @@ func (t InvocationTask) Deadline() time.Time {
 }
 
 func (InvocationTask) Validate(ref *persistencespb.StateMachineRef, node *hsm.Node) error {
+	// Tasks of callbacks that exhausted their retries are sent to the DLQ, from where they may be replayed.
+	if cb, err := hsm.MachineData[Callback](node); err == nil && cb.retriesExhausted() {
+		return nil
+	}
 	return hsm.ValidateState[enumsspb.CallbackState, Callback](node, enumsspb.CALLBACK_STATE_SCHEDULED)
 }
 
@@ -0,0 +1,187 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/queues"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// WebhookSignatureHeader carries the HMAC-SHA256 signature of a signed webhook request, in the form
+	// "t=<unix seconds>,v1=<hex signature>". The signature is computed over "<unix seconds>.<request body>".
+	WebhookSignatureHeader = "Temporal-Webhook-Signature"
+	// WebhookKeyIDHeader carries the ID of the key used to sign a webhook request.
+	WebhookKeyIDHeader = "Temporal-Webhook-Key-Id"
+)
+
+// webhookPayload is the JSON body delivered to webhook callbacks.
+type webhookPayload struct {
+	Namespace  string          `json:"namespace"`
+	WorkflowID string          `json:"workflowId"`
+	RunID      string          `json:"runId"`
+	Event      json.RawMessage `json:"event"`
+}
+
+type webhookInvocation struct {
+	webhook     *persistencespb.Callback_Webhook
+	callbackArg *persistencespb.HSMCompletionCallbackArg
+	attempt     int32
+}
+
+// SignWebhookPayload returns the value of the WebhookSignatureHeader for the given body, key and timestamp.
+func SignWebhookPayload(key []byte, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func (w webhookInvocation) WrapError(result invocationResult, err error) error {
+	if failure, ok := result.(invocationResultRetry); ok && !isRetriesExhaustedError(err) {
+		return queues.NewDestinationDownError(failure.err.Error(), err)
+	}
+	return err
+}
+
+func (w webhookInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e taskExecutor, task InvocationTask) invocationResult {
+	if e.HTTPTraceProvider != nil {
+		traceLogger := log.With(e.Logger,
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.Operation("WebhookCallback"),
+			tag.NewStringTag("destination", task.destination),
+			tag.WorkflowID(w.callbackArg.GetWorkflowId()),
+			tag.WorkflowRunID(w.callbackArg.GetRunId()),
+			tag.AttemptStart(time.Now().UTC()),
+			tag.Attempt(w.attempt),
+		)
+		if trace := e.HTTPTraceProvider.NewTrace(w.attempt, traceLogger); trace != nil {
+			ctx = httptrace.WithClientTrace(ctx, trace)
+		}
+	}
+
+	request, err := w.newRequest(ctx, ns, e.SigningKeyProvider, time.Now())
+	if err != nil {
+		return invocationResultFail{queues.NewUnprocessableTaskError(
+			fmt.Sprintf("failed to construct webhook request: %v", err),
+		)}
+	}
+
+	caller := e.HTTPCallerProvider(queues.NamespaceIDAndDestination{
+		NamespaceID: ns.ID().String(),
+		Destination: task.Destination(),
+	})
+	// Make the call and record metrics.
+	startTime := time.Now()
+	response, err := caller(request)
+
+	namespaceTag := metrics.NamespaceTag(ns.Name().String())
+	destTag := metrics.DestinationTag(task.Destination())
+	statusCodeTag := metrics.OutcomeTag(outcomeTag(ctx, response, err))
+	e.MetricsHandler.Counter(RequestCounter.Name()).Record(1, namespaceTag, destTag, statusCodeTag)
+	e.MetricsHandler.Timer(RequestLatencyHistogram.Name()).Record(time.Since(startTime), namespaceTag, destTag, statusCodeTag)
+
+	if err != nil {
+		e.Logger.Error("Webhook callback request failed with error", tag.Error(err))
+		return invocationResultRetry{err}
+	}
+
+	// Body is not read but should be discarded to keep the underlying TCP connection alive.
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return invocationResultOK{}
+	}
+
+	retryable := isRetryableHTTPResponse(response)
+	err = fmt.Errorf("webhook request failed with: %v", response.Status)
+	e.Logger.Error("Webhook callback request failed", tag.Error(err), tag.NewBoolTag("retryable", retryable))
+	if retryable {
+		return invocationResultRetry{err}
+	}
+	return invocationResultFail{err}
+}
+
+func (w webhookInvocation) newRequest(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	signingKeys WebhookSigningKeyProvider,
+	now time.Time,
+) (*http.Request, error) {
+	event, err := protojson.Marshal(w.callbackArg.GetLastEvent())
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(webhookPayload{
+		Namespace:  ns.Name().String(),
+		WorkflowID: w.callbackArg.GetWorkflowId(),
+		RunID:      w.callbackArg.GetRunId(),
+		Event:      event,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.webhook.GetUrl(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range w.webhook.GetHeader() {
+		request.Header.Set(k, v)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if keyID := w.webhook.GetSigningKeyId(); keyID != "" {
+		key, err := signingKeys.SigningKey(ns.Name().String(), keyID)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set(WebhookKeyIDHeader, keyID)
+		request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(key, now, body))
+	}
+
+	return request, nil
+}
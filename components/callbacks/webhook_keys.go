@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+// ErrUnknownWebhookSigningKey is returned when a webhook signing key cannot be found.
+var ErrUnknownWebhookSigningKey = errors.New("unknown webhook signing key")
+
+// WebhookSigningKeyProvider resolves the secrets used to sign webhook callback requests.
+type WebhookSigningKeyProvider interface {
+	// SigningKey returns the secret of the given namespace's key, or an error wrapping ErrUnknownWebhookSigningKey if
+	// the key does not exist.
+	SigningKey(namespace, keyID string) ([]byte, error)
+}
+
+type fileWebhookSigningKeyProvider struct {
+	dir dynamicconfig.StringPropertyFn
+}
+
+// NewFileWebhookSigningKeyProvider returns a WebhookSigningKeyProvider that reads secrets from files laid out as
+// <dir>/<namespace>/<key ID>, as is the case for mounted secret volumes. Files are read on every lookup so that
+// rotated secrets are picked up without a restart.
+func NewFileWebhookSigningKeyProvider(dir dynamicconfig.StringPropertyFn) WebhookSigningKeyProvider {
+	return fileWebhookSigningKeyProvider{dir: dir}
+}
+
+func WebhookSigningKeyProviderProvider(config *Config) WebhookSigningKeyProvider {
+	return NewFileWebhookSigningKeyProvider(config.WebhookSigningKeysDir)
+}
+
+func (p fileWebhookSigningKeyProvider) SigningKey(namespace, keyID string) ([]byte, error) {
+	dir := p.dir()
+	if dir == "" {
+		return nil, fmt.Errorf("%w %q: no signing keys directory is configured", ErrUnknownWebhookSigningKey, keyID)
+	}
+	if !isPathElement(namespace) || !isPathElement(keyID) {
+		return nil, fmt.Errorf("%w %q: invalid key ID", ErrUnknownWebhookSigningKey, keyID)
+	}
+
+	key, err := os.ReadFile(filepath.Join(dir, namespace, keyID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w %q", ErrUnknownWebhookSigningKey, keyID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read webhook signing key %q: %w", keyID, err)
+	}
+	// Secrets written by hand or by tooling commonly end with a newline that isn't part of the secret.
+	key = bytes.TrimRight(key, "\r\n")
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w %q: key is empty", ErrUnknownWebhookSigningKey, keyID)
+	}
+	return key, nil
+}
+
+// isPathElement reports whether name refers to a single entry within a directory.
+func isPathElement(name string) bool {
+	return name != "" && name != "." && name != ".." && filepath.Base(name) == name
+}
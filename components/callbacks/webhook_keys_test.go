@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package callbacks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/components/callbacks"
+)
+
+func TestFileWebhookSigningKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "ns"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ns", "key-1"), []byte("secret\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ns", "empty"), nil, 0o600))
+
+	provider := callbacks.NewFileWebhookSigningKeyProvider(dynamicconfig.GetStringPropertyFn(dir))
+	key, err := provider.SigningKey("ns", "key-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), key)
+
+	for _, keyID := range []string{"missing", "empty", "", "..", "../ns/key-1"} {
+		_, err = provider.SigningKey("ns", keyID)
+		require.ErrorIs(t, err, callbacks.ErrUnknownWebhookSigningKey, keyID)
+	}
+	_, err = provider.SigningKey("other-ns", "key-1")
+	require.ErrorIs(t, err, callbacks.ErrUnknownWebhookSigningKey)
+
+	// Without a configured directory, no keys are known.
+	provider = callbacks.NewFileWebhookSigningKeyProvider(dynamicconfig.GetStringPropertyFn(""))
+	_, err = provider.SigningKey("ns", "key-1")
+	require.ErrorIs(t, err, callbacks.ErrUnknownWebhookSigningKey)
+}
@@ -104,6 +104,11 @@ var defaultDisallowedOperationHeaders = []string{
 	headers.CallerNameHeaderName,
 	headers.CallerTypeHeaderName,
 	headers.CallOriginHeaderName,
+	headers.WorkerHostNameHeaderName,
+	headers.WorkerAvailableSlotsHeaderName,
+	headers.WorkerUsedSlotsHeaderName,
+	headers.ReadPartitionsHeaderName,
+	headers.WritePartitionsHeaderName,
 }
 
 var DisallowedOperationHeaders = dynamicconfig.NewGlobalTypedSetting(
@@ -62,14 +62,15 @@ type ClientProvider func(ctx context.Context, namespaceID string, entry *persist
 type TaskExecutorOptions struct {
 	fx.In
 
-	Config                 *Config
-	NamespaceRegistry      namespace.Registry
-	MetricsHandler         metrics.Handler
-	Logger                 log.Logger
-	CallbackTokenGenerator *commonnexus.CallbackTokenGenerator
-	ClientProvider         ClientProvider
-	EndpointRegistry       commonnexus.EndpointRegistry
-	HTTPTraceProvider      commonnexus.HTTPClientTraceProvider
+	Config                  *Config
+	NamespaceRegistry       namespace.Registry
+	MetricsHandler          metrics.Handler
+	Logger                  log.Logger
+	CallbackTokenGenerator  *commonnexus.CallbackTokenGenerator
+	ClientProvider          ClientProvider
+	EndpointRegistry        commonnexus.EndpointRegistry
+	HTTPTraceProvider       commonnexus.HTTPClientTraceProvider
+	EndpointCircuitBreakers *commonnexus.EndpointCircuitBreakers
 }
 
 func RegisterExecutor(
@@ -227,15 +228,19 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 	if callTimeout < e.Config.MinOperationTimeout(ns.Name().String()) {
 		callErr = ErrOperationTimeoutBelowMin
 	} else {
-		rawResult, callErr = client.StartOperation(callCtx, args.operation, args.payload, nexus.StartOperationOptions{
-			Header:      header,
-			CallbackURL: callbackURL,
-			RequestID:   args.requestID,
-			CallbackHeader: nexus.Header{
-				commonnexus.CallbackTokenHeader: token,
-			},
-			Links: []nexus.Link{args.nexusLink},
-		})
+		var done func(success bool)
+		if done, callErr = e.EndpointCircuitBreakers.Allow(endpoint); callErr == nil {
+			rawResult, callErr = client.StartOperation(callCtx, args.operation, args.payload, nexus.StartOperationOptions{
+				Header:      header,
+				CallbackURL: callbackURL,
+				RequestID:   args.requestID,
+				CallbackHeader: nexus.Header{
+					commonnexus.CallbackTokenHeader: token,
+				},
+				Links: []nexus.Link{args.nexusLink},
+			})
+			done(callErr == nil || !isDestinationDown(callErr))
+		}
 	}
 
 	methodTag := metrics.NexusMethodTag("StartOperation")
@@ -277,6 +282,10 @@ func (e taskExecutor) executeInvocationTask(ctx context.Context, env hsm.Environ
 		}
 	}
 
+	if errors.Is(callErr, commonnexus.ErrEndpointCircuitBreakerOpen) {
+		return newEndpointCircuitBreakerOpenError(callErr)
+	}
+
 	if callErr != nil {
 		e.Logger.Error("Nexus StartOperation request failed", tag.Error(callErr))
 	}
@@ -597,7 +606,11 @@ func (e taskExecutor) executeCancelationTask(ctx context.Context, env hsm.Enviro
 	if callTimeout < e.Config.MinOperationTimeout(ns.Name().String()) {
 		callErr = ErrOperationTimeoutBelowMin
 	} else {
-		callErr = handle.Cancel(callCtx, nexus.CancelOperationOptions{})
+		var done func(success bool)
+		if done, callErr = e.EndpointCircuitBreakers.Allow(endpoint); callErr == nil {
+			callErr = handle.Cancel(callCtx, nexus.CancelOperationOptions{})
+			done(callErr == nil || !isDestinationDown(callErr))
+		}
 	}
 
 	methodTag := metrics.NexusMethodTag("CancelOperation")
@@ -608,6 +621,10 @@ func (e taskExecutor) executeCancelationTask(ctx context.Context, env hsm.Enviro
 	OutboundRequestCounter.With(e.MetricsHandler).Record(1, namespaceTag, destTag, methodTag, statusCodeTag, failureSourceTag)
 	OutboundRequestLatency.With(e.MetricsHandler).Record(time.Since(startTime), namespaceTag, destTag, methodTag, statusCodeTag, failureSourceTag)
 
+	if errors.Is(callErr, commonnexus.ErrEndpointCircuitBreakerOpen) {
+		return newEndpointCircuitBreakerOpenError(callErr)
+	}
+
 	if callErr != nil {
 		e.Logger.Error("Nexus CancelOperation request failed", tag.Error(callErr))
 	}
@@ -758,6 +775,9 @@ func startCallOutcomeTag(callCtx context.Context, result *nexus.ClientStartOpera
 		if errors.Is(callErr, ErrOperationTimeoutBelowMin) {
 			return "operation-timeout"
 		}
+		if errors.Is(callErr, commonnexus.ErrEndpointCircuitBreakerOpen) {
+			return "circuit-breaker-open"
+		}
 		if callCtx.Err() != nil {
 			return "request-timeout"
 		}
@@ -780,6 +800,9 @@ func cancelCallOutcomeTag(callCtx context.Context, callErr error) string {
 		if errors.Is(callErr, ErrOperationTimeoutBelowMin) {
 			return "operation-timeout"
 		}
+		if errors.Is(callErr, commonnexus.ErrEndpointCircuitBreakerOpen) {
+			return "circuit-breaker-open"
+		}
 		if callCtx.Err() != nil {
 			return "request-timeout"
 		}
@@ -791,6 +814,21 @@ func cancelCallOutcomeTag(callCtx context.Context, callErr error) string {
 	return "successful"
 }
 
+// newEndpointCircuitBreakerOpenError wraps a rejection by the endpoint's circuit breaker in a resource exhausted error.
+// The request was never sent, so no attempt is recorded in the state machine. The task is retried less aggressively by
+// the queue, isn't sent to the DLQ and, since this isn't a DestinationDownError, isn't counted as a failure by the
+// outbound queue's circuit breaker.
+func newEndpointCircuitBreakerOpenError(err error) error {
+	return fmt.Errorf(
+		"%w: %w",
+		serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_CIRCUIT_BREAKER_OPEN,
+			"nexus endpoint circuit breaker rejection",
+		),
+		err,
+	)
+}
+
 func isDestinationDown(err error) bool {
 	var handlerError *nexus.HandlerError
 	var opFailedErr *nexus.OperationError
@@ -99,6 +99,7 @@ func TestProcessInvocationTask(t *testing.T) {
 		requestTimeout             time.Duration
 		schedToCloseTimeout        time.Duration
 		destinationDown            bool
+		circuitBreakerOpen         bool
 	}{
 		{
 			name:            "async start",
@@ -305,6 +306,22 @@ func TestProcessInvocationTask(t *testing.T) {
 				require.Equal(t, 0, len(events))
 			},
 		},
+		{
+			name:               "circuit breaker open",
+			requestTimeout:     time.Hour,
+			circuitBreakerOpen: true,
+			onStartOperation: func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error) {
+				return nil, errors.New("unexpected request while the circuit breaker is open")
+			},
+			expectedMetricOutcome: "circuit-breaker-open",
+			checkOutcome: func(t *testing.T, op nexusoperations.Operation, events []*historypb.HistoryEvent) {
+				// The request was never sent, no attempt is recorded.
+				require.Equal(t, enumsspb.NEXUS_OPERATION_STATE_SCHEDULED, op.State())
+				require.Equal(t, int32(0), op.Attempt)
+				require.Nil(t, op.LastAttemptFailure)
+				require.Equal(t, 0, len(events))
+			},
+		},
 		{
 			name:                  "invocation timeout by request timeout",
 			requestTimeout:        2 * time.Millisecond,
@@ -511,6 +528,15 @@ func TestProcessInvocationTask(t *testing.T) {
 					return endpointEntry, nil
 				},
 			}
+			circuitBreakers := newEndpointCircuitBreakers()
+			if tc.circuitBreakerOpen {
+				// The default breaker settings trip after more than 5 consecutive failures.
+				for range 6 {
+					done, err := circuitBreakers.Allow(endpointEntry)
+					require.NoError(t, err)
+					done(false)
+				}
+			}
 			require.NoError(t, nexusoperations.RegisterExecutor(reg, nexusoperations.TaskExecutorOptions{
 				Config: &nexusoperations.Config{
 					Enabled:                 dynamicconfig.GetBoolPropertyFn(true),
@@ -523,11 +549,12 @@ func TestProcessInvocationTask(t *testing.T) {
 						return backoff.NewExponentialRetryPolicy(time.Second)
 					},
 				},
-				CallbackTokenGenerator: commonnexus.NewCallbackTokenGenerator(),
-				NamespaceRegistry:      namespaceRegistry,
-				MetricsHandler:         metricsHandler,
-				Logger:                 log.NewNoopLogger(),
-				EndpointRegistry:       endpointReg,
+				CallbackTokenGenerator:  commonnexus.NewCallbackTokenGenerator(),
+				NamespaceRegistry:       namespaceRegistry,
+				MetricsHandler:          metricsHandler,
+				Logger:                  log.NewNoopLogger(),
+				EndpointRegistry:        endpointReg,
+				EndpointCircuitBreakers: circuitBreakers,
 				ClientProvider: func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
 					return nexus.NewHTTPClient(nexus.HTTPClientOptions{
 						BaseURL:    "http://" + listenAddr,
@@ -549,6 +576,10 @@ func TestProcessInvocationTask(t *testing.T) {
 			if tc.destinationDown {
 				var destinationDownErr *queues.DestinationDownError
 				require.ErrorAs(t, err, &destinationDownErr)
+			} else if tc.circuitBreakerOpen {
+				var resourceExhaustedErr *serviceerror.ResourceExhausted
+				require.ErrorAs(t, err, &resourceExhaustedErr)
+				require.ErrorIs(t, err, commonnexus.ErrEndpointCircuitBreakerOpen)
 			} else {
 				require.NoError(t, err)
 			}
@@ -815,10 +846,11 @@ func TestProcessCancelationTask(t *testing.T) {
 						return backoff.NewExponentialRetryPolicy(time.Second)
 					},
 				},
-				NamespaceRegistry: namespaceRegistry,
-				MetricsHandler:    metricsHandler,
-				Logger:            log.NewNoopLogger(),
-				EndpointRegistry:  endpointReg,
+				NamespaceRegistry:       namespaceRegistry,
+				MetricsHandler:          metricsHandler,
+				Logger:                  log.NewNoopLogger(),
+				EndpointRegistry:        endpointReg,
+				EndpointCircuitBreakers: newEndpointCircuitBreakers(),
 				ClientProvider: func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
 					return nexus.NewHTTPClient(nexus.HTTPClientOptions{
 						BaseURL:    "http://" + listenAddr,
@@ -41,16 +41,21 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
 )
 
 var Module = fx.Module(
 	"component.nexusoperations",
 	fx.Provide(ConfigProvider),
+	fx.Provide(ExternalHTTPClientProviderFactory),
 	fx.Provide(ClientProviderFactory),
 	fx.Provide(DefaultNexusTransportProvider),
+	fx.Provide(DefaultSecretProvider),
 	fx.Provide(CallbackTokenGeneratorProvider),
 	fx.Provide(EndpointRegistryProvider),
 	fx.Invoke(EndpointRegistryLifetimeHooks),
+	fx.Provide(EndpointCircuitBreakersProvider),
+	fx.Invoke(EndpointCircuitBreakersLifetimeHooks),
 	fx.Invoke(RegisterStateMachines),
 	fx.Invoke(RegisterTaskSerializers),
 	fx.Invoke(RegisterEventDefinitions),
@@ -80,6 +85,33 @@ func EndpointRegistryLifetimeHooks(lc fx.Lifecycle, registry commonnexus.Endpoin
 	lc.Append(fx.StartStopHook(registry.StartLifecycle, registry.StopLifecycle))
 }
 
+func EndpointCircuitBreakersProvider(
+	dc *dynamicconfig.Collection,
+	endpointRegistry commonnexus.EndpointRegistry,
+	externalHTTPClientProvider ExternalHTTPClientProvider,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *commonnexus.EndpointCircuitBreakers {
+	return commonnexus.NewEndpointCircuitBreakers(
+		commonnexus.NewEndpointCircuitBreakerConfig(dc),
+		endpointRegistry,
+		func(entry *persistencespb.NexusEndpointEntry) (func(*http.Request) (*http.Response, error), error) {
+			// Probes aren't sent on behalf of any namespace.
+			client, err := externalHTTPClientProvider("", entry)
+			if err != nil {
+				return nil, err
+			}
+			return client.Do, nil
+		},
+		metricsHandler,
+		logger,
+	)
+}
+
+func EndpointCircuitBreakersLifetimeHooks(lc fx.Lifecycle, breakers *commonnexus.EndpointCircuitBreakers) {
+	lc.Append(fx.StartStopHook(breakers.StartLifecycle, breakers.StopLifecycle))
+}
+
 // NexusTransportProvider type alias allows a provider to customize the default implementation specifically for Nexus.
 type NexusTransportProvider func(namespaceID, serviceName string) http.RoundTripper
 
@@ -91,17 +123,62 @@ func DefaultNexusTransportProvider() NexusTransportProvider {
 	}
 }
 
+// DefaultSecretProvider provides the secret provider used to resolve auth headers of external endpoint targets.
+// Secrets are read from environment variables by default, use fx.Decorate to plug in a different secret store.
+func DefaultSecretProvider() commonnexus.SecretProvider {
+	return commonnexus.EnvSecretProvider{}
+}
+
 type clientProviderCacheKey struct {
 	namespaceID, endpointID string
-	// URL is part of the cache key in case the service configuration is modified to use a new URL after caching the
-	// client for the service.
-	url string
+	// The serialized target is part of the cache key in case the endpoint is modified to use a new URL or new
+	// outbound TLS or auth settings after caching the client for the endpoint.
+	target string
+}
+
+// ExternalHTTPClientProvider returns the HTTP client used to call an external endpoint target, configured with the
+// endpoint's outbound TLS and auth settings.
+type ExternalHTTPClientProvider func(namespaceID string, entry *persistencespb.NexusEndpointEntry) (*http.Client, error)
+
+func ExternalHTTPClientProviderFactory(
+	httpTransportProvider NexusTransportProvider,
+	secretProvider commonnexus.SecretProvider,
+) ExternalHTTPClientProvider {
+	// TODO(bergundy): This should use an LRU or other form of cache that supports eviction.
+	m := collection.NewFallibleOnceMap(func(key clientProviderCacheKey) (*http.Client, error) {
+		target := &persistencespb.NexusEndpointTarget_External{}
+		if err := proto.Unmarshal([]byte(key.target), target); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("cannot deserialize target of endpoint %q: %v", key.endpointID, err))
+		}
+		transport, err := commonnexus.NewExternalTargetTransport(
+			httpTransportProvider(key.namespaceID, key.endpointID),
+			target,
+			secretProvider,
+		)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("cannot configure transport for endpoint %q: %v", key.endpointID, err))
+		}
+		return &http.Client{
+			Transport: ResponseSizeLimiter{transport},
+		}, nil
+	})
+	return func(namespaceID string, entry *persistencespb.NexusEndpointEntry) (*http.Client, error) {
+		target, err := proto.MarshalOptions{Deterministic: true}.Marshal(entry.GetEndpoint().GetSpec().GetTarget().GetExternal())
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("cannot serialize target of endpoint %q: %v", entry.Id, err))
+		}
+		return m.Get(clientProviderCacheKey{
+			namespaceID: namespaceID,
+			endpointID:  entry.Id,
+			target:      string(target),
+		})
+	}
 }
 
 func ClientProviderFactory(
 	namespaceRegistry namespace.Registry,
 	endpointRegistry commonnexus.EndpointRegistry,
-	httpTransportProvider NexusTransportProvider,
+	externalHTTPClientProvider ExternalHTTPClientProvider,
 	clusterMetadata cluster.Metadata,
 	rpcFactory common.RPCFactory,
 ) (ClientProvider, error) {
@@ -110,13 +187,6 @@ func ClientProviderFactory(
 		return nil, fmt.Errorf("cannot create local frontend HTTP client: %w", err)
 	}
 
-	// TODO(bergundy): This should use an LRU or other form of cache that supports eviction.
-	m := collection.NewFallibleOnceMap(func(key clientProviderCacheKey) (*http.Client, error) {
-		transport := httpTransportProvider(key.namespaceID, key.endpointID)
-		return &http.Client{
-			Transport: ResponseSizeLimiter{transport},
-		}, nil
-	})
 	return func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
 		var url string
 		var httpClient *http.Client
@@ -124,7 +194,7 @@ func ClientProviderFactory(
 		case *persistencespb.NexusEndpointTarget_External_:
 			url = variant.External.GetUrl()
 			var err error
-			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url})
+			httpClient, err = externalHTTPClientProvider(namespaceID, entry)
 			if err != nil {
 				return nil, err
 			}
@@ -35,6 +35,10 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/sdk/converter"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
@@ -57,6 +61,16 @@ func (fakeEnv) Now() time.Time {
 
 var _ hsm.Environment = fakeEnv{}
 
+func newEndpointCircuitBreakers() *commonnexus.EndpointCircuitBreakers {
+	return commonnexus.NewEndpointCircuitBreakers(
+		commonnexus.NewEndpointCircuitBreakerConfig(dynamicconfig.NewNoopCollection()),
+		nil,
+		nil,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
 func newRegistry(t *testing.T) *hsm.Registry {
 	t.Helper()
 	reg := hsm.NewRegistry()
@@ -38,6 +38,7 @@ type (
 		MaxBufferSize                     int           // MaxBufferSize limits the number of buffered actions pending execution in total
 		CanceledTerminatedCountAsFailures bool          // Whether cancelled+terminated count for pause-on-failure
 		RecentActionCount                 int           // How many recent actions are recorded in SchedulerInfo.
+		FutureActionCount                 int           // The number of future action times to include in Describe.
 
 		// TODO - incomplete tweakables list
 
@@ -81,6 +82,7 @@ var (
 		MaxBufferSize:                     1000,
 		CanceledTerminatedCountAsFailures: false,
 		RecentActionCount:                 10,
+		FutureActionCount:                 10,
 	}
 )
 
@@ -0,0 +1,414 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/hsm"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// UpstreamCompletedMethod is the remote method invoked by the completion
+	// callbacks attached to an upstream schedule's workflows. Its input is the
+	// completed workflow's HSMCompletionCallbackArg.
+	UpstreamCompletedMethod struct{}
+
+	// SetUpstreamMethod declares (or, given an empty schedule ID, clears) the
+	// upstream schedule of a downstream Scheduler. Its input is a
+	// ScheduleUpstream; any recent run IDs in the input are ignored.
+	SetUpstreamMethod struct{}
+
+	// AddDependentMethod adds a callback created with NewDependentCallback to an
+	// upstream Scheduler, to be attached to each workflow it starts.
+	AddDependentMethod struct{}
+
+	// RemoveDependentMethod removes a callback previously added with
+	// AddDependentMethod from an upstream Scheduler.
+	RemoveDependentMethod struct{}
+
+	DependencyExecutorOptions struct {
+		fx.In
+
+		Config         *Config
+		MetricsHandler metrics.Handler
+		BaseLogger     log.Logger
+	}
+
+	dependencyExecutor struct {
+		DependencyExecutorOptions
+	}
+)
+
+const (
+	UpstreamCompletedMethodName = "scheduler.UpstreamCompleted"
+	SetUpstreamMethodName       = "scheduler.SetUpstream"
+	AddDependentMethodName      = "scheduler.AddDependent"
+	RemoveDependentMethodName   = "scheduler.RemoveDependent"
+
+	// maxRecentUpstreamRunIDs bounds how many handled upstream run IDs are kept
+	// for deduplicating retried completion callbacks.
+	maxRecentUpstreamRunIDs = 100
+)
+
+var (
+	_ hsm.RemoteMethod = UpstreamCompletedMethod{}
+	_ hsm.RemoteMethod = SetUpstreamMethod{}
+	_ hsm.RemoteMethod = AddDependentMethod{}
+	_ hsm.RemoteMethod = RemoveDependentMethod{}
+)
+
+func (UpstreamCompletedMethod) Name() string {
+	return UpstreamCompletedMethodName
+}
+
+func (UpstreamCompletedMethod) SerializeOutput(_ any) ([]byte, error) {
+	// The method has no output.
+	return nil, nil
+}
+
+func (UpstreamCompletedMethod) DeserializeInput(data []byte) (any, error) {
+	arg := &persistencespb.HSMCompletionCallbackArg{}
+	return arg, proto.Unmarshal(data, arg)
+}
+
+func (SetUpstreamMethod) Name() string {
+	return SetUpstreamMethodName
+}
+
+func (SetUpstreamMethod) SerializeOutput(_ any) ([]byte, error) {
+	return nil, nil
+}
+
+func (SetUpstreamMethod) DeserializeInput(data []byte) (any, error) {
+	upstream := &schedulespb.ScheduleUpstream{}
+	return upstream, proto.Unmarshal(data, upstream)
+}
+
+func (AddDependentMethod) Name() string {
+	return AddDependentMethodName
+}
+
+func (AddDependentMethod) SerializeOutput(_ any) ([]byte, error) {
+	return nil, nil
+}
+
+func (AddDependentMethod) DeserializeInput(data []byte) (any, error) {
+	cb := &persistencespb.Callback{}
+	return cb, proto.Unmarshal(data, cb)
+}
+
+func (RemoveDependentMethod) Name() string {
+	return RemoveDependentMethodName
+}
+
+func (RemoveDependentMethod) SerializeOutput(_ any) ([]byte, error) {
+	return nil, nil
+}
+
+func (RemoveDependentMethod) DeserializeInput(data []byte) (any, error) {
+	cb := &persistencespb.Callback{}
+	return cb, proto.Unmarshal(data, cb)
+}
+
+// RegisterDependencyExecutors registers the remote methods used to declare and
+// drive schedule dependencies. The methods are invoked through history's
+// InvokeStateMachineMethod API.
+func RegisterDependencyExecutors(registry *hsm.Registry, options DependencyExecutorOptions) error {
+	e := dependencyExecutor{
+		DependencyExecutorOptions: options,
+	}
+	if err := hsm.RegisterRemoteMethod(registry, UpstreamCompletedMethod{}, e.executeUpstreamCompleted); err != nil {
+		return err
+	}
+	if err := hsm.RegisterRemoteMethod(registry, SetUpstreamMethod{}, e.executeSetUpstream); err != nil {
+		return err
+	}
+	if err := hsm.RegisterRemoteMethod(registry, AddDependentMethod{}, e.executeAddDependent); err != nil {
+		return err
+	}
+	return hsm.RegisterRemoteMethod(registry, RemoveDependentMethod{}, e.executeRemoveDependent)
+}
+
+// NewDependentCallback returns a completion callback that notifies the
+// downstream Scheduler referenced by ref. The callback should be added to the
+// upstream Scheduler's DependentCallbacks.
+func NewDependentCallback(
+	namespaceID, workflowID, runID string,
+	ref *persistencespb.StateMachineRef,
+) *persistencespb.Callback {
+	return &persistencespb.Callback{
+		Variant: &persistencespb.Callback_Hsm{
+			Hsm: &persistencespb.Callback_HSM{
+				NamespaceId: namespaceID,
+				WorkflowId:  workflowID,
+				RunId:       runID,
+				Ref:         ref,
+				Method:      UpstreamCompletedMethodName,
+			},
+		},
+	}
+}
+
+// CompletionCallbacks returns the Scheduler's dependent callbacks, encoded to
+// be attached to the workflows started by the Scheduler.
+func (s Scheduler) CompletionCallbacks() ([]*commonpb.Callback, error) {
+	callbacks := make([]*commonpb.Callback, 0, len(s.DependentCallbacks))
+	for _, cb := range s.DependentCallbacks {
+		data, err := proto.Marshal(cb)
+		if err != nil {
+			return nil, err
+		}
+		callbacks = append(callbacks, &commonpb.Callback{
+			Variant: &commonpb.Callback_Internal_{
+				Internal: &commonpb.Callback_Internal{
+					Data: data,
+				},
+			},
+		})
+	}
+	return callbacks, nil
+}
+
+// executeSetUpstream declares the upstream schedule whose completed actions
+// trigger actions on the Scheduler.
+func (e dependencyExecutor) executeSetUpstream(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+	input any,
+) (any, error) {
+	upstream, ok := input.(*schedulespb.ScheduleUpstream)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid upstream input: %v", input))
+	}
+
+	return nil, env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		scheduler, err := loadScheduler(node)
+		if err != nil {
+			return err
+		}
+		if upstream.GetScheduleId() == scheduler.ScheduleId {
+			return serviceerror.NewInvalidArgument("a schedule can't be its own upstream")
+		}
+
+		return hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+			if upstream.GetScheduleId() == "" {
+				s.Upstream = nil
+				s.updateConflictToken()
+				return hsm.TransitionOutput{}, nil
+			}
+
+			// Handled run IDs only remain meaningful for the same upstream schedule.
+			var recentRunIDs []string
+			if s.Upstream.GetScheduleId() == upstream.GetScheduleId() {
+				recentRunIDs = s.Upstream.GetRecentRunIds()
+			}
+			s.Upstream = &schedulespb.ScheduleUpstream{
+				ScheduleId:    upstream.GetScheduleId(),
+				SkipOnFailure: upstream.GetSkipOnFailure(),
+				RecentRunIds:  recentRunIDs,
+			}
+			s.updateConflictToken()
+			return hsm.TransitionOutput{}, nil
+		})
+	})
+}
+
+// executeAddDependent adds a downstream Scheduler's completion callback to the
+// Scheduler. Adding an already present callback is a no-op.
+func (e dependencyExecutor) executeAddDependent(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+	input any,
+) (any, error) {
+	cb, ok := input.(*persistencespb.Callback)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid dependent callback input: %v", input))
+	}
+	hsmCallback := cb.GetHsm()
+	if hsmCallback == nil || hsmCallback.GetMethod() != UpstreamCompletedMethodName {
+		return nil, serviceerror.NewInvalidArgument("dependent callback must invoke " + UpstreamCompletedMethodName)
+	}
+
+	return nil, env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		scheduler, err := loadScheduler(node)
+		if err != nil {
+			return err
+		}
+		if hsmCallback.GetNamespaceId() != scheduler.NamespaceId {
+			return serviceerror.NewInvalidArgument("dependent schedule must be in the same namespace")
+		}
+		if slices.ContainsFunc(scheduler.DependentCallbacks, func(existing *persistencespb.Callback) bool {
+			return proto.Equal(existing, cb)
+		}) {
+			return nil
+		}
+
+		return hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+			s.DependentCallbacks = append(s.DependentCallbacks, cb)
+			s.updateConflictToken()
+			return hsm.TransitionOutput{}, nil
+		})
+	})
+}
+
+// executeRemoveDependent removes a downstream Scheduler's completion callback
+// from the Scheduler. Removing a missing callback is a no-op.
+func (e dependencyExecutor) executeRemoveDependent(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+	input any,
+) (any, error) {
+	cb, ok := input.(*persistencespb.Callback)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid dependent callback input: %v", input))
+	}
+
+	return nil, env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		scheduler, err := loadScheduler(node)
+		if err != nil {
+			return err
+		}
+		isCallback := func(existing *persistencespb.Callback) bool {
+			return proto.Equal(existing, cb)
+		}
+		if !slices.ContainsFunc(scheduler.DependentCallbacks, isCallback) {
+			return nil
+		}
+
+		return hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+			s.DependentCallbacks = slices.DeleteFunc(s.DependentCallbacks, isCallback)
+			s.updateConflictToken()
+			return hsm.TransitionOutput{}, nil
+		})
+	})
+}
+
+// executeUpstreamCompleted buffers an action on the downstream Scheduler in
+// response to one of its upstream schedule's workflows completing.
+func (e dependencyExecutor) executeUpstreamCompleted(
+	ctx context.Context,
+	env hsm.Environment,
+	ref hsm.Ref,
+	input any,
+) (any, error) {
+	arg, ok := input.(*persistencespb.HSMCompletionCallbackArg)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid upstream completion input: %v", input))
+	}
+
+	return nil, env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		scheduler, err := loadScheduler(node)
+		if err != nil {
+			return err
+		}
+		logger := newTaggedLogger(e.BaseLogger, scheduler)
+
+		if scheduler.Upstream == nil {
+			return serviceerror.NewFailedPrecondition("Scheduler has no upstream schedule")
+		}
+
+		// Completion callbacks are delivered at least once, so a retried callback for
+		// an already-handled run must not take another action.
+		runID := arg.GetRunId()
+		if slices.Contains(scheduler.Upstream.RecentRunIds, runID) {
+			logger.Debug("Ignoring duplicate upstream completion", tag.WorkflowRunID(runID))
+			return nil
+		}
+		err = hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+			s.Upstream.RecentRunIds = append(s.Upstream.RecentRunIds, runID)
+			if extra := len(s.Upstream.RecentRunIds) - maxRecentUpstreamRunIDs; extra > 0 {
+				s.Upstream.RecentRunIds = s.Upstream.RecentRunIds[extra:]
+			}
+			return hsm.TransitionOutput{}, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		succeeded := arg.GetLastEvent().GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+		if !succeeded && scheduler.Upstream.SkipOnFailure {
+			logger.Debug("Skipping action after upstream failure",
+				tag.NewStringTag("upstream-schedule-id", scheduler.Upstream.ScheduleId),
+				tag.WorkflowID(arg.GetWorkflowId()),
+				tag.WorkflowRunID(runID))
+			e.MetricsHandler.Counter(metrics.ScheduleUpstreamFailureSkipped.Name()).Record(1)
+			return nil
+		}
+
+		// Upstream-triggered actions count as scheduled actions, so they're subject
+		// to pausing and action limits.
+		allowed := false
+		err = hsm.MachineTransition(node, func(s Scheduler) (hsm.TransitionOutput, error) {
+			allowed = s.useScheduledAction(true)
+			return hsm.TransitionOutput{}, nil
+		})
+		if err != nil || !allowed {
+			return err
+		}
+
+		// The upstream workflow's close time is used for both nominal and actual
+		// time, so that the request ID is stable should the callback be retried.
+		closeTime := arg.GetLastEvent().GetEventTime().AsTime()
+		start := &schedulespb.BufferedStart{
+			NominalTime:   timestamppb.New(closeTime),
+			ActualTime:    timestamppb.New(closeTime),
+			OverlapPolicy: scheduler.overlapPolicy(),
+			Manual:        false,
+			RequestId:     generateRequestID(scheduler, "upstream-"+runID, closeTime, closeTime),
+		}
+
+		executorNode, err := node.Child([]hsm.Key{ExecutorMachineKey})
+		if err != nil {
+			return fmt.Errorf(
+				"%w: %w",
+				serviceerror.NewInternal("Scheduler is missing its Executor node"),
+				err,
+			)
+		}
+		return hsm.MachineTransition(executorNode, func(e Executor) (hsm.TransitionOutput, error) {
+			return TransitionExecute.Apply(e, EventExecute{
+				Node:           executorNode,
+				BufferedStarts: []*schedulespb.BufferedStart{start},
+			})
+		})
+	})
+}
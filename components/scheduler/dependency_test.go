@@ -0,0 +1,248 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/components/scheduler"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func registerDependencyExecutor(t *testing.T, registry *hsm.Registry) {
+	require.NoError(t, scheduler.RegisterDependencyExecutors(registry, scheduler.DependencyExecutorOptions{
+		Config:         defaultConfig(),
+		MetricsHandler: metrics.NoopMetricsHandler,
+		BaseLogger:     log.NewTestLogger(),
+	}))
+}
+
+func upstreamCompletion(t *testing.T, eventType enumspb.EventType, closeTime time.Time) []byte {
+	data, err := proto.Marshal(&persistencespb.HSMCompletionCallbackArg{
+		NamespaceId: namespaceID,
+		WorkflowId:  "upstream-wf",
+		RunId:       "upstream-run",
+		LastEvent: &historypb.HistoryEvent{
+			EventType: eventType,
+			EventTime: timestamppb.New(closeTime),
+		},
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func newDependentSchedulerTree(t *testing.T, skipOnFailure bool) (*hsm.Registry, *fakeEnv, *hsm.Node) {
+	env := newFakeEnv()
+	registry := newRegistry(t)
+	registerDependencyExecutor(t, registry)
+	root := newRoot(t, registry, &hsmtest.NodeBackend{})
+	schedulerNode := newSchedulerTree(t, registry, root, defaultSchedule(), nil)
+	env.node = schedulerNode
+
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	s.Upstream = &schedulespb.ScheduleUpstream{
+		ScheduleId:    "upstream-sched-id",
+		SkipOnFailure: skipOnFailure,
+	}
+
+	return registry, env, schedulerNode
+}
+
+func bufferedStarts(t *testing.T, schedulerNode *hsm.Node) []*schedulespb.BufferedStart {
+	executorNode, err := schedulerNode.Child([]hsm.Key{scheduler.ExecutorMachineKey})
+	require.NoError(t, err)
+	executor, err := hsm.MachineData[scheduler.Executor](executorNode)
+	require.NoError(t, err)
+	return executor.BufferedStarts
+}
+
+func TestUpstreamCompleted_Succeeded(t *testing.T) {
+	registry, env, schedulerNode := newDependentSchedulerTree(t, true)
+	closeTime := env.Now().UTC()
+
+	_, err := registry.ExecuteRemoteMethod(
+		context.Background(),
+		env,
+		hsm.Ref{},
+		scheduler.UpstreamCompletedMethodName,
+		upstreamCompletion(t, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, closeTime),
+	)
+	require.NoError(t, err)
+
+	// A successful upstream action should buffer a single start.
+	starts := bufferedStarts(t, schedulerNode)
+	require.Len(t, starts, 1)
+	require.Equal(t, closeTime, starts[0].NominalTime.AsTime())
+	require.False(t, starts[0].Manual)
+	require.Contains(t, starts[0].RequestId, "upstream-run")
+}
+
+func TestUpstreamCompleted_Duplicate(t *testing.T) {
+	registry, env, schedulerNode := newDependentSchedulerTree(t, false)
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	s.Schedule.State.LimitedActions = true
+	s.Schedule.State.RemainingActions = 5
+	completion := upstreamCompletion(t, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, env.Now())
+
+	// A retried callback for the same upstream run takes only a single action.
+	for range 2 {
+		_, err = registry.ExecuteRemoteMethod(context.Background(), env, hsm.Ref{}, scheduler.UpstreamCompletedMethodName, completion)
+		require.NoError(t, err)
+	}
+	require.Len(t, bufferedStarts(t, schedulerNode), 1)
+	require.Equal(t, int64(4), s.Schedule.State.RemainingActions)
+	require.Equal(t, []string{"upstream-run"}, s.Upstream.RecentRunIds)
+}
+
+func TestUpstreamCompleted_Failed(t *testing.T) {
+	failure := upstreamCompletion(t, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED, time.Now())
+
+	// When skipping on failure, nothing should be buffered.
+	registry, env, schedulerNode := newDependentSchedulerTree(t, true)
+	_, err := registry.ExecuteRemoteMethod(context.Background(), env, hsm.Ref{}, scheduler.UpstreamCompletedMethodName, failure)
+	require.NoError(t, err)
+	require.Empty(t, bufferedStarts(t, schedulerNode))
+
+	// Otherwise, failed upstream actions trigger an action all the same.
+	registry, env, schedulerNode = newDependentSchedulerTree(t, false)
+	_, err = registry.ExecuteRemoteMethod(context.Background(), env, hsm.Ref{}, scheduler.UpstreamCompletedMethodName, failure)
+	require.NoError(t, err)
+	require.Len(t, bufferedStarts(t, schedulerNode), 1)
+}
+
+func TestUpstreamCompleted_Paused(t *testing.T) {
+	registry, env, schedulerNode := newDependentSchedulerTree(t, false)
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	s.Schedule.State.Paused = true
+
+	// Paused schedules don't take upstream-triggered actions.
+	_, err = registry.ExecuteRemoteMethod(
+		context.Background(),
+		env,
+		hsm.Ref{},
+		scheduler.UpstreamCompletedMethodName,
+		upstreamCompletion(t, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, time.Now()),
+	)
+	require.NoError(t, err)
+	require.Empty(t, bufferedStarts(t, schedulerNode))
+}
+
+func TestCompletionCallbacks(t *testing.T) {
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	cb := scheduler.NewDependentCallback(namespaceID, "downstream-wf", "downstream-run", &persistencespb.StateMachineRef{})
+	s.DependentCallbacks = []*persistencespb.Callback{cb}
+
+	callbacks, err := s.CompletionCallbacks()
+	require.NoError(t, err)
+	require.Len(t, callbacks, 1)
+
+	decoded := &persistencespb.Callback{}
+	require.NoError(t, proto.Unmarshal(callbacks[0].GetInternal().GetData(), decoded))
+	require.Equal(t, scheduler.UpstreamCompletedMethodName, decoded.GetHsm().GetMethod())
+	require.Equal(t, "downstream-wf", decoded.GetHsm().GetWorkflowId())
+}
+
+func invokeDependencyMethod(t *testing.T, registry *hsm.Registry, env *fakeEnv, method string, input proto.Message) error {
+	data, err := proto.Marshal(input)
+	require.NoError(t, err)
+	_, err = registry.ExecuteRemoteMethod(context.Background(), env, hsm.Ref{}, method, data)
+	return err
+}
+
+func TestSetUpstream(t *testing.T) {
+	registry, env, schedulerNode := newDependentSchedulerTree(t, false)
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	s.Upstream.RecentRunIds = []string{"upstream-run"}
+	token := s.ConflictToken
+
+	// Updating the same upstream keeps its handled run IDs.
+	require.NoError(t, invokeDependencyMethod(t, registry, env, scheduler.SetUpstreamMethodName, &schedulespb.ScheduleUpstream{
+		ScheduleId:    "upstream-sched-id",
+		SkipOnFailure: true,
+		RecentRunIds:  []string{"ignored"},
+	}))
+	require.True(t, s.Upstream.SkipOnFailure)
+	require.Equal(t, []string{"upstream-run"}, s.Upstream.RecentRunIds)
+	require.Greater(t, s.ConflictToken, token)
+
+	// A different upstream starts from scratch.
+	require.NoError(t, invokeDependencyMethod(t, registry, env, scheduler.SetUpstreamMethodName, &schedulespb.ScheduleUpstream{
+		ScheduleId: "other-sched-id",
+	}))
+	require.Equal(t, "other-sched-id", s.Upstream.ScheduleId)
+	require.Empty(t, s.Upstream.RecentRunIds)
+
+	// A schedule can't depend on itself.
+	err = invokeDependencyMethod(t, registry, env, scheduler.SetUpstreamMethodName, &schedulespb.ScheduleUpstream{
+		ScheduleId: scheduleID,
+	})
+	require.Error(t, err)
+
+	// An empty schedule ID clears the upstream.
+	require.NoError(t, invokeDependencyMethod(t, registry, env, scheduler.SetUpstreamMethodName, &schedulespb.ScheduleUpstream{}))
+	require.Nil(t, s.Upstream)
+}
+
+func TestAddRemoveDependent(t *testing.T) {
+	registry, env, schedulerNode := newDependentSchedulerTree(t, false)
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	cb := scheduler.NewDependentCallback(namespaceID, "downstream-wf", "downstream-run", &persistencespb.StateMachineRef{})
+
+	// Adding the same callback twice only registers it once.
+	for range 2 {
+		require.NoError(t, invokeDependencyMethod(t, registry, env, scheduler.AddDependentMethodName, cb))
+	}
+	require.Len(t, s.DependentCallbacks, 1)
+
+	// Callbacks must notify a Scheduler in the same namespace.
+	other := scheduler.NewDependentCallback("other-namespace-id", "downstream-wf", "downstream-run", &persistencespb.StateMachineRef{})
+	require.Error(t, invokeDependencyMethod(t, registry, env, scheduler.AddDependentMethodName, other))
+
+	// Callbacks must invoke the upstream completion method.
+	invalid := proto.Clone(cb).(*persistencespb.Callback)
+	invalid.GetHsm().Method = "other.Method"
+	require.Error(t, invokeDependencyMethod(t, registry, env, scheduler.AddDependentMethodName, invalid))
+	require.Len(t, s.DependentCallbacks, 1)
+
+	require.NoError(t, invokeDependencyMethod(t, registry, env, scheduler.RemoveDependentMethodName, cb))
+	require.Empty(t, s.DependentCallbacks)
+}
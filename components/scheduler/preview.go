@@ -0,0 +1,285 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/service/history/hsm"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// BackfillOutcome describes what the Executor would do with a single
+	// backfilled action once its overlap policy is applied.
+	BackfillOutcome int
+
+	// BackfillPreview describes a single action that a backfill request would
+	// buffer, along with the outcome of applying its overlap policy.
+	BackfillPreview struct {
+		NominalTime   time.Time
+		ActualTime    time.Time
+		OverlapPolicy enumspb.ScheduleOverlapPolicy
+		Outcome       BackfillOutcome
+	}
+)
+
+const (
+	// The action would be started as soon as the Executor runs.
+	BackfillOutcomeStart BackfillOutcome = iota
+	// The action would be started alongside other actions (allow-all).
+	BackfillOutcomeStartOverlapping
+	// The action would remain buffered until the running workflow closes.
+	BackfillOutcomeBuffer
+	// The action would remain buffered while the running workflow is cancelled.
+	BackfillOutcomeCancelOther
+	// The action would remain buffered while the running workflow is terminated.
+	BackfillOutcomeTerminateOther
+	// The action would be dropped.
+	BackfillOutcomeSkip
+)
+
+func (o BackfillOutcome) String() string {
+	switch o {
+	case BackfillOutcomeStart:
+		return "Start"
+	case BackfillOutcomeStartOverlapping:
+		return "StartOverlapping"
+	case BackfillOutcomeBuffer:
+		return "Buffer"
+	case BackfillOutcomeCancelOther:
+		return "CancelOther"
+	case BackfillOutcomeTerminateOther:
+		return "TerminateOther"
+	case BackfillOutcomeSkip:
+		return "Skip"
+	default:
+		return "Unknown"
+	}
+}
+
+// Describe returns the Scheduler's schedule and info. Future action times
+// follow the Generator's last processed time, and are capped by the schedule's
+// remaining actions. The buffer size counts starts already buffered by the
+// Executor.
+func Describe(
+	node *hsm.Node,
+	config *Config,
+	specProcessor SpecProcessor,
+) (*schedulespb.DescribeResponse, error) {
+	scheduler, err := loadScheduler(node)
+	if err != nil {
+		return nil, err
+	}
+	generator, err := loadGenerator(node)
+	if err != nil {
+		return nil, err
+	}
+	executor, err := loadExecutor(node)
+	if err != nil {
+		return nil, err
+	}
+
+	count := config.Tweakables(scheduler.Namespace).FutureActionCount
+	if scheduler.Schedule.GetState().GetLimitedActions() {
+		count = min(count, int(scheduler.Schedule.GetState().GetRemainingActions()))
+	}
+	futureActionTimes, err := futureActionTimes(specProcessor, scheduler, generator.LastProcessedTime.AsTime(), count)
+	if err != nil {
+		return nil, err
+	}
+
+	info := common.CloneProto(scheduler.Info)
+	info.FutureActionTimes = futureActionTimes
+	info.BufferSize = int64(len(executor.BufferedStarts))
+
+	return &schedulespb.DescribeResponse{
+		Schedule:      scheduler.Schedule,
+		Info:          info,
+		ConflictToken: scheduler.ConflictToken,
+	}, nil
+}
+
+// PreviewNextTimes returns up to count action times that follow after, for
+// the Scheduler's current spec or, when spec is non-nil, a proposed one.
+func PreviewNextTimes(
+	node *hsm.Node,
+	specProcessor SpecProcessor,
+	spec *schedulepb.ScheduleSpec,
+	after time.Time,
+	count int,
+) ([]scheduler1.GetNextTimeResult, error) {
+	scheduler, err := loadScheduler(node)
+	if err != nil {
+		return nil, err
+	}
+	return specProcessor.NextTimes(scheduler, spec, after, count)
+}
+
+// PreviewBackfill returns up to limit actions that the given backfill request
+// would buffer, without mutating any state. Each action's outcome reflects a
+// single pass of the Executor over its currently buffered starts followed by
+// the backfilled actions, against the Scheduler's currently running workflows.
+// Actions that wouldn't fit in the Executor's buffer are not returned.
+//
+// As with backfills, the request's start time is inclusive, and actions are
+// generated regardless of whether the schedule is paused or out of actions.
+// The end time is applied before outcomes are resolved, so the limit only
+// truncates the returned list.
+func PreviewBackfill(
+	node *hsm.Node,
+	config *Config,
+	specProcessor SpecProcessor,
+	request *schedulepb.BackfillRequest,
+	limit int,
+) ([]BackfillPreview, error) {
+	scheduler, err := loadScheduler(node)
+	if err != nil {
+		return nil, err
+	}
+	executor, err := loadExecutor(node)
+	if err != nil {
+		return nil, err
+	}
+
+	maxStarts := config.Tweakables(scheduler.Namespace).MaxBufferSize - len(executor.BufferedStarts)
+	starts, err := backfillStarts(specProcessor, scheduler, request, maxStarts)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]*schedulespb.BufferedStart, 0, len(executor.BufferedStarts)+len(starts))
+	buffer = append(buffer, executor.BufferedStarts...)
+	buffer = append(buffer, starts...)
+	isRunning := len(scheduler.Info.GetRunningWorkflows()) > 0
+	result := scheduler1.ProcessBuffer(buffer, isRunning, scheduler.resolveOverlapPolicy)
+
+	outcomes := make(map[*schedulespb.BufferedStart]BackfillOutcome, len(buffer))
+	for _, start := range result.OverlappingStarts {
+		outcomes[start] = BackfillOutcomeStartOverlapping
+	}
+	if result.NonOverlappingStart != nil {
+		outcomes[result.NonOverlappingStart] = BackfillOutcomeStart
+	}
+	for _, start := range result.NewBuffer {
+		policy := scheduler.resolveOverlapPolicy(start.OverlapPolicy)
+		switch {
+		case result.NeedCancel && policy == enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+			outcomes[start] = BackfillOutcomeCancelOther
+		case result.NeedTerminate && policy == enumspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER:
+			outcomes[start] = BackfillOutcomeTerminateOther
+		default:
+			outcomes[start] = BackfillOutcomeBuffer
+		}
+	}
+
+	if len(starts) > limit {
+		starts = starts[:limit]
+	}
+	previews := make([]BackfillPreview, 0, len(starts))
+	for _, start := range starts {
+		outcome, ok := outcomes[start]
+		if !ok {
+			outcome = BackfillOutcomeSkip
+		}
+		previews = append(previews, BackfillPreview{
+			NominalTime:   start.NominalTime.AsTime(),
+			ActualTime:    start.ActualTime.AsTime(),
+			OverlapPolicy: scheduler.resolveOverlapPolicy(start.OverlapPolicy),
+			Outcome:       outcome,
+		})
+	}
+
+	return previews, nil
+}
+
+// backfillStarts returns up to maxStarts buffered starts for the backfill
+// request's time range.
+func backfillStarts(
+	specProcessor SpecProcessor,
+	scheduler Scheduler,
+	request *schedulepb.BackfillRequest,
+	maxStarts int,
+) ([]*schedulespb.BufferedStart, error) {
+	if maxStarts <= 0 {
+		return nil, nil
+	}
+
+	start := request.GetStartTime().AsTime().Add(-time.Millisecond)
+	end := request.GetEndTime().AsTime()
+
+	nextTimes, err := specProcessor.NextTimes(scheduler, nil, start, maxStarts)
+	if err != nil {
+		return nil, err
+	}
+
+	var starts []*schedulespb.BufferedStart
+	for _, next := range nextTimes {
+		if next.Next.After(end) {
+			break
+		}
+		starts = append(starts, &schedulespb.BufferedStart{
+			NominalTime:   timestamppb.New(next.Nominal),
+			ActualTime:    timestamppb.New(next.Next),
+			OverlapPolicy: request.GetOverlapPolicy(),
+			Manual:        true,
+		})
+	}
+	return starts, nil
+}
+
+// futureActionTimes returns up to count action times following after,
+// skipping those that precede the schedule's last update.
+func futureActionTimes(
+	specProcessor SpecProcessor,
+	scheduler Scheduler,
+	after time.Time,
+	count int,
+) ([]*timestamppb.Timestamp, error) {
+	updateTime := scheduler.Info.GetUpdateTime().AsTime()
+	out := make([]*timestamppb.Timestamp, 0, max(count, 0))
+	for len(out) < count {
+		nextTimes, err := specProcessor.NextTimes(scheduler, nil, after, count-len(out))
+		if err != nil {
+			return nil, err
+		}
+		if len(nextTimes) == 0 {
+			break
+		}
+		for _, next := range nextTimes {
+			if updateTime.After(next.Next) {
+				continue
+			}
+			out = append(out, timestamppb.New(next.Next))
+		}
+		after = nextTimes[len(nextTimes)-1].Next
+	}
+	return out, nil
+}
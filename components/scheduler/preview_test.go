@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/components/scheduler"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNextTimes(t *testing.T) {
+	processor := setupSpecProcessor(t)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := processor.NextTimes(s, nil, after, 3)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	for i, next := range res {
+		require.Equal(t, after.Add(time.Duration(i+1)*defaultInterval), next.Nominal)
+	}
+}
+
+func TestNextTimes_ProposedSpec(t *testing.T) {
+	processor := setupSpecProcessor(t)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// A proposed spec is previewed in place of the current spec, with its
+	// exclusions applied.
+	proposed := &schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{
+			Interval: durationpb.New(time.Hour),
+		}},
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{
+			Hour:       []*schedulepb.Range{{Start: 2}},
+			Minute:     []*schedulepb.Range{{Start: 0, End: 59}},
+			Second:     []*schedulepb.Range{{Start: 0, End: 59}},
+			DayOfMonth: []*schedulepb.Range{{Start: 1, End: 31}},
+			Month:      []*schedulepb.Range{{Start: 1, End: 12}},
+			DayOfWeek:  []*schedulepb.Range{{Start: 0, End: 6}},
+		}},
+	}
+
+	res, err := processor.NextTimes(s, proposed, after, 3)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.Equal(t, after.Add(1*time.Hour), res[0].Nominal)
+	require.Equal(t, after.Add(3*time.Hour), res[1].Nominal)
+	require.Equal(t, after.Add(4*time.Hour), res[2].Nominal)
+
+	// An invalid proposed spec should return an error.
+	_, err = processor.NextTimes(s, &schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{
+			Interval: durationpb.New(-time.Hour),
+		}},
+	}, after, 3)
+	require.Error(t, err)
+}
+
+func newPreviewTree(t *testing.T) (*hsm.Node, scheduler.Scheduler, scheduler.Executor) {
+	registry := newRegistry(t)
+	root := newRoot(t, registry, &hsmtest.NodeBackend{})
+	schedulerNode := newSchedulerTree(t, registry, root, defaultSchedule(), nil)
+
+	s, err := hsm.MachineData[scheduler.Scheduler](schedulerNode)
+	require.NoError(t, err)
+	executorNode, err := schedulerNode.Child([]hsm.Key{scheduler.ExecutorMachineKey})
+	require.NoError(t, err)
+	e, err := hsm.MachineData[scheduler.Executor](executorNode)
+	require.NoError(t, err)
+
+	return schedulerNode, s, e
+}
+
+func TestDescribe(t *testing.T) {
+	processor := setupSpecProcessor(t)
+	node, s, e := newPreviewTree(t)
+	e.BufferedStarts = append(e.BufferedStarts, &schedulespb.BufferedStart{
+		NominalTime: timestamppb.Now(),
+		ActualTime:  timestamppb.Now(),
+	})
+
+	res, err := scheduler.Describe(node, defaultConfig(), processor)
+	require.NoError(t, err)
+	require.Len(t, res.Info.FutureActionTimes, scheduler.DefaultTweakables.FutureActionCount)
+	require.Equal(t, int64(1), res.Info.BufferSize)
+	require.Equal(t, s.ConflictToken, res.ConflictToken)
+
+	// Future action times are capped by the schedule's remaining actions.
+	s.Schedule.State.LimitedActions = true
+	s.Schedule.State.RemainingActions = 2
+	res, err = scheduler.Describe(node, defaultConfig(), processor)
+	require.NoError(t, err)
+	require.Len(t, res.Info.FutureActionTimes, 2)
+}
+
+func TestPreviewBackfill(t *testing.T) {
+	processor := setupSpecProcessor(t)
+	node, s, e := newPreviewTree(t)
+	config := defaultConfig()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	request := &schedulepb.BackfillRequest{
+		StartTime:     timestamppb.New(start),
+		EndTime:       timestamppb.New(start.Add(defaultInterval * 2)),
+		OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+	}
+
+	// Start time is inclusive. With nothing running, the first action starts, the
+	// second is buffered, and the remainder are skipped.
+	previews, err := scheduler.PreviewBackfill(node, config, processor, request, 10)
+	require.NoError(t, err)
+	require.Len(t, previews, 3)
+	require.Equal(t, start, previews[0].NominalTime)
+	require.Equal(t, scheduler.BackfillOutcomeStart, previews[0].Outcome)
+	require.Equal(t, scheduler.BackfillOutcomeBuffer, previews[1].Outcome)
+	require.Equal(t, scheduler.BackfillOutcomeSkip, previews[2].Outcome)
+
+	// With a workflow already running, the first action is buffered instead.
+	s.Info.RunningWorkflows = []*commonpb.WorkflowExecution{{WorkflowId: "running"}}
+	previews, err = scheduler.PreviewBackfill(node, config, processor, request, 10)
+	require.NoError(t, err)
+	require.Len(t, previews, 3)
+	require.Equal(t, scheduler.BackfillOutcomeBuffer, previews[0].Outcome)
+	require.Equal(t, scheduler.BackfillOutcomeSkip, previews[1].Outcome)
+	require.Equal(t, scheduler.BackfillOutcomeSkip, previews[2].Outcome)
+
+	// Allow-all starts everything, and the limit caps the preview.
+	request.OverlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL
+	previews, err = scheduler.PreviewBackfill(node, config, processor, request, 2)
+	require.NoError(t, err)
+	require.Len(t, previews, 2)
+	for _, preview := range previews {
+		require.Equal(t, scheduler.BackfillOutcomeStartOverlapping, preview.Outcome)
+	}
+
+	// Terminate-other marks the action that waits on the termination.
+	request.OverlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER
+	previews, err = scheduler.PreviewBackfill(node, config, processor, request, 1)
+	require.NoError(t, err)
+	require.Len(t, previews, 1)
+	require.Equal(t, scheduler.BackfillOutcomeTerminateOther, previews[0].Outcome)
+
+	// Outcomes are resolved over the whole time range before the limit is
+	// applied: with nothing running, cancel-other replaces earlier actions with
+	// the last one in range.
+	s.Info.RunningWorkflows = nil
+	request.OverlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER
+	previews, err = scheduler.PreviewBackfill(node, config, processor, request, 1)
+	require.NoError(t, err)
+	require.Len(t, previews, 1)
+	require.Equal(t, scheduler.BackfillOutcomeSkip, previews[0].Outcome)
+
+	// Starts already buffered by the Executor are processed ahead of the
+	// backfill.
+	e.BufferedStarts = append(e.BufferedStarts, &schedulespb.BufferedStart{
+		NominalTime:   timestamppb.New(start),
+		ActualTime:    timestamppb.New(start),
+		OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+	})
+	request.OverlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE
+	previews, err = scheduler.PreviewBackfill(node, config, processor, request, 10)
+	require.NoError(t, err)
+	require.Len(t, previews, 3)
+	require.Equal(t, scheduler.BackfillOutcomeBuffer, previews[0].Outcome)
+	require.Equal(t, scheduler.BackfillOutcomeSkip, previews[1].Outcome)
+	require.Equal(t, scheduler.BackfillOutcomeSkip, previews[2].Outcome)
+}
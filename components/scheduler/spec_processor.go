@@ -27,6 +27,7 @@ package scheduler
 import (
 	"time"
 
+	schedulepb "go.temporal.io/api/schedule/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -54,6 +55,18 @@ type (
 			manual bool,
 			limit *int,
 		) (*ProcessedTimeRange, error)
+
+		// NextTimes returns up to count upcoming action times that follow after. When
+		// spec is nil, the Scheduler's current spec is used; otherwise, spec is
+		// compiled and evaluated in its place, allowing a proposed change to be
+		// previewed. Jitter and exclusion calendars are applied exactly as they are
+		// when buffering actions.
+		NextTimes(
+			sched Scheduler,
+			spec *schedulepb.ScheduleSpec,
+			after time.Time,
+			count int,
+		) ([]scheduler1.GetNextTimeResult, error)
 	}
 
 	SpecProcessorImpl struct {
@@ -153,6 +166,31 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 	}, nil
 }
 
+func (s SpecProcessorImpl) NextTimes(
+	scheduler Scheduler,
+	spec *schedulepb.ScheduleSpec,
+	after time.Time,
+	count int,
+) ([]scheduler1.GetNextTimeResult, error) {
+	var cspec *scheduler1.CompiledSpec
+	var err error
+	if spec == nil {
+		cspec, err = scheduler.getCompiledSpec(s.SpecBuilder)
+	} else {
+		cspec, err = s.SpecBuilder.NewCompiledSpec(spec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var results []scheduler1.GetNextTimeResult
+	for next := cspec.GetNextTime(scheduler.jitterSeed(), after); !next.Next.IsZero() && len(results) < count; next = cspec.GetNextTime(scheduler.jitterSeed(), next.Next) {
+		results = append(results, next)
+	}
+
+	return results, nil
+}
+
 func catchupWindow(s Scheduler, tweakables Tweakables) time.Duration {
 	cw := s.Schedule.Policies.CatchupWindow
 	if cw == nil {
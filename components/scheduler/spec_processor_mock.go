@@ -37,6 +37,8 @@ import (
 	reflect "reflect"
 	time "time"
 
+	schedule "go.temporal.io/api/schedule/v1"
+	scheduler "go.temporal.io/server/service/worker/scheduler"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -64,6 +66,21 @@ func (m *MockSpecProcessor) EXPECT() *MockSpecProcessorMockRecorder {
 	return m.recorder
 }
 
+// NextTimes mocks base method.
+func (m *MockSpecProcessor) NextTimes(sched Scheduler, spec *schedule.ScheduleSpec, after time.Time, count int) ([]scheduler.GetNextTimeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextTimes", sched, spec, after, count)
+	ret0, _ := ret[0].([]scheduler.GetNextTimeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextTimes indicates an expected call of NextTimes.
+func (mr *MockSpecProcessorMockRecorder) NextTimes(sched, spec, after, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextTimes", reflect.TypeOf((*MockSpecProcessor)(nil).NextTimes), sched, spec, after, count)
+}
+
 // ProcessTimeRange mocks base method.
 func (m *MockSpecProcessor) ProcessTimeRange(scheduler Scheduler, start, end time.Time, manual bool, limit *int) (*ProcessedTimeRange, error) {
 	m.ctrl.T.Helper()
@@ -102,3 +102,21 @@ func loadScheduler(node *hsm.Node) (Scheduler, error) {
 		SchedulerInternal: prevScheduler.SchedulerInternal,
 	}, nil
 }
+
+// loadGenerator loads the Scheduler's Generator sub state machine.
+func loadGenerator(node *hsm.Node) (Generator, error) {
+	generatorNode, err := node.Child([]hsm.Key{GeneratorMachineKey})
+	if err != nil {
+		return Generator{}, err
+	}
+	return hsm.MachineData[Generator](generatorNode)
+}
+
+// loadExecutor loads the Scheduler's Executor sub state machine.
+func loadExecutor(node *hsm.Node) (Executor, error) {
+	executorNode, err := node.Child([]hsm.Key{ExecutorMachineKey})
+	if err != nil {
+		return Executor{}, err
+	}
+	return hsm.MachineData[Executor](executorNode)
+}
@@ -66,7 +66,11 @@ func PathsByDir(dbSubDir string) []string {
 
 func PathsByDB(dbName string) []string {
 	if dbName == "sql" {
-		return append(PathsByDir("mysql"), PathsByDir("postgresql")...)
+		var paths []string
+		for _, dir := range []string{"mysql", "postgresql", "cockroachdb", "yugabytedb"} {
+			paths = append(paths, PathsByDir(dir)...)
+		}
+		return paths
 	}
 	return PathsByDir(dbName)
 }
@@ -47,6 +47,18 @@ func TestSchemaDirs(t *testing.T) {
 		"postgresql/v12/temporal",
 		"postgresql/v12/visibility",
 	}, dirs)
+
+	dirs = PathsByDir("cockroachdb")
+	requireContains(t, []string{
+		"cockroachdb/v23/temporal",
+		"cockroachdb/v23/visibility",
+	}, dirs)
+
+	dirs = PathsByDir("yugabytedb")
+	requireContains(t, []string{
+		"yugabytedb/v2/temporal",
+		"yugabytedb/v2/visibility",
+	}, dirs)
 }
 
 func requireContains(t *testing.T, expected []string, actual []string) {
@@ -0,0 +1 @@
+CREATE DATABASE temporal_visibility;
\ No newline at end of file
@@ -31,7 +31,10 @@ import (
 	"io"
 	"maps"
 	"net"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -54,6 +57,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
@@ -73,6 +77,7 @@ import (
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	persistencesql "go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
@@ -95,6 +100,9 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	listUsageRecordsDefaultPageSize         = 100
+	// describeNexusEndpointHostTimeout bounds the time a single history host may take to describe its circuit breaker.
+	describeNexusEndpointHostTimeout = 5 * time.Second
 )
 
 type (
@@ -106,6 +114,7 @@ type (
 
 		logger                     log.Logger
 		numberOfHistoryShards      int32
+		persistenceConfig          *config.Persistence
 		ESClient                   esclient.Client
 		config                     *Config
 		namespaceDLQHandler        nsreplication.DLQMessageHandler
@@ -116,6 +125,8 @@ type (
 		taskManager                persistence.TaskManager
 		clusterMetadataManager     persistence.ClusterMetadataManager
 		persistenceMetadataManager persistence.MetadataManager
+		usageRecordManager         persistence.UsageRecordManager
+		nexusEndpointClient        *NexusEndpointClient
 		clientFactory              serverClient.Factory
 		clientBean                 serverClient.Bean
 		historyClient              historyservice.HistoryServiceClient
@@ -150,6 +161,8 @@ type (
 		PersistenceExecutionManager         persistence.ExecutionManager
 		ClusterMetadataManager              persistence.ClusterMetadataManager
 		PersistenceMetadataManager          persistence.MetadataManager
+		UsageRecordManager                  persistence.UsageRecordManager
+		NexusEndpointClient                 *NexusEndpointClient
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
@@ -207,6 +220,7 @@ func NewAdminHandler(
 		logger:                args.Logger,
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: args.PersistenceConfig.NumHistoryShards,
+		persistenceConfig:     args.PersistenceConfig,
 		config:                args.Config,
 		namespaceDLQHandler: nsreplication.NewDLQMessageHandler(
 			namespaceReplicationTaskExecutor,
@@ -221,6 +235,8 @@ func NewAdminHandler(
 		taskManager:                args.TaskManager,
 		clusterMetadataManager:     args.ClusterMetadataManager,
 		persistenceMetadataManager: args.PersistenceMetadataManager,
+		usageRecordManager:         args.UsageRecordManager,
+		nexusEndpointClient:        args.NexusEndpointClient,
 		clientFactory:              args.ClientFactory,
 		clientBean:                 args.ClientBean,
 		historyClient:              args.HistoryClient,
@@ -1682,6 +1698,198 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// UpdateTaskQueueConfig updates the per-type dispatch rate limits of a task queue
+func (adh *AdminHandler) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConfigRequest,
+) (_ *adminservice.UpdateTaskQueueConfigResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.UpdateTaskQueueConfig(ctx, &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId:         namespaceID.String(),
+		TaskQueue:           request.GetTaskQueue(),
+		TaskQueueType:       request.GetTaskQueueType(),
+		SetTypeRateLimits:   request.GetSetTypeRateLimits(),
+		UnsetTypeRateLimits: request.GetUnsetTypeRateLimits(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateTaskQueueConfigResponse{
+		TypeRateLimits: resp.GetTypeRateLimits(),
+	}, nil
+}
+
+// ListTaskQueueBacklog returns a page of backlog tasks of a task queue partition
+func (adh *AdminHandler) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+) (_ *adminservice.ListTaskQueueBacklogResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueuePartition().GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ListBacklogTasks(ctx, &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		BuildId:            request.GetBuildId(),
+		Subqueue:           request.GetSubqueue(),
+		WorkflowId:         request.GetWorkflowId(),
+		TypeName:           request.GetTypeName(),
+		PageSize:           request.GetPageSize(),
+		NextPageToken:      request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.ListTaskQueueBacklogResponse{
+		Tasks:         resp.GetTasks(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// DeleteTaskQueueBacklogTasks deletes a range of backlog tasks from a task queue partition
+func (adh *AdminHandler) DeleteTaskQueueBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueBacklogTasksRequest,
+) (_ *adminservice.DeleteTaskQueueBacklogTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueuePartition().GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.matchingClient.DeleteBacklogTasks(ctx, &matchingservice.DeleteBacklogTasksRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		BuildId:            request.GetBuildId(),
+		InclusiveMinTaskId: request.GetInclusiveMinTaskId(),
+		ExclusiveMaxTaskId: request.GetExclusiveMaxTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DeleteTaskQueueBacklogTasksResponse{}, nil
+}
+
+// ListTaskQueueWorkers lists the workers that recently polled any partition of the given task queue,
+// together with the metadata they reported.
+func (adh *AdminHandler) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueWorkersRequest,
+) (_ *adminservice.ListTaskQueueWorkersResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ListTaskQueueWorkers(ctx, &matchingservice.ListTaskQueueWorkersRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     request.GetTaskQueue(),
+			TaskQueueType: request.GetTaskQueueType(),
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 0},
+		},
+		FanOut: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.ListTaskQueueWorkersResponse{
+		Workers: resp.GetWorkers(),
+	}, nil
+}
+
+// GetTaskQueueScalingRecommendation returns aggregated backlog and rate stats of a task queue and a
+// recommended worker scaling ratio
+func (adh *AdminHandler) GetTaskQueueScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueScalingRecommendationRequest,
+) (_ *adminservice.GetTaskQueueScalingRecommendationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return getTaskQueueScalingRecommendation(
+		ctx,
+		adh.matchingClient,
+		adh.config,
+		namespaceName,
+		namespaceID,
+		request.GetTaskQueue(),
+		request.GetTaskQueueType(),
+	)
+}
+
 // DescribeTaskQueuePartition returns information for a given task queue partition of the task queue
 func (adh *AdminHandler) DescribeTaskQueuePartition(
 	ctx context.Context,
@@ -1717,6 +1925,7 @@ func (adh *AdminHandler) DescribeTaskQueuePartition(
 
 	return &adminservice.DescribeTaskQueuePartitionResponse{
 		VersionsInfoInternal: resp.VersionsInfoInternal,
+		PartitionScaling:     resp.PartitionScaling,
 	}, nil
 }
 
@@ -1755,6 +1964,172 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// BackupPersistence writes a consistent copy of the default or visibility store to a file on this host. The
+// destination is resolved relative to the backup directory configured for the store, so the file lands on
+// whichever frontend host serves the request.
+func (adh *AdminHandler) BackupPersistence(
+	ctx context.Context,
+	request *adminservice.BackupPersistenceRequest,
+) (_ *adminservice.BackupPersistenceResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetDestinationPath() == "" {
+		return nil, serviceerror.NewInvalidArgument("destination path is not set on request")
+	}
+	if !isRelativeLocalPath(request.GetDestinationPath()) {
+		return nil, serviceerror.NewInvalidArgument("destination path must be relative to the backup directory and must not contain \"..\"")
+	}
+
+	storeName := adh.persistenceConfig.DefaultStore
+	if request.GetVisibility() {
+		storeName = adh.persistenceConfig.VisibilityStore
+	}
+	store, ok := adh.persistenceConfig.DataStores[storeName]
+	if !ok || store.SQL == nil {
+		return nil, serviceerror.NewUnimplemented(fmt.Sprintf("backup is not supported for data store %q", storeName))
+	}
+	if store.SQL.BackupDir == "" {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("backup directory is not configured for data store %q", storeName))
+	}
+	destination := filepath.Join(store.SQL.BackupDir, request.GetDestinationPath())
+	if err := persistencesql.BackupDB(ctx, store.SQL, destination); err != nil {
+		if errors.Is(err, persistencesql.ErrBackupNotSupported) {
+			return nil, serviceerror.NewUnimplemented(err.Error())
+		}
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to back up data store %q: %v", storeName, err))
+	}
+	adh.logger.Info("Backed up data store", tag.NewStringTag("store", storeName), tag.NewStringTag("destination", destination))
+	return &adminservice.BackupPersistenceResponse{
+		StoreName:       storeName,
+		DestinationPath: destination,
+	}, nil
+}
+
+// isRelativeLocalPath reports whether path is relative and has no ".." elements, so that joining it with a
+// directory can't refer to anything outside of that directory.
+func isRelativeLocalPath(path string) bool {
+	if filepath.IsAbs(path) || filepath.VolumeName(path) != "" {
+		return false
+	}
+	return !slices.Contains(strings.Split(filepath.ToSlash(path), "/"), "..")
+}
+
+// ListUsageRecords returns the usage records of a namespace whose metering window overlaps the requested time range.
+// Records are filtered after they're read, so a page may contain fewer records than requested.
+func (adh *AdminHandler) ListUsageRecords(
+	ctx context.Context,
+	request *adminservice.ListUsageRecordsRequest,
+) (_ *adminservice.ListUsageRecordsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = listUsageRecordsDefaultPageSize
+	}
+
+	listRequest := &persistence.ListUsageRecordsRequest{
+		NamespaceID:   namespaceID.String(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	}
+	if request.GetStartTime() != nil {
+		listRequest.StartTime = request.GetStartTime().AsTime()
+	}
+	if request.GetEndTime() != nil {
+		listRequest.EndTime = request.GetEndTime().AsTime()
+	}
+	resp, err := adh.usageRecordManager.ListUsageRecords(ctx, listRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListUsageRecordsResponse{
+		Records:       resp.Records,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// DescribeNexusEndpoint returns the state of the circuit breakers all history hosts maintain for a Nexus endpoint.
+// Hosts are queried concurrently, and the hosts that fail to respond are reported in the response instead of failing
+// the request.
+func (adh *AdminHandler) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+) (_ *adminservice.DescribeNexusEndpointResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetId() == "" {
+		return nil, serviceerror.NewInvalidArgument("endpoint ID is not set on request")
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+
+	// Circuit breakers are local to each history host, collect the state from all of them.
+	members := resolver.Members()
+	response := &adminservice.DescribeNexusEndpointResponse{
+		CircuitBreakers: make(map[string]*commonspb.NexusEndpointCircuitBreakerInfo, len(members)),
+		HostErrors:      make(map[string]string),
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, host := range members {
+		address := host.GetAddress()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hostCtx, cancel := context.WithTimeout(ctx, describeNexusEndpointHostTimeout)
+			defer cancel()
+			resp, err := adh.historyClient.DescribeNexusEndpoint(hostCtx, &historyservice.DescribeNexusEndpointRequest{
+				HostAddress: address,
+				EndpointId:  request.GetId(),
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				response.HostErrors[address] = err.Error()
+				return
+			}
+			response.CircuitBreakers[address] = resp.GetCircuitBreaker()
+		}()
+	}
+	wg.Wait()
+
+	return response, nil
+}
+
+// UpdateNexusEndpointOutboundSettings sets the outbound TLS and auth header settings of a Nexus endpoint with an
+// external target.
+func (adh *AdminHandler) UpdateNexusEndpointOutboundSettings(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundSettingsRequest,
+) (_ *adminservice.UpdateNexusEndpointOutboundSettingsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	return adh.nexusEndpointClient.UpdateOutboundSettings(ctx, request)
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -32,6 +32,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -57,6 +58,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -75,6 +77,7 @@ import (
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
@@ -84,6 +87,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -107,6 +111,8 @@ type (
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
 		mockSaMapper               *searchattribute.MockMapper
+		mockUsageRecordMgr         *persistence.MockUsageRecordManager
+		mockNexusEndpointMgr       *persistence.MockNexusEndpointManager
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -151,6 +157,8 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockUsageRecordMgr = persistence.NewMockUsageRecordManager(s.controller)
+	s.mockNexusEndpointMgr = persistence.NewMockNexusEndpointManager(s.controller)
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
 	s.mockSaMapper = searchattribute.NewMockMapper(s.controller)
@@ -181,6 +189,14 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetExecutionManager(),
 		s.mockResource.GetClusterMetadataManager(),
 		s.mockResource.GetMetadataManager(),
+		s.mockUsageRecordMgr,
+		newNexusEndpointClient(
+			&nexusEndpointClientConfig{},
+			s.mockResource.GetNamespaceRegistry(),
+			s.mockMatchingClient,
+			s.mockNexusEndpointMgr,
+			s.mockResource.GetLogger(),
+		),
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
@@ -959,6 +975,121 @@ func (s *adminHandlerSuite) Test_DescribeCluster_CurrentCluster_Success() {
 	s.True(resp.GetIsGlobalNamespaceEnabled())
 }
 
+func (s *adminHandlerSuite) Test_DescribeNexusEndpoint() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("host1"),
+		membership.NewHostInfoFromAddress("host2"),
+		membership.NewHostInfoFromAddress("host3"),
+	})
+	for _, host := range []string{"host1", "host2"} {
+		s.mockHistoryClient.EXPECT().DescribeNexusEndpoint(gomock.Any(), protomock.Eq(&historyservice.DescribeNexusEndpointRequest{
+			HostAddress: host,
+			EndpointId:  "endpoint-id",
+		})).DoAndReturn(func(ctx context.Context, _ *historyservice.DescribeNexusEndpointRequest, _ ...grpc.CallOption) (*historyservice.DescribeNexusEndpointResponse, error) {
+			deadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.WithinDuration(time.Now().Add(describeNexusEndpointHostTimeout), deadline, time.Second)
+			return &historyservice.DescribeNexusEndpointResponse{
+				CircuitBreaker: &commonspb.NexusEndpointCircuitBreakerInfo{
+					EndpointId: "endpoint-id",
+					State:      enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED,
+				},
+			}, nil
+		})
+	}
+	s.mockHistoryClient.EXPECT().DescribeNexusEndpoint(gomock.Any(), protomock.Eq(&historyservice.DescribeNexusEndpointRequest{
+		HostAddress: "host3",
+		EndpointId:  "endpoint-id",
+	})).Return(nil, serviceerror.NewUnavailable("host unavailable"))
+
+	resp, err := s.handler.DescribeNexusEndpoint(context.Background(), &adminservice.DescribeNexusEndpointRequest{Id: "endpoint-id"})
+	s.NoError(err)
+	s.Len(resp.GetCircuitBreakers(), 2)
+	s.Equal(enumsspb.NEXUS_ENDPOINT_CIRCUIT_BREAKER_STATE_CLOSED, resp.GetCircuitBreakers()["host2"].GetState())
+	s.Equal(map[string]string{"host3": "host unavailable"}, resp.GetHostErrors())
+
+	_, err = s.handler.DescribeNexusEndpoint(context.Background(), &adminservice.DescribeNexusEndpointRequest{})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateNexusEndpointOutboundSettings() {
+	endpointID := uuid.New()
+	newEntry := func(target *persistencespb.NexusEndpointTarget) *persistencespb.NexusEndpointEntry {
+		return &persistencespb.NexusEndpointEntry{
+			Id:      endpointID,
+			Version: 3,
+			Endpoint: &persistencespb.NexusEndpoint{
+				Spec: &persistencespb.NexusEndpointSpec{
+					Name:   "endpoint",
+					Target: target,
+				},
+			},
+		}
+	}
+	externalTarget := func(url string) *persistencespb.NexusEndpointTarget {
+		return &persistencespb.NexusEndpointTarget{
+			Variant: &persistencespb.NexusEndpointTarget_External_{
+				External: &persistencespb.NexusEndpointTarget_External{Url: url},
+			},
+		}
+	}
+	tlsSettings := &persistencespb.NexusEndpointTarget_TLS{CaFile: "/etc/ca.pem", ServerName: "internal.example.com"}
+	authHeader := &persistencespb.NexusEndpointTarget_AuthHeader{Name: "Authorization", SecretName: "TOKEN"}
+
+	entry := newEntry(externalTarget("https://example.com"))
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: endpointID}).Return(entry, nil)
+	expectedSpec := common.CloneProto(entry.Endpoint.Spec)
+	expectedSpec.Target.GetExternal().Tls = tlsSettings
+	expectedSpec.Target.GetExternal().AuthHeader = authHeader
+	updatedEntry := &persistencespb.NexusEndpointEntry{
+		Id:       endpointID,
+		Version:  4,
+		Endpoint: &persistencespb.NexusEndpoint{Spec: expectedSpec},
+	}
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(gomock.Any(), protomock.Eq(&matchingservice.UpdateNexusEndpointRequest{
+		Id:      endpointID,
+		Version: 3,
+		Spec:    expectedSpec,
+	})).Return(&matchingservice.UpdateNexusEndpointResponse{Entry: updatedEntry}, nil)
+
+	resp, err := s.handler.UpdateNexusEndpointOutboundSettings(context.Background(), &adminservice.UpdateNexusEndpointOutboundSettingsRequest{
+		Id:         endpointID,
+		Version:    3,
+		Tls:        tlsSettings,
+		AuthHeader: authHeader,
+	})
+	s.NoError(err)
+	s.ProtoEqual(updatedEntry, resp.GetEntry())
+	// The cached entry is not modified.
+	s.Nil(entry.Endpoint.Spec.Target.GetExternal().GetTls())
+
+	// TLS settings don't apply to http targets.
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), gomock.Any()).Return(newEntry(externalTarget("http://example.com")), nil)
+	_, err = s.handler.UpdateNexusEndpointOutboundSettings(context.Background(), &adminservice.UpdateNexusEndpointOutboundSettingsRequest{
+		Id:      endpointID,
+		Version: 3,
+		Tls:     tlsSettings,
+	})
+	s.ErrorContains(err, "TLS settings require an https URL")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// Worker targets have no outbound settings.
+	s.mockNexusEndpointMgr.EXPECT().GetNexusEndpoint(gomock.Any(), gomock.Any()).Return(newEntry(&persistencespb.NexusEndpointTarget{
+		Variant: &persistencespb.NexusEndpointTarget_Worker_{
+			Worker: &persistencespb.NexusEndpointTarget_Worker{NamespaceId: s.namespaceID.String(), TaskQueue: "tq"},
+		},
+	}), nil)
+	_, err = s.handler.UpdateNexusEndpointOutboundSettings(context.Background(), &adminservice.UpdateNexusEndpointOutboundSettingsRequest{
+		Id:         endpointID,
+		Version:    3,
+		AuthHeader: authHeader,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.UpdateNexusEndpointOutboundSettings(context.Background(), &adminservice.UpdateNexusEndpointOutboundSettingsRequest{})
+	s.Equal(codes.InvalidArgument, serviceerror.ToStatus(err).Code())
+}
+
 func (s *adminHandlerSuite) Test_DescribeCluster_NonCurrentCluster_Success() {
 	var clusterName = uuid.New()
 	var clusterId = uuid.New()
@@ -2107,6 +2238,44 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttr
 	}
 }
 
+func (s *adminHandlerSuite) TestListUsageRecords() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	resp, err := handler.ListUsageRecords(ctx, &adminservice.ListUsageRecordsRequest{})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Namespace is not set on request."}, err)
+	s.Nil(resp)
+
+	now := time.Now().UTC()
+	record := &persistencespb.UsageRecord{
+		NamespaceId:     s.namespaceID.String(),
+		WindowStartTime: timestamppb.New(now.Add(-time.Minute)),
+		WindowEndTime:   timestamppb.New(now),
+	}
+	// The time range is passed down so that persistence can seek to it.
+	s.mockUsageRecordMgr.EXPECT().ListUsageRecords(ctx, &persistence.ListUsageRecordsRequest{
+		NamespaceID:   s.namespaceID.String(),
+		PageSize:      listUsageRecordsDefaultPageSize,
+		NextPageToken: []byte("token"),
+		StartTime:     now.Add(-90 * time.Second),
+		EndTime:       now,
+	}).Return(&persistence.ListUsageRecordsResponse{
+		Records:       []*persistencespb.UsageRecord{record},
+		NextPageToken: []byte("next-token"),
+	}, nil)
+
+	resp, err = handler.ListUsageRecords(ctx, &adminservice.ListUsageRecordsRequest{
+		Namespace:     s.namespace.String(),
+		StartTime:     timestamppb.New(now.Add(-90 * time.Second)),
+		EndTime:       timestamppb.New(now),
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]*persistencespb.UsageRecord{record}, resp.Records)
+	s.Equal([]byte("next-token"), resp.NextPageToken)
+}
+
 func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo,
 	responsePhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo) {
 
@@ -2114,3 +2283,28 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) TestBackupPersistence_DestinationPath() {
+	handler := s.handler
+	ctx := context.Background()
+	handler.persistenceConfig = &config.Persistence{
+		DefaultStore: "default",
+		DataStores: map[string]config.DataStore{
+			"default": {SQL: &config.SQL{PluginName: "sqlite"}},
+		},
+	}
+
+	for _, destination := range []string{
+		"/tmp/backup.db",
+		"../backup.db",
+		"backups/../../backup.db",
+	} {
+		_, err := handler.BackupPersistence(ctx, &adminservice.BackupPersistenceRequest{DestinationPath: destination})
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument, destination)
+	}
+
+	_, err := handler.BackupPersistence(ctx, &adminservice.BackupPersistenceRequest{DestinationPath: "backup.db"})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
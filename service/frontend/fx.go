@@ -102,6 +102,7 @@ var Module = fx.Options(
 	fx.Provide(NamespaceHandoverInterceptorProvider),
 	fx.Provide(RedirectionInterceptorProvider),
 	fx.Provide(TelemetryInterceptorProvider),
+	fx.Provide(interceptor.NewTraceInterceptor),
 	fx.Provide(RetryableInterceptorProvider),
 	fx.Provide(RateLimitInterceptorProvider),
 	fx.Provide(interceptor.NewHealthInterceptor),
@@ -127,6 +128,7 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Invoke(RegisterNexusHTTPHandler),
 	fx.Invoke(RegisterOpenAPIHTTPHandler),
+	fx.Invoke(RegisterTaskQueueScalingHTTPHandler),
 	fx.Provide(HTTPAPIServerProvider),
 	fx.Provide(NewServiceProvider),
 	fx.Provide(NexusEndpointClientProvider),
@@ -222,6 +224,7 @@ func GrpcServerOptionsProvider(
 	namespaceHandoverInterceptor *interceptor.NamespaceHandoverInterceptor,
 	redirectionInterceptor *interceptor.Redirection,
 	telemetryInterceptor *interceptor.TelemetryInterceptor,
+	traceInterceptor *interceptor.TraceInterceptor,
 	retryableInterceptor *interceptor.RetryableInterceptor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	rateLimitInterceptor *interceptor.RateLimitInterceptor,
@@ -259,8 +262,10 @@ func GrpcServerOptionsProvider(
 	}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		// Order or interceptors is important
-		// Mask error interceptor should be the most outer interceptor since it handle the errors format
+		// Trace interceptor should be the most outer interceptor so that all others see the span it starts
+		// Mask error interceptor should be the most outer interceptor on error handling since it handle the errors format
 		// Service Error Interceptor should be the next most outer interceptor on error handling
+		traceInterceptor.UnaryIntercept,
 		maskInternalErrorDetailsInterceptor.Intercept,
 		interceptor.ServiceErrorInterceptor,
 		rpc.NewFrontendServiceErrorInterceptor(logger),
@@ -289,6 +294,7 @@ func GrpcServerOptionsProvider(
 	unaryInterceptors = append(unaryInterceptors, retryableInterceptor.Intercept)
 
 	streamInterceptor := []grpc.StreamServerInterceptor{
+		traceInterceptor.StreamIntercept,
 		telemetryInterceptor.StreamIntercept,
 	}
 
@@ -618,6 +624,8 @@ func AdminHandlerProvider(
 	persistenceExecutionManager persistence.ExecutionManager,
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	persistenceMetadataManager persistence.MetadataManager,
+	usageRecordManager persistence.UsageRecordManager,
+	nexusEndpointClient *NexusEndpointClient,
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient resource.HistoryClient,
@@ -648,6 +656,8 @@ func AdminHandlerProvider(
 		persistenceExecutionManager,
 		clusterMetadataManager,
 		persistenceMetadataManager,
+		usageRecordManager,
+		nexusEndpointClient,
 		clientFactory,
 		clientBean,
 		historyClient,
@@ -820,6 +830,26 @@ func RegisterOpenAPIHTTPHandler(
 	return h
 }
 
+func RegisterTaskQueueScalingHTTPHandler(
+	serviceConfig *Config,
+	namespaceRegistry namespace.Registry,
+	matchingClient resource.MatchingClient,
+	authInterceptor *authorization.Interceptor,
+	rateLimitInterceptor *interceptor.RateLimitInterceptor,
+	logger log.Logger,
+	router *mux.Router,
+) {
+	h := NewTaskQueueScalingHTTPHandler(
+		serviceConfig,
+		namespaceRegistry,
+		matchingClient,
+		authInterceptor,
+		rateLimitInterceptor,
+		logger,
+	)
+	h.RegisterRoutes(router)
+}
+
 func MuxRouterProvider() *mux.Router {
 	// Instantiate a router to support additional route prefixes.
 	return mux.NewRouter().UseEncodedPath()
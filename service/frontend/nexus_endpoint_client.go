@@ -33,8 +33,10 @@ import (
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -146,6 +148,9 @@ func (c *NexusEndpointClient) Update(
 	if err != nil {
 		return nil, err
 	}
+	if err := c.carryOverOutboundSettings(ctx, request, spec); err != nil {
+		return nil, err
+	}
 
 	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
 		Id:      request.Id,
@@ -166,6 +171,78 @@ func (c *NexusEndpointClient) Update(
 	}, nil
 }
 
+// carryOverOutboundSettings copies the outbound TLS and auth header settings of the endpoint being updated to spec,
+// since they are not part of the public API and would otherwise be cleared by every update. Settings are only carried
+// over from the version being updated, matching rejects the update if the endpoint was modified concurrently.
+func (c *NexusEndpointClient) carryOverOutboundSettings(
+	ctx context.Context,
+	request *operatorservice.UpdateNexusEndpointRequest,
+	spec *persistencespb.NexusEndpointSpec,
+) error {
+	external := spec.GetTarget().GetExternal()
+	if external == nil {
+		return nil
+	}
+	previous, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.GetId(),
+	})
+	if err != nil {
+		return c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.GetId()))
+	}
+	if previous.GetVersion() != request.GetVersion() {
+		return nil
+	}
+	if previousExternal := previous.GetEndpoint().GetSpec().GetTarget().GetExternal(); previousExternal != nil {
+		external.Tls = previousExternal.GetTls()
+		external.AuthHeader = previousExternal.GetAuthHeader()
+		// The URL may have changed, e.g. to http, in which case the TLS settings no longer apply.
+		if err := cnexus.ValidateExternalTarget(external); err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("endpoint outbound settings are not valid for the updated target: %v", err))
+		}
+	}
+	return nil
+}
+
+// UpdateOutboundSettings sets the outbound TLS and auth header settings of an endpoint with an external target.
+func (c *NexusEndpointClient) UpdateOutboundSettings(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointOutboundSettingsRequest,
+) (*adminservice.UpdateNexusEndpointOutboundSettingsResponse, error) {
+	issues := getEndpointIDIssues(request.GetId())
+	if err := issues.GetError(); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.GetId(),
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.GetId()))
+	}
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	external := spec.GetTarget().GetExternal()
+	if external == nil {
+		return nil, serviceerror.NewInvalidArgument("outbound settings can only be set on endpoints with an external target")
+	}
+	external.Tls = request.GetTls()
+	external.AuthHeader = request.GetAuthHeader()
+	if err := cnexus.ValidateExternalTarget(external); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid endpoint outbound settings: %v", err))
+	}
+
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.GetId(),
+		Version: request.GetVersion(),
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateNexusEndpointOutboundSettingsResponse{
+		Entry: resp.GetEntry(),
+	}, nil
+}
+
 func (c *NexusEndpointClient) Delete(
 	ctx context.Context,
 	request *operatorservice.DeleteNexusEndpointRequest,
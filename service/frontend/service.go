@@ -210,10 +210,15 @@ type Config struct {
 	// EnableNexusAPIs controls whether to allow invoking Nexus related APIs.
 	EnableNexusAPIs dynamicconfig.BoolPropertyFn
 
-	CallbackURLMaxLength    dynamicconfig.IntPropertyFnWithNamespaceFilter
-	CallbackHeaderMaxSize   dynamicconfig.IntPropertyFnWithNamespaceFilter
-	MaxCallbacksPerWorkflow dynamicconfig.IntPropertyFnWithNamespaceFilter
-	CallbackEndpointConfigs dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]callbacks.AddressMatchRule]
+	EnableTaskQueueScalingMetricsEndpoint dynamicconfig.BoolPropertyFn
+	TaskQueueScalingBacklogDrainTarget    dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	TaskQueueScalingMaxRatio              dynamicconfig.FloatPropertyFnWithNamespaceFilter
+
+	CallbackURLMaxLength          dynamicconfig.IntPropertyFnWithNamespaceFilter
+	CallbackHeaderMaxSize         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxCallbacksPerWorkflow       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	CallbackEndpointConfigs       dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]callbacks.AddressMatchRule]
+	CallbackWebhookSigningKeysDir dynamicconfig.StringPropertyFn
 
 	MaxNexusOperationTokenLength   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NexusRequestHeadersBlacklist   *dynamicconfig.GlobalCachedTypedValue[*regexp.Regexp]
@@ -347,6 +352,10 @@ func NewConfig(
 		EnableWorkerVersioningWorkflow: dynamicconfig.FrontendEnableWorkerVersioningWorkflowAPIs.Get(dc),
 		EnableWorkerVersioningRules:    dynamicconfig.FrontendEnableWorkerVersioningRuleAPIs.Get(dc),
 
+		EnableTaskQueueScalingMetricsEndpoint: dynamicconfig.FrontendEnableTaskQueueScalingMetricsEndpoint.Get(dc),
+		TaskQueueScalingBacklogDrainTarget:    dynamicconfig.FrontendTaskQueueScalingBacklogDrainTarget.Get(dc),
+		TaskQueueScalingMaxRatio:              dynamicconfig.FrontendTaskQueueScalingMaxRatio.Get(dc),
+
 		EnableNexusAPIs:              dynamicconfig.EnableNexus.Get(dc),
 		CallbackURLMaxLength:         dynamicconfig.FrontendCallbackURLMaxLength.Get(dc),
 		CallbackHeaderMaxSize:        dynamicconfig.FrontendCallbackHeaderMaxSize.Get(dc),
@@ -373,8 +382,9 @@ func NewConfig(
 		LinkMaxSize:        dynamicconfig.FrontendLinkMaxSize.Get(dc),
 		MaxLinksPerRequest: dynamicconfig.FrontendMaxLinksPerRequest.Get(dc),
 
-		CallbackEndpointConfigs:     callbacks.AllowedAddresses.Get(dc),
-		AdminEnableListHistoryTasks: dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
+		CallbackEndpointConfigs:       callbacks.AllowedAddresses.Get(dc),
+		CallbackWebhookSigningKeysDir: callbacks.WebhookSigningKeysDir.Get(dc),
+		AdminEnableListHistoryTasks:   dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
 
 		MaskInternalErrorDetails: dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc),
 
@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/namespace"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// getTaskQueueScalingRecommendation aggregates the stats of all partitions and active versions of a task queue
+// through the enhanced DescribeTaskQueue fan-out in matching, and derives a scaling ratio from them.
+func getTaskQueueScalingRecommendation(
+	ctx context.Context,
+	matchingClient matchingservice.MatchingServiceClient,
+	config *Config,
+	namespaceName namespace.Name,
+	namespaceID namespace.ID,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+	taskQueueTypes := []enumspb.TaskQueueType{taskQueueType}
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueTypes = []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+	}
+
+	resp, err := matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			Namespace:      namespaceName.String(),
+			TaskQueue:      &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			TaskQueueType:  enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			ApiMode:        enumspb.DESCRIBE_TASK_QUEUE_MODE_ENHANCED,
+			Versions:       &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+			TaskQueueTypes: taskQueueTypes,
+			ReportStats:    true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &taskqueuepb.TaskQueueStats{ApproximateBacklogAge: durationpb.New(0)}
+	for _, versionInfo := range resp.GetDescResponse().GetVersionsInfo() {
+		for _, typeInfo := range versionInfo.GetTypesInfo() {
+			s := typeInfo.GetStats()
+			stats.ApproximateBacklogCount += s.GetApproximateBacklogCount()
+			stats.TasksAddRate += s.GetTasksAddRate()
+			stats.TasksDispatchRate += s.GetTasksDispatchRate()
+			if s.GetApproximateBacklogAge().AsDuration() > stats.ApproximateBacklogAge.AsDuration() {
+				stats.ApproximateBacklogAge = s.GetApproximateBacklogAge()
+			}
+		}
+	}
+
+	return &adminservice.GetTaskQueueScalingRecommendationResponse{
+		Stats: stats,
+		ScalingRatio: taskQueueScalingRatio(
+			stats,
+			config.TaskQueueScalingBacklogDrainTarget(namespaceName.String()),
+			config.TaskQueueScalingMaxRatio(namespaceName.String()),
+		),
+	}, nil
+}
+
+// taskQueueScalingRatio estimates the ratio of the desired to the current worker capacity.
+//
+// Without a backlog the workers are keeping up with the add rate, so the ratio is 1, or 0 if there is no
+// traffic at all. With a backlog, the demand is the add rate plus the rate needed to drain the backlog within
+// drainTarget, and the current capacity is the dispatch rate. The ratio is also at least the backlog age
+// over drainTarget, so that an old backlog keeps pushing for more workers even if it is small.
+func taskQueueScalingRatio(stats *taskqueuepb.TaskQueueStats, drainTarget time.Duration, maxRatio float64) float64 {
+	backlogCount := float64(stats.GetApproximateBacklogCount())
+	addRate := float64(stats.GetTasksAddRate())
+	dispatchRate := float64(stats.GetTasksDispatchRate())
+
+	if backlogCount <= 0 {
+		if addRate == 0 && dispatchRate == 0 {
+			return 0
+		}
+		return min(1, maxRatio)
+	}
+	if dispatchRate <= 0 {
+		return maxRatio
+	}
+
+	drainSeconds := max(drainTarget.Seconds(), 1)
+	ratio := (addRate + backlogCount/drainSeconds) / dispatchRate
+	ratio = max(ratio, stats.GetApproximateBacklogAge().AsDuration().Seconds()/drainSeconds)
+	return min(max(ratio, 1), maxRatio)
+}
@@ -0,0 +1,323 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/rpc/interceptor"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	externalMetricsGroupVersion = "external.metrics.k8s.io/v1beta1"
+	externalMetricsPathPrefix   = "/apis/" + externalMetricsGroupVersion
+
+	// Label selector keys understood by the external metrics endpoint.
+	scalingLabelNamespace     = "temporal_namespace"
+	scalingLabelTaskQueue     = "task_queue"
+	scalingLabelTaskQueueType = "task_queue_type"
+)
+
+// Metrics served by the external metrics endpoint, keyed by name.
+var taskQueueScalingMetrics = map[string]func(*adminservice.GetTaskQueueScalingRecommendationResponse) float64{
+	"temporal_task_queue_scaling_ratio": func(r *adminservice.GetTaskQueueScalingRecommendationResponse) float64 {
+		return r.GetScalingRatio()
+	},
+	"temporal_task_queue_backlog_count": func(r *adminservice.GetTaskQueueScalingRecommendationResponse) float64 {
+		return float64(r.GetStats().GetApproximateBacklogCount())
+	},
+	"temporal_task_queue_backlog_age_seconds": func(r *adminservice.GetTaskQueueScalingRecommendationResponse) float64 {
+		return r.GetStats().GetApproximateBacklogAge().AsDuration().Seconds()
+	},
+	"temporal_task_queue_add_rate": func(r *adminservice.GetTaskQueueScalingRecommendationResponse) float64 {
+		return float64(r.GetStats().GetTasksAddRate())
+	},
+	"temporal_task_queue_dispatch_rate": func(r *adminservice.GetTaskQueueScalingRecommendationResponse) float64 {
+		return float64(r.GetStats().GetTasksDispatchRate())
+	},
+}
+
+type (
+	// TaskQueueScalingHTTPHandler serves task queue scaling recommendations in the Kubernetes external metrics
+	// API format, so that the frontend can back an external metrics APIService used by autoscalers.
+	// The Kubernetes namespace in the path is ignored; the task queue is selected with the labelSelector
+	// query parameter, e.g. "temporal_namespace=default,task_queue=my-tq,task_queue_type=Activity".
+	TaskQueueScalingHTTPHandler struct {
+		logger               log.Logger
+		config               *Config
+		namespaceRegistry    namespace.Registry
+		matchingClient       matchingservice.MatchingServiceClient
+		auth                 *authorization.Interceptor
+		rateLimitInterceptor *interceptor.RateLimitInterceptor
+		enabled              dynamicconfig.BoolPropertyFn
+		timeSource           func() time.Time
+	}
+
+	externalMetricValueList struct {
+		Kind       string                `json:"kind"`
+		APIVersion string                `json:"apiVersion"`
+		Metadata   struct{}              `json:"metadata"`
+		Items      []externalMetricValue `json:"items"`
+	}
+
+	externalMetricValue struct {
+		MetricName   string            `json:"metricName"`
+		MetricLabels map[string]string `json:"metricLabels"`
+		Timestamp    string            `json:"timestamp"`
+		Value        string            `json:"value"`
+	}
+
+	apiResourceList struct {
+		Kind         string        `json:"kind"`
+		APIVersion   string        `json:"apiVersion"`
+		GroupVersion string        `json:"groupVersion"`
+		Resources    []apiResource `json:"resources"`
+	}
+
+	apiResource struct {
+		Name       string   `json:"name"`
+		Namespaced bool     `json:"namespaced"`
+		Kind       string   `json:"kind"`
+		Verbs      []string `json:"verbs"`
+	}
+
+	apiStatus struct {
+		Kind       string `json:"kind"`
+		APIVersion string `json:"apiVersion"`
+		Status     string `json:"status"`
+		Message    string `json:"message"`
+		Code       int    `json:"code"`
+	}
+)
+
+func NewTaskQueueScalingHTTPHandler(
+	config *Config,
+	namespaceRegistry namespace.Registry,
+	matchingClient matchingservice.MatchingServiceClient,
+	authInterceptor *authorization.Interceptor,
+	rateLimitInterceptor *interceptor.RateLimitInterceptor,
+	logger log.Logger,
+) *TaskQueueScalingHTTPHandler {
+	return &TaskQueueScalingHTTPHandler{
+		logger:               logger,
+		config:               config,
+		namespaceRegistry:    namespaceRegistry,
+		matchingClient:       matchingClient,
+		auth:                 authInterceptor,
+		rateLimitInterceptor: rateLimitInterceptor,
+		enabled:              config.EnableTaskQueueScalingMetricsEndpoint,
+		timeSource:           time.Now,
+	}
+}
+
+func (h *TaskQueueScalingHTTPHandler) RegisterRoutes(r *mux.Router) {
+	r.Path(externalMetricsPathPrefix).Methods("GET").HandlerFunc(h.listMetrics)
+	r.Path(externalMetricsPathPrefix + "/namespaces/{k8s_namespace}/{metric}").Methods("GET").HandlerFunc(h.getMetric)
+}
+
+func (h *TaskQueueScalingHTTPHandler) listMetrics(w http.ResponseWriter, r *http.Request) {
+	if !h.enabled() {
+		h.writeStatus(w, http.StatusNotFound, "task queue scaling metrics endpoint disabled")
+		return
+	}
+	resp := apiResourceList{
+		Kind:         "APIResourceList",
+		APIVersion:   "v1",
+		GroupVersion: externalMetricsGroupVersion,
+	}
+	for _, name := range slices.Sorted(maps.Keys(taskQueueScalingMetrics)) {
+		resp.Resources = append(resp.Resources, apiResource{
+			Name:       name,
+			Namespaced: true,
+			Kind:       "ExternalMetricValueList",
+			Verbs:      []string{"get"},
+		})
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TaskQueueScalingHTTPHandler) getMetric(w http.ResponseWriter, r *http.Request) {
+	if !h.enabled() {
+		h.writeStatus(w, http.StatusNotFound, "task queue scaling metrics endpoint disabled")
+		return
+	}
+	apiName := adminservice.AdminService_GetTaskQueueScalingRecommendation_FullMethodName
+	if err := h.rateLimitInterceptor.Allow(apiName, r.Header); err != nil {
+		h.writeStatus(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
+
+	metricName := mux.Vars(r)["metric"]
+	metricFn, ok := taskQueueScalingMetrics[metricName]
+	if !ok {
+		h.writeStatus(w, http.StatusNotFound, fmt.Sprintf("unknown metric %q", metricName))
+		return
+	}
+	labels, err := parseLabelSelector(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		h.writeStatus(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	namespaceName := namespace.Name(labels[scalingLabelNamespace])
+	taskQueue := labels[scalingLabelTaskQueue]
+	if namespaceName == "" || taskQueue == "" {
+		h.writeStatus(w, http.StatusBadRequest, fmt.Sprintf("labelSelector must set %s and %s", scalingLabelNamespace, scalingLabelTaskQueue))
+		return
+	}
+	taskQueueType := enumspb.TASK_QUEUE_TYPE_UNSPECIFIED
+	if typeName := labels[scalingLabelTaskQueueType]; typeName != "" {
+		if taskQueueType, err = enumspb.TaskQueueTypeFromString(typeName); err != nil {
+			h.writeStatus(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	ctx := r.Context()
+	claims, err := h.getClaims(r)
+	if err != nil {
+		h.logger.Error("failed to get claims", tag.Error(err))
+		h.writeStatus(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	if err := h.auth.Authorize(ctx, claims, &authorization.CallTarget{
+		APIName:   apiName,
+		Namespace: namespaceName.String(),
+	}); err != nil {
+		h.writeStatus(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	namespaceID, err := h.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	resp, err := getTaskQueueScalingRecommendation(ctx, h.matchingClient, h.config, namespaceName, namespaceID, taskQueue, taskQueueType)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, externalMetricValueList{
+		Kind:       "ExternalMetricValueList",
+		APIVersion: externalMetricsGroupVersion,
+		Items: []externalMetricValue{{
+			MetricName:   metricName,
+			MetricLabels: labels,
+			Timestamp:    h.timeSource().UTC().Format(time.RFC3339),
+			Value:        formatQuantity(metricFn(resp)),
+		}},
+	})
+}
+
+func (h *TaskQueueScalingHTTPHandler) getClaims(r *http.Request) (*authorization.Claims, error) {
+	var tlsInfo *credentials.TLSInfo
+	if r.TLS != nil {
+		tlsInfo = &credentials.TLSInfo{
+			State:          *r.TLS,
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}
+	}
+	authInfo := h.auth.GetAuthInfo(tlsInfo, r.Header, func() string {
+		return ""
+	})
+	if authInfo == nil {
+		return nil, nil
+	}
+	return h.auth.GetClaims(authInfo)
+}
+
+func (h *TaskQueueScalingHTTPHandler) writeError(w http.ResponseWriter, err error) {
+	h.writeStatus(w, runtime.HTTPStatusFromCode(serviceerror.ToStatus(err).Code()), err.Error())
+}
+
+func (h *TaskQueueScalingHTTPHandler) writeStatus(w http.ResponseWriter, code int, message string) {
+	h.writeJSON(w, code, apiStatus{
+		Kind:       "Status",
+		APIVersion: "v1",
+		Status:     "Failure",
+		Message:    message,
+		Code:       code,
+	})
+}
+
+func (h *TaskQueueScalingHTTPHandler) writeJSON(w http.ResponseWriter, code int, body any) {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		h.logger.Error("failed to marshal response", tag.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(bytes); err != nil {
+		h.logger.Error("failed to write response body", tag.Error(err))
+	}
+}
+
+// parseLabelSelector parses an equality-based Kubernetes label selector such as "a=b,c==d".
+func parseLabelSelector(selector string) (map[string]string, error) {
+	labels := make(map[string]string)
+	if selector == "" {
+		return labels, nil
+	}
+	for _, requirement := range strings.Split(selector, ",") {
+		key, value, ok := strings.Cut(requirement, "=")
+		if !ok {
+			return nil, fmt.Errorf("unsupported label selector requirement %q", requirement)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+		if key == "" || strings.ContainsAny(key, "!") {
+			return nil, fmt.Errorf("unsupported label selector requirement %q", requirement)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// formatQuantity formats a value as a Kubernetes quantity with milli precision.
+func formatQuantity(value float64) string {
+	return fmt.Sprintf("%dm", int64(math.Round(value*1000)))
+}
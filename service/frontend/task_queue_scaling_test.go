@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/rpc/interceptor"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestTaskQueueScalingRatio(t *testing.T) {
+	drainTarget := 10 * time.Second
+	testCases := []struct {
+		name     string
+		stats    *taskqueuepb.TaskQueueStats
+		expected float64
+	}{
+		{
+			name:     "idle",
+			stats:    &taskqueuepb.TaskQueueStats{},
+			expected: 0,
+		},
+		{
+			name:     "keeping up",
+			stats:    &taskqueuepb.TaskQueueStats{TasksAddRate: 5, TasksDispatchRate: 5},
+			expected: 1,
+		},
+		{
+			name:     "backlog without dispatch",
+			stats:    &taskqueuepb.TaskQueueStats{ApproximateBacklogCount: 1},
+			expected: 5,
+		},
+		{
+			name: "backlog drained by rate",
+			stats: &taskqueuepb.TaskQueueStats{
+				ApproximateBacklogCount: 100,
+				TasksAddRate:            10,
+				TasksDispatchRate:       10,
+			},
+			expected: 2,
+		},
+		{
+			name: "old backlog",
+			stats: &taskqueuepb.TaskQueueStats{
+				ApproximateBacklogCount: 1,
+				ApproximateBacklogAge:   durationpb.New(30 * time.Second),
+				TasksAddRate:            10,
+				TasksDispatchRate:       10,
+			},
+			expected: 3,
+		},
+		{
+			name: "capped",
+			stats: &taskqueuepb.TaskQueueStats{
+				ApproximateBacklogCount: 10000,
+				TasksDispatchRate:       1,
+			},
+			expected: 5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.InDelta(t, tc.expected, taskQueueScalingRatio(tc.stats, drainTarget, 5), 0.0001)
+		})
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	labels, err := parseLabelSelector("temporal_namespace=ns, task_queue==tq")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"temporal_namespace": "ns", "task_queue": "tq"}, labels)
+
+	_, err = parseLabelSelector("task_queue!=tq")
+	require.Error(t, err)
+	_, err = parseLabelSelector("task_queue")
+	require.Error(t, err)
+}
+
+func TestTaskQueueScalingHTTPHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.FrontendEnableTaskQueueScalingMetricsEndpoint.Key(): true,
+	}, log.NewNoopLogger())
+	config := NewConfig(dc, 1)
+	h := NewTaskQueueScalingHTTPHandler(
+		config,
+		namespaceRegistry,
+		matchingClient,
+		authorization.NewInterceptor(nil, nil, metrics.NoopMetricsHandler, log.NewNoopLogger(), nil, nil, "", ""),
+		interceptor.NewRateLimitInterceptor(quotas.NoopRequestRateLimiter, map[string]int{}),
+		log.NewNoopLogger(),
+	)
+	h.timeSource = func() time.Time { return time.Unix(0, 0) }
+	router := mux.NewRouter()
+	h.RegisterRoutes(router)
+
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name("ns")).Return(namespace.ID("ns-id"), nil)
+	matchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, req *matchingservice.DescribeTaskQueueRequest, _ ...any) (*matchingservice.DescribeTaskQueueResponse, error) {
+			require.Equal(t, "ns-id", req.GetNamespaceId())
+			require.Equal(t, "tq", req.GetDescRequest().GetTaskQueue().GetName())
+			require.Equal(t, []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY}, req.GetDescRequest().GetTaskQueueTypes())
+			return &matchingservice.DescribeTaskQueueResponse{
+				DescResponse: &workflowservice.DescribeTaskQueueResponse{
+					VersionsInfo: map[string]*taskqueuepb.TaskQueueVersionInfo{
+						"": {TypesInfo: map[int32]*taskqueuepb.TaskQueueTypeInfo{
+							int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {Stats: &taskqueuepb.TaskQueueStats{ApproximateBacklogCount: 30}},
+						}},
+						"v1": {TypesInfo: map[int32]*taskqueuepb.TaskQueueTypeInfo{
+							int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {Stats: &taskqueuepb.TaskQueueStats{ApproximateBacklogCount: 12}},
+						}},
+					},
+				},
+			}, nil
+		})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet,
+		"/apis/external.metrics.k8s.io/v1beta1/namespaces/default/temporal_task_queue_backlog_count"+
+			"?labelSelector=temporal_namespace%3Dns,task_queue%3Dtq,task_queue_type%3DActivity", nil)
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var list externalMetricValueList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Equal(t, "ExternalMetricValueList", list.Kind)
+	require.Len(t, list.Items, 1)
+	require.Equal(t, "temporal_task_queue_backlog_count", list.Items[0].MetricName)
+	require.Equal(t, "42000m", list.Items[0].Value)
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/unknown", nil)
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/temporal_task_queue_scaling_ratio", nil)
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strconv"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/headers"
+)
+
+// workerMetadataFromContext collects the metadata a worker reports on poll: SDK name and
+// version from the standard client headers, host and slot usage from the optional worker
+// headers, and the given build ID.
+func workerMetadataFromContext(ctx context.Context, buildId string) *taskqueuespb.WorkerMetadata {
+	values := headers.GetValues(ctx,
+		headers.ClientNameHeaderName,
+		headers.ClientVersionHeaderName,
+		headers.WorkerHostNameHeaderName,
+		headers.WorkerAvailableSlotsHeaderName,
+		headers.WorkerUsedSlotsHeaderName,
+	)
+	metadata := &taskqueuespb.WorkerMetadata{
+		SdkName:    values[0],
+		SdkVersion: values[1],
+		HostName:   values[2],
+		BuildId:    buildId,
+	}
+	available, availableErr := strconv.ParseInt(values[3], 10, 32)
+	used, usedErr := strconv.ParseInt(values[4], 10, 32)
+	if availableErr == nil && usedErr == nil && available >= 0 && used >= 0 {
+		metadata.SlotsReported = true
+		metadata.AvailableSlots = int32(available)
+		metadata.UsedSlots = int32(used)
+	}
+	return metadata
+}
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/headers"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWorkerMetadataFromContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.ClientNameHeaderName, "temporal-go",
+		headers.ClientVersionHeaderName, "1.30.0",
+		headers.WorkerHostNameHeaderName, "host-1",
+		headers.WorkerAvailableSlotsHeaderName, "7",
+		headers.WorkerUsedSlotsHeaderName, "3",
+	))
+
+	md := workerMetadataFromContext(ctx, "build-1")
+	require.Equal(t, "temporal-go", md.GetSdkName())
+	require.Equal(t, "1.30.0", md.GetSdkVersion())
+	require.Equal(t, "host-1", md.GetHostName())
+	require.Equal(t, "build-1", md.GetBuildId())
+	require.True(t, md.GetSlotsReported())
+	require.Equal(t, int32(7), md.GetAvailableSlots())
+	require.Equal(t, int32(3), md.GetUsedSlots())
+}
+
+func TestWorkerMetadataFromContext_InvalidSlots(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.WorkerAvailableSlotsHeaderName, "7",
+		headers.WorkerUsedSlotsHeaderName, "-1",
+	))
+
+	md := workerMetadataFromContext(ctx, "")
+	require.False(t, md.GetSlotsReported())
+	require.Zero(t, md.GetAvailableSlots())
+	require.Zero(t, md.GetUsedSlots())
+
+	md = workerMetadataFromContext(context.Background(), "")
+	require.False(t, md.GetSlotsReported())
+	require.Empty(t, md.GetHostName())
+}
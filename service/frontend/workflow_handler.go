@@ -51,6 +51,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/client/frontend"
@@ -88,6 +89,7 @@ import (
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
+	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deployment"
@@ -151,6 +153,7 @@ type (
 		scheduleSpecBuilder                           *scheduler.SpecBuilder
 		outstandingPollers                            collection.SyncMap[string, collection.SyncMap[string, context.CancelFunc]]
 		httpEnabled                                   bool
+		webhookSigningKeys                            callbacks.WebhookSigningKeyProvider
 	}
 )
 
@@ -235,6 +238,7 @@ func NewWorkflowHandler(
 		scheduleSpecBuilder: scheduleSpecBuilder,
 		outstandingPollers:  collection.NewSyncMap[string, collection.SyncMap[string, context.CancelFunc]](),
 		httpEnabled:         httpEnabled,
+		webhookSigningKeys:  callbacks.NewFileWebhookSigningKeyProvider(config.CallbackWebhookSigningKeysDir),
 	}
 
 	return handler
@@ -884,9 +888,10 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	childCtx := wh.registerOutstandingPollContext(ctx, pollerID, namespaceID.String())
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
 	matchingResp, err := wh.matchingClient.PollWorkflowTaskQueue(childCtx, &matchingservice.PollWorkflowTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:    namespaceID.String(),
+		PollerId:       pollerID,
+		PollRequest:    request,
+		WorkerMetadata: workerMetadataFromContext(ctx, cmp.Or(request.GetDeploymentOptions().GetBuildId(), request.GetWorkerVersionCapabilities().GetBuildId())),
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueue, pollerID)
@@ -1113,9 +1118,10 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 	childCtx := wh.registerOutstandingPollContext(ctx, pollerID, namespaceID.String())
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
 	matchingResponse, err := wh.matchingClient.PollActivityTaskQueue(childCtx, &matchingservice.PollActivityTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:    namespaceID.String(),
+		PollerId:       pollerID,
+		PollRequest:    request,
+		WorkerMetadata: workerMetadataFromContext(ctx, cmp.Or(request.GetDeploymentOptions().GetBuildId(), request.GetWorkerVersionCapabilities().GetBuildId())),
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueue, pollerID)
@@ -4936,9 +4942,10 @@ func (wh *WorkflowHandler) PollNexusTaskQueue(ctx context.Context, request *work
 	childCtx := wh.registerOutstandingPollContext(ctx, pollerID, namespaceID.String())
 	defer wh.unregisterOutstandingPollContext(pollerID, namespaceID.String())
 	matchingResponse, err := wh.matchingClient.PollNexusTaskQueue(childCtx, &matchingservice.PollNexusTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		Request:     request,
+		NamespaceId:    namespaceID.String(),
+		PollerId:       pollerID,
+		Request:        request,
+		WorkerMetadata: workerMetadataFromContext(ctx, cmp.Or(request.GetDeploymentOptions().GetBuildId(), request.GetWorkerVersionCapabilities().GetBuildId())),
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_NEXUS, request.TaskQueue, pollerID)
@@ -5181,16 +5188,16 @@ func (wh *WorkflowHandler) validateLinks(
 
 func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 	ns namespace.Name,
-	callbacks []*commonpb.Callback,
+	completionCallbacks []*commonpb.Callback,
 ) error {
-	if len(callbacks) > 0 && !wh.config.EnableNexusAPIs() {
+	if len(completionCallbacks) > 0 && !wh.config.EnableNexusAPIs() {
 		return status.Error(
 			codes.InvalidArgument,
 			"attaching workflow callbacks is disabled for this namespace",
 		)
 	}
 
-	if len(callbacks) > wh.config.MaxCallbacksPerWorkflow(ns.String()) {
+	if len(completionCallbacks) > wh.config.MaxCallbacksPerWorkflow(ns.String()) {
 		return status.Error(
 			codes.InvalidArgument,
 			fmt.Sprintf(
@@ -5200,36 +5207,62 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 		)
 	}
 
-	for _, callback := range callbacks {
-		switch cb := callback.GetVariant().(type) {
-		case *commonpb.Callback_Nexus_:
+	for _, callback := range completionCallbacks {
+		switch callback.GetVariant().(type) {
+		case *commonpb.Callback_Nexus_, *commonpb.Callback_Internal_:
+		default:
+			return status.Error(codes.Unimplemented, fmt.Sprintf("unknown callback variant: %T", callback.GetVariant()))
+		}
+		persistenceCB, err := callbacks.CallbackFromAPI(callback)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid callback: %v", err))
+		}
+
+		switch cb := persistenceCB.GetVariant().(type) {
+		case *persistencespb.Callback_Nexus_:
 			if err := wh.validateCallbackURL(ns, cb.Nexus.GetUrl()); err != nil {
 				return err
 			}
-
-			headerSize := 0
-			for k, v := range cb.Nexus.GetHeader() {
-				headerSize += len(k) + len(v)
+			if err := wh.validateCallbackHeader(ns, cb.Nexus.GetHeader()); err != nil {
+				return err
 			}
-			if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
-				return status.Error(
-					codes.InvalidArgument,
-					fmt.Sprintf(
-						"invalid header: header size longer than max allowed size of %d",
-						wh.config.CallbackHeaderMaxSize(ns.String()),
-					),
-				)
+		case *persistencespb.Callback_Webhook_:
+			if err := wh.validateCallbackURL(ns, cb.Webhook.GetUrl()); err != nil {
+				return err
 			}
-		case *commonpb.Callback_Internal_:
-			// TODO(Tianyu): For now, there is nothing to validate given that this is an internal field.
-			continue
-		default:
-			return status.Error(codes.Unimplemented, fmt.Sprintf("unknown callback variant: %T", cb))
+			if err := wh.validateCallbackHeader(ns, cb.Webhook.GetHeader()); err != nil {
+				return err
+			}
+			if keyID := cb.Webhook.GetSigningKeyId(); keyID != "" {
+				if _, err := wh.webhookSigningKeys.SigningKey(ns.String(), keyID); err != nil {
+					return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid webhook signing key: %v", err))
+				}
+			}
+		}
+		if err := retrypolicy.Validate(persistenceCB.GetRetryPolicy()); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func (wh *WorkflowHandler) validateCallbackHeader(ns namespace.Name, header map[string]string) error {
+	headerSize := 0
+	for k, v := range header {
+		headerSize += len(k) + len(v)
+	}
+	if headerSize > wh.config.CallbackHeaderMaxSize(ns.String()) {
+		return status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf(
+				"invalid header: header size longer than max allowed size of %d",
+				wh.config.CallbackHeaderMaxSize(ns.String()),
+			),
+		)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string) error {
 	if len(rawURL) > wh.config.CallbackURLMaxLength(ns.String()) {
 		return status.Errorf(codes.InvalidArgument, "invalid url: url length longer than max length allowed of %d", wh.config.CallbackURLMaxLength(ns.String()))
@@ -65,6 +65,7 @@ type (
 		RpcFactory             common.RPCFactory
 		RetryableInterceptor   *interceptor.RetryableInterceptor
 		TelemetryInterceptor   *interceptor.TelemetryInterceptor
+		TraceInterceptor       *interceptor.TraceInterceptor
 		RateLimitInterceptor   *interceptor.RateLimitInterceptor
 		TracingStatsHandler    telemetry.ServerStatsHandler
 		AdditionalInterceptors []grpc.UnaryServerInterceptor `optional:"true"`
@@ -155,13 +156,14 @@ func GrpcServerOptionsProvider(
 	return append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(getUnaryInterceptors(params)...),
-		grpc.ChainStreamInterceptor(params.TelemetryInterceptor.StreamIntercept),
+		grpc.ChainStreamInterceptor(params.TraceInterceptor.StreamIntercept, params.TelemetryInterceptor.StreamIntercept),
 		grpc.StreamInterceptor(interceptor.CustomErrorStreamInterceptor),
 	)
 }
 
 func getUnaryInterceptors(params GrpcServerOptionsParams) []grpc.UnaryServerInterceptor {
 	interceptors := []grpc.UnaryServerInterceptor{
+		params.TraceInterceptor.UnaryIntercept,
 		interceptor.ServiceErrorInterceptor,
 		metrics.NewServerMetricsContextInjectorInterceptor(),
 		metrics.NewServerMetricsTrailerPropagatorInterceptor(params.Logger),
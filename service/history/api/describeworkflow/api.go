@@ -322,20 +322,19 @@ func buildCallbackInfo(
 	outboundQueueCBPool *circuitbreakerpool.OutboundQueueCircuitBreakerPool,
 ) (*workflowpb.CallbackInfo, error) {
 	destination := ""
-	cbSpec := &commonpb.Callback{}
 	switch variant := callback.Callback.Variant.(type) {
 	case *persistencespb.Callback_Nexus_:
-		cbSpec.Variant = &commonpb.Callback_Nexus_{
-			Nexus: &commonpb.Callback_Nexus{
-				Url:    variant.Nexus.GetUrl(),
-				Header: variant.Nexus.GetHeader(),
-			},
-		}
 		destination = variant.Nexus.GetUrl()
+	case *persistencespb.Callback_Webhook_:
+		destination = variant.Webhook.GetUrl()
 	default:
-		// Ignore non-nexus callbacks for now (there aren't any just yet).
+		// Ignore internal callbacks, they have no public representation.
 		return nil, nil
 	}
+	cbSpec, err := callbacks.CallbackToAPI(callback.Callback)
+	if err != nil {
+		return nil, err
+	}
 
 	var state enumspb.CallbackState
 	switch callback.State() {
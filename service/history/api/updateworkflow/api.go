@@ -74,6 +74,7 @@ type Updater struct {
 	// to avoid data races when used outside the workflow lease.
 	taskQueue              *taskqueuepb.TaskQueue
 	priority               *commonpb.Priority
+	workflowType           *commonpb.WorkflowType
 	normalTaskQueueName    string
 	scheduledEventID       int64
 	scheduleToStartTimeout time.Duration
@@ -212,6 +213,7 @@ func (u *Updater) ApplyRequest(
 
 	u.taskQueue = common.CloneProto(newWorkflowTask.TaskQueue)
 	u.priority = common.CloneProto(ms.GetExecutionInfo().Priority)
+	u.workflowType = &commonpb.WorkflowType{Name: ms.GetExecutionInfo().WorkflowTypeName}
 	u.normalTaskQueueName = ms.GetExecutionInfo().TaskQueue
 	u.directive = worker_versioning.MakeDirectiveForWorkflowTask(
 		ms.GetInheritedBuildId(),
@@ -300,6 +302,7 @@ func (u *Updater) addWorkflowTaskToMatching(ctx context.Context) error {
 		Clock:                  clock,
 		VersionDirective:       u.directive,
 		Priority:               u.priority,
+		WorkflowType:           u.workflowType,
 	})
 	if err != nil {
 		return err
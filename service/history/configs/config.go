@@ -251,6 +251,10 @@ type Config struct {
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.TypedPropertyFnWithNamespaceFilter[retrypolicy.DefaultRetrySettings]
 
+	// ActivityRetryDispatchDelayMax is the longest retry backoff for which
+	// activity retries are delayed in matching rather than by a history timer.
+	ActivityRetryDispatchDelayMax dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
 	// DefaultWorkflowRetryPolicy specifies the out-of-box retry policy for
 	// any unset fields on a RetryPolicy configured on a Workflow
 	DefaultWorkflowRetryPolicy dynamicconfig.TypedPropertyFnWithNamespaceFilter[retrypolicy.DefaultRetrySettings]
@@ -384,6 +388,12 @@ type Config struct {
 	BreakdownMetricsByTaskQueue dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 
 	LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	MeteringEnabled                   dynamicconfig.BoolPropertyFn
+	MeteringFlushInterval             dynamicconfig.DurationPropertyFn
+	MeteringRetention                 dynamicconfig.DurationPropertyFn
+	MeteringCostCenterSearchAttribute dynamicconfig.StringPropertyFnWithNamespaceFilter
+	MeteringMaxCostCenters            dynamicconfig.IntPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -601,6 +611,7 @@ func NewConfig(
 		EnableStickyQuery: dynamicconfig.EnableStickyQuery.Get(dc),
 
 		DefaultActivityRetryPolicy:                       dynamicconfig.DefaultActivityRetryPolicy.Get(dc),
+		ActivityRetryDispatchDelayMax:                    dynamicconfig.ActivityRetryDispatchDelayMax.Get(dc),
 		DefaultWorkflowRetryPolicy:                       dynamicconfig.DefaultWorkflowRetryPolicy.Get(dc),
 		WorkflowTaskHeartbeatTimeout:                     dynamicconfig.WorkflowTaskHeartbeatTimeout.Get(dc),
 		WorkflowTaskCriticalAttempts:                     dynamicconfig.WorkflowTaskCriticalAttempts.Get(dc),
@@ -701,6 +712,12 @@ func NewConfig(
 		BreakdownMetricsByTaskQueue: dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 
 		LogAllReqErrors: dynamicconfig.LogAllReqErrors.Get(dc),
+
+		MeteringEnabled:                   dynamicconfig.MeteringEnabled.Get(dc),
+		MeteringFlushInterval:             dynamicconfig.MeteringFlushInterval.Get(dc),
+		MeteringRetention:                 dynamicconfig.MeteringRetention.Get(dc),
+		MeteringCostCenterSearchAttribute: dynamicconfig.MeteringCostCenterSearchAttribute.Get(dc),
+		MeteringMaxCostCenters:            dynamicconfig.MeteringMaxCostCenters.Get(dc),
 	}
 
 	return cfg
@@ -112,6 +112,7 @@ type (
 		taskQueueManager             persistence.HistoryTaskQueueManager
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
 		dlqMetricsEmitter            *persistence.DLQMetricsEmitter
+		nexusEndpointCircuitBreakers *commonnexus.EndpointCircuitBreakers
 
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
@@ -143,6 +144,7 @@ type (
 		TaskQueueManager             persistence.HistoryTaskQueueManager
 		TaskCategoryRegistry         tasks.TaskCategoryRegistry
 		DLQMetricsEmitter            *persistence.DLQMetricsEmitter
+		NexusEndpointCircuitBreakers *commonnexus.EndpointCircuitBreakers
 
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
 		ReplicationTaskConverterFactory replication.SourceTaskConverterProvider
@@ -226,6 +228,22 @@ func (h *Handler) DeepHealthCheck(
 	return &historyservice.DeepHealthCheckResponse{State: enumsspb.HEALTH_STATE_SERVING}, nil
 }
 
+// DescribeNexusEndpoint returns the state of this host's circuit breaker for a Nexus endpoint.
+func (h *Handler) DescribeNexusEndpoint(
+	_ context.Context,
+	request *historyservice.DescribeNexusEndpointRequest,
+) (_ *historyservice.DescribeNexusEndpointResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if request.GetEndpointId() == "" {
+		return nil, h.convertError(serviceerror.NewInvalidArgument("EndpointId is not set on request."))
+	}
+	return &historyservice.DescribeNexusEndpointResponse{
+		CircuitBreaker: h.nexusEndpointCircuitBreakers.Describe(request.GetEndpointId()),
+	}, nil
+}
+
 // IsWorkflowTaskValid - whether workflow task is still valid
 func (h *Handler) IsWorkflowTaskValid(ctx context.Context, request *historyservice.IsWorkflowTaskValidRequest) (_ *historyservice.IsWorkflowTaskValidResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
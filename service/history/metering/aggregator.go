@@ -0,0 +1,250 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	flushTimeout = 10 * time.Second
+	// trimInterval is how often each host deletes the expired usage records of a namespace it writes to.
+	trimInterval = time.Hour
+
+	// OverflowCostCenter is the cost center usage is attributed to once a namespace has more distinct cost centers
+	// within a flush interval than MeteringMaxCostCenters.
+	OverflowCostCenter = "__other__"
+)
+
+type (
+	// Aggregator sums up usage per Key in memory and periodically writes the sums as usage records. Each record
+	// covers the window from the first usage recorded for its key until the flush. Usage which wasn't written yet is
+	// lost if the host crashes.
+	Aggregator struct {
+		usageRecordManager persistence.UsageRecordManager
+		timeSource         clock.TimeSource
+		logger             log.Logger
+		flushInterval      func() time.Duration
+		retention          func() time.Duration
+		maxCostCenters     func() int
+
+		mu      sync.Mutex
+		pending map[Key]*pendingUsage
+		// costCenters holds the distinct cost centers of each namespace's pending usage.
+		costCenters map[string]map[string]struct{}
+
+		// Only accessed by flush, which never runs concurrently.
+		lastTrimTime map[string]time.Time
+		// unwritten holds the batch of each namespace whose write failed. It is retried unchanged, so that it can be
+		// told apart from the batches which were written.
+		unwritten map[string]*usageBatch
+
+		ctx    context.Context
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+	}
+
+	pendingUsage struct {
+		Usage
+		windowStart time.Time
+	}
+
+	usageBatch struct {
+		flushID string
+		records []*persistencespb.UsageRecord
+	}
+)
+
+func NewAggregator(
+	config *configs.Config,
+	usageRecordManager persistence.UsageRecordManager,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Aggregator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Aggregator{
+		usageRecordManager: usageRecordManager,
+		timeSource:         timeSource,
+		logger:             logger,
+		flushInterval:      config.MeteringFlushInterval,
+		retention:          config.MeteringRetention,
+		maxCostCenters:     config.MeteringMaxCostCenters,
+		pending:            make(map[Key]*pendingUsage),
+		costCenters:        make(map[string]map[string]struct{}),
+		lastTrimTime:       make(map[string]time.Time),
+		unwritten:          make(map[string]*usageBatch),
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+}
+
+// Record adds usage to the pending sums of key.
+func (a *Aggregator) Record(key Key, usage Usage) {
+	if usage.IsZero() {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if key.CostCenter != "" {
+		costCenters, ok := a.costCenters[key.NamespaceID]
+		if !ok {
+			costCenters = make(map[string]struct{})
+			a.costCenters[key.NamespaceID] = costCenters
+		}
+		if _, ok := costCenters[key.CostCenter]; !ok {
+			if len(costCenters) >= a.maxCostCenters() {
+				key.CostCenter = OverflowCostCenter
+			} else {
+				costCenters[key.CostCenter] = struct{}{}
+			}
+		}
+	}
+
+	now := a.timeSource.Now()
+	p, ok := a.pending[key]
+	if !ok {
+		p = &pendingUsage{windowStart: now}
+		a.pending[key] = p
+	}
+	p.Add(usage)
+}
+
+func (a *Aggregator) Start() {
+	a.wg.Add(1)
+	go a.flushLoop()
+}
+
+// Stop stops the periodic flushes and writes the usage which is still pending.
+func (a *Aggregator) Stop() {
+	a.cancel()
+	a.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	a.flush(ctx)
+}
+
+func (a *Aggregator) flushLoop() {
+	defer a.wg.Done()
+
+	timer := time.NewTimer(a.flushInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-timer.C:
+			ctx, cancel := context.WithTimeout(a.ctx, flushTimeout)
+			a.flush(ctx)
+			cancel()
+			timer.Reset(a.flushInterval())
+		}
+	}
+}
+
+// flush writes all pending usage as usage records, with a single atomic append per namespace. The append of a
+// namespace which fails is retried with the next flush, with the same flush ID and records so that it isn't written
+// twice if the failed append was applied after all. Until then, the namespace's new usage is kept pending.
+func (a *Aggregator) flush(ctx context.Context) {
+	flushID := uuid.NewString()
+	windowEnd := a.timeSource.Now()
+	batches := make(map[string]*usageBatch)
+	a.mu.Lock()
+	for key, p := range a.pending {
+		if _, ok := a.unwritten[key.NamespaceID]; ok {
+			continue
+		}
+		batch, ok := batches[key.NamespaceID]
+		if !ok {
+			batch = &usageBatch{flushID: flushID}
+			batches[key.NamespaceID] = batch
+		}
+		batch.records = append(batch.records, p.toRecord(key, p.windowStart, windowEnd))
+		delete(a.pending, key)
+	}
+	for namespaceID := range batches {
+		delete(a.costCenters, namespaceID)
+	}
+	a.mu.Unlock()
+
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+	for namespaceID, batch := range a.unwritten {
+		a.write(ctx, namespaceID, batch, true, windowEnd)
+	}
+	for namespaceID, batch := range batches {
+		a.write(ctx, namespaceID, batch, false, windowEnd)
+	}
+}
+
+func (a *Aggregator) write(ctx context.Context, namespaceID string, batch *usageBatch, retry bool, now time.Time) {
+	err := a.usageRecordManager.AppendUsageRecords(ctx, &persistence.AppendUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		FlushID:     batch.flushID,
+		Records:     batch.records,
+		Retry:       retry,
+	})
+	if err != nil {
+		a.logger.Warn("Failed to write usage records, retrying with the next flush",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.Error(err),
+		)
+		a.unwritten[namespaceID] = batch
+		return
+	}
+	delete(a.unwritten, namespaceID)
+	a.maybeTrim(ctx, namespaceID, now)
+}
+
+// maybeTrim deletes the namespace's usage records which are past retention, at most once per trimInterval.
+func (a *Aggregator) maybeTrim(ctx context.Context, namespaceID string, now time.Time) {
+	retention := a.retention()
+	if retention <= 0 || now.Sub(a.lastTrimTime[namespaceID]) < trimInterval {
+		return
+	}
+	a.lastTrimTime[namespaceID] = now
+
+	err := a.usageRecordManager.TrimUsageRecords(ctx, &persistence.TrimUsageRecordsRequest{
+		NamespaceID: namespaceID,
+		OlderThan:   now.Add(-retention),
+	})
+	if err != nil {
+		a.logger.Warn("Failed to delete expired usage records",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.Error(err),
+		)
+	}
+}
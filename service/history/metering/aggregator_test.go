@@ -0,0 +1,226 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestAggregator(t *testing.T) (*Aggregator, *persistence.MockUsageRecordManager, *clock.EventTimeSource) {
+	ctrl := gomock.NewController(t)
+	usageRecordManager := persistence.NewMockUsageRecordManager(ctrl)
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0).UTC())
+	config := &configs.Config{
+		MeteringFlushInterval:  dynamicconfig.GetDurationPropertyFn(time.Minute),
+		MeteringRetention:      dynamicconfig.GetDurationPropertyFn(0),
+		MeteringMaxCostCenters: dynamicconfig.GetIntPropertyFn(2),
+	}
+	return NewAggregator(config, usageRecordManager, timeSource, log.NewNoopLogger()), usageRecordManager, timeSource
+}
+
+func TestAggregator_Flush(t *testing.T) {
+	t.Parallel()
+
+	aggregator, usageRecordManager, timeSource := newTestAggregator(t)
+	windowStart := timeSource.Now()
+	keyA := Key{NamespaceID: "ns-1", WorkflowType: "a"}
+	keyB := Key{NamespaceID: "ns-2", WorkflowType: "b", CostCenter: "c"}
+	aggregator.Record(keyA, Usage{WorkflowStarts: 1, HistoryEvents: 2, StateTransitions: 1})
+	aggregator.Record(keyA, Usage{Signals: 1, HistoryEvents: 1, StateTransitions: 1, HistoryBytes: 10})
+	aggregator.Record(keyB, Usage{Timers: 2})
+	aggregator.Record(keyB, Usage{})
+	timeSource.Advance(time.Minute)
+	windowEnd := timeSource.Now()
+
+	written := make(map[string][]*persistencespb.UsageRecord)
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendUsageRecordsRequest) error {
+			written[request.NamespaceID] = request.Records
+			return nil
+		}).Times(2)
+	aggregator.flush(context.Background())
+
+	require.Equal(t, map[string][]*persistencespb.UsageRecord{
+		"ns-1": {{
+			NamespaceId:      "ns-1",
+			WorkflowType:     "a",
+			WindowStartTime:  timestamppb.New(windowStart),
+			WindowEndTime:    timestamppb.New(windowEnd),
+			Actions:          2,
+			WorkflowStarts:   1,
+			Signals:          1,
+			HistoryEvents:    3,
+			StateTransitions: 2,
+			HistoryBytes:     10,
+		}},
+		"ns-2": {{
+			NamespaceId:     "ns-2",
+			WorkflowType:    "b",
+			CostCenter:      "c",
+			WindowStartTime: timestamppb.New(windowStart),
+			WindowEndTime:   timestamppb.New(windowEnd),
+			Actions:         2,
+			Timers:          2,
+		}},
+	}, written)
+
+	// Nothing is pending after a successful flush.
+	aggregator.flush(context.Background())
+}
+
+func TestAggregator_FlushFailureIsRetried(t *testing.T) {
+	t.Parallel()
+
+	aggregator, usageRecordManager, timeSource := newTestAggregator(t)
+	windowStart := timeSource.Now()
+	key := Key{NamespaceID: "ns-1", WorkflowType: "a"}
+	aggregator.Record(key, Usage{Signals: 1})
+	timeSource.Advance(time.Minute)
+	windowEnd := timeSource.Now()
+
+	var failed *persistence.AppendUsageRecordsRequest
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendUsageRecordsRequest) error {
+			failed = request
+			return context.DeadlineExceeded
+		})
+	aggregator.flush(context.Background())
+	require.NotEmpty(t, failed.FlushID)
+	require.False(t, failed.Retry)
+
+	// The failed append may have been applied, so it's retried unchanged while the new usage is kept pending.
+	aggregator.Record(key, Usage{Signals: 2})
+	timeSource.Advance(time.Minute)
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), &persistence.AppendUsageRecordsRequest{
+		NamespaceID: "ns-1",
+		FlushID:     failed.FlushID,
+		Records:     failed.Records,
+		Retry:       true,
+	}).Return(nil)
+	aggregator.flush(context.Background())
+	require.Len(t, failed.Records, 1)
+	require.Equal(t, int64(1), failed.Records[0].Signals)
+	require.Equal(t, windowStart, failed.Records[0].WindowStartTime.AsTime())
+	require.Equal(t, windowEnd, failed.Records[0].WindowEndTime.AsTime())
+
+	var written *persistence.AppendUsageRecordsRequest
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendUsageRecordsRequest) error {
+			written = request
+			return nil
+		})
+	aggregator.flush(context.Background())
+	require.NotEqual(t, failed.FlushID, written.FlushID)
+	require.False(t, written.Retry)
+	require.Len(t, written.Records, 1)
+	require.Equal(t, int64(2), written.Records[0].Signals)
+	require.Equal(t, windowEnd, written.Records[0].WindowStartTime.AsTime())
+}
+
+func TestAggregator_LimitsCostCenters(t *testing.T) {
+	t.Parallel()
+
+	aggregator, usageRecordManager, _ := newTestAggregator(t)
+	for _, costCenter := range []string{"a", "b", "c", "a", "d", ""} {
+		aggregator.Record(Key{NamespaceID: "ns-1", CostCenter: costCenter}, Usage{Signals: 1})
+	}
+	aggregator.Record(Key{NamespaceID: "ns-2", CostCenter: "e"}, Usage{Signals: 1})
+
+	signals := make(map[Key]int64)
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendUsageRecordsRequest) error {
+			for _, record := range request.Records {
+				signals[Key{NamespaceID: record.NamespaceId, CostCenter: record.CostCenter}] = record.Signals
+			}
+			return nil
+		}).Times(2)
+	aggregator.flush(context.Background())
+	require.Equal(t, map[Key]int64{
+		{NamespaceID: "ns-1", CostCenter: "a"}:                2,
+		{NamespaceID: "ns-1", CostCenter: "b"}:                1,
+		{NamespaceID: "ns-1", CostCenter: OverflowCostCenter}: 2,
+		{NamespaceID: "ns-1"}:                                 1,
+		{NamespaceID: "ns-2", CostCenter: "e"}:                1,
+	}, signals)
+
+	// The limit applies per flush.
+	aggregator.Record(Key{NamespaceID: "ns-1", CostCenter: "c"}, Usage{Signals: 1})
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendUsageRecordsRequest) error {
+			require.Equal(t, "c", request.Records[0].CostCenter)
+			return nil
+		})
+	aggregator.flush(context.Background())
+}
+
+func TestAggregator_TrimsExpiredRecords(t *testing.T) {
+	t.Parallel()
+
+	aggregator, usageRecordManager, timeSource := newTestAggregator(t)
+	aggregator.retention = dynamicconfig.GetDurationPropertyFn(24 * time.Hour)
+	key := Key{NamespaceID: "ns-1", WorkflowType: "a"}
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	// Records past retention are deleted after a successful write.
+	aggregator.Record(key, Usage{Signals: 1})
+	usageRecordManager.EXPECT().TrimUsageRecords(gomock.Any(), &persistence.TrimUsageRecordsRequest{
+		NamespaceID: "ns-1",
+		OlderThan:   timeSource.Now().Add(-24 * time.Hour),
+	}).Return(nil)
+	aggregator.flush(context.Background())
+
+	// But at most once per trim interval.
+	timeSource.Advance(time.Minute)
+	aggregator.Record(key, Usage{Signals: 1})
+	aggregator.flush(context.Background())
+
+	timeSource.Advance(trimInterval)
+	aggregator.Record(key, Usage{Signals: 1})
+	usageRecordManager.EXPECT().TrimUsageRecords(gomock.Any(), gomock.Any()).Return(nil)
+	aggregator.flush(context.Background())
+}
+
+func TestAggregator_StopFlushesPendingUsage(t *testing.T) {
+	t.Parallel()
+
+	aggregator, usageRecordManager, _ := newTestAggregator(t)
+	aggregator.Start()
+	aggregator.Record(Key{NamespaceID: "ns-1"}, Usage{Timers: 1})
+
+	usageRecordManager.EXPECT().AppendUsageRecords(gomock.Any(), gomock.Any()).Return(nil)
+	aggregator.Stop()
+}
@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"context"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/configs"
+)
+
+type (
+	// executionManager records the usage of every workflow update that was persisted successfully. Updates of
+	// namespaces which aren't active in the current cluster are skipped, so that replicated workflows are only metered
+	// once, in the cluster where they run.
+	executionManager struct {
+		persistence.ExecutionManager
+
+		aggregator        *Aggregator
+		config            *configs.Config
+		namespaceRegistry namespace.Registry
+		saMapperProvider  searchattribute.MapperProvider
+		clusterName       string
+	}
+)
+
+var _ persistence.ExecutionManager = (*executionManager)(nil)
+
+func NewExecutionManager(
+	executionManager persistence.ExecutionManager,
+	aggregator *Aggregator,
+	config *configs.Config,
+	namespaceRegistry namespace.Registry,
+	saMapperProvider searchattribute.MapperProvider,
+	clusterMetadata cluster.Metadata,
+) persistence.ExecutionManager {
+	return newExecutionManager(
+		executionManager,
+		aggregator,
+		config,
+		namespaceRegistry,
+		saMapperProvider,
+		clusterMetadata.GetCurrentClusterName(),
+	)
+}
+
+func newExecutionManager(
+	base persistence.ExecutionManager,
+	aggregator *Aggregator,
+	config *configs.Config,
+	namespaceRegistry namespace.Registry,
+	saMapperProvider searchattribute.MapperProvider,
+	clusterName string,
+) *executionManager {
+	return &executionManager{
+		ExecutionManager:  base,
+		aggregator:        aggregator,
+		config:            config,
+		namespaceRegistry: namespaceRegistry,
+		saMapperProvider:  saMapperProvider,
+		clusterName:       clusterName,
+	}
+}
+
+func (m *executionManager) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+	response, err := m.ExecutionManager.CreateWorkflowExecution(ctx, request)
+	if err != nil || !m.config.MeteringEnabled() {
+		return response, err
+	}
+	m.record(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowEvents, &response.NewMutableStateStats)
+	return response, nil
+}
+
+func (m *executionManager) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	response, err := m.ExecutionManager.UpdateWorkflowExecution(ctx, request)
+	if err != nil || !m.config.MeteringEnabled() {
+		return response, err
+	}
+	m.record(request.UpdateWorkflowMutation.ExecutionInfo, request.UpdateWorkflowEvents, &response.UpdateMutableStateStats)
+	if request.NewWorkflowSnapshot != nil {
+		m.record(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowEvents, response.NewMutableStateStats)
+	}
+	return response, nil
+}
+
+func (m *executionManager) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+	response, err := m.ExecutionManager.ConflictResolveWorkflowExecution(ctx, request)
+	if err != nil || !m.config.MeteringEnabled() {
+		return response, err
+	}
+	m.record(request.ResetWorkflowSnapshot.ExecutionInfo, request.ResetWorkflowEvents, &response.ResetMutableStateStats)
+	if request.NewWorkflowSnapshot != nil {
+		m.record(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowEvents, response.NewMutableStateStats)
+	}
+	if request.CurrentWorkflowMutation != nil {
+		m.record(request.CurrentWorkflowMutation.ExecutionInfo, request.CurrentWorkflowEvents, response.CurrentMutableStateStats)
+	}
+	return response, nil
+}
+
+// record meters a single persisted mutation or snapshot of a workflow together with the events written with it.
+// The execution manager has already added the size of the events to the history size of executionInfo at this
+// point.
+func (m *executionManager) record(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	workflowEvents []*persistence.WorkflowEvents,
+	stats *persistence.MutableStateStatistics,
+) {
+	ns, err := m.namespaceRegistry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	if err != nil || !ns.ActiveInCluster(m.clusterName) {
+		return
+	}
+
+	usage, closeEvent := usageFromEvents(workflowEvents)
+	usage.StateTransitions = 1
+	if stats != nil && stats.HistoryStatistics != nil {
+		usage.HistoryBytes = int64(stats.HistoryStatistics.SizeDiff)
+	}
+	if closeEvent != nil {
+		// Closed workflows are kept until the end of the retention period, charge for storing their final history
+		// size from the start of the workflow until then.
+		retained := closeEvent.GetEventTime().AsTime().Sub(executionInfo.GetStartTime().AsTime()) + ns.Retention()
+		usage.StorageByteSeconds = executionInfo.GetExecutionStats().GetHistorySize() * int64(max(retained, 0)/time.Second)
+	}
+
+	m.aggregator.Record(Key{
+		NamespaceID:  executionInfo.GetNamespaceId(),
+		WorkflowType: executionInfo.GetWorkflowTypeName(),
+		CostCenter:   m.costCenter(ns, executionInfo),
+	}, usage)
+}
+
+// costCenter returns the value of the namespace's cost center search attribute on the workflow.
+func (m *executionManager) costCenter(
+	ns *namespace.Namespace,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) string {
+	alias := m.config.MeteringCostCenterSearchAttribute(ns.Name().String())
+	if alias == "" {
+		return ""
+	}
+	// Search attributes are stored in mutable state by their field name.
+	fieldName := alias
+	if searchattribute.IsMappable(alias) {
+		mapper, err := m.saMapperProvider.GetMapper(ns.Name())
+		if err != nil {
+			return ""
+		}
+		if mapper != nil {
+			if fieldName, err = mapper.GetFieldName(alias, ns.Name().String()); err != nil {
+				return ""
+			}
+		}
+	}
+	value, ok := executionInfo.GetSearchAttributes()[fieldName]
+	if !ok {
+		return ""
+	}
+	var costCenter string
+	if err := payload.Decode(value, &costCenter); err != nil {
+		return ""
+	}
+	return costCenter
+}
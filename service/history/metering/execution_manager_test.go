@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/configs"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespaceID   = "test-namespace-id"
+	testNamespaceName = "test-namespace"
+	testWorkflowType  = "test-workflow-type"
+)
+
+type testExecutionManager struct {
+	*executionManager
+
+	base       *persistence.MockExecutionManager
+	registry   *namespace.MockRegistry
+	mapper     *searchattribute.MockMapper
+	enabled    bool
+	timeSource *clock.EventTimeSource
+}
+
+func newTestExecutionManager(t *testing.T, activeCluster string) *testExecutionManager {
+	ctrl := gomock.NewController(t)
+	m := &testExecutionManager{
+		base:       persistence.NewMockExecutionManager(ctrl),
+		registry:   namespace.NewMockRegistry(ctrl),
+		mapper:     searchattribute.NewMockMapper(ctrl),
+		enabled:    true,
+		timeSource: clock.NewEventTimeSource(),
+	}
+	config := &configs.Config{
+		MeteringEnabled:                   func() bool { return m.enabled },
+		MeteringFlushInterval:             dynamicconfig.GetDurationPropertyFn(time.Minute),
+		MeteringCostCenterSearchAttribute: dynamicconfig.GetStringPropertyFnFilteredByNamespace("CostCenter"),
+		MeteringMaxCostCenters:            dynamicconfig.GetIntPropertyFn(10),
+	}
+	mapperProvider := searchattribute.NewMockMapperProvider(ctrl)
+	mapperProvider.EXPECT().GetMapper(namespace.Name(testNamespaceName)).Return(m.mapper, nil).AnyTimes()
+	m.mapper.EXPECT().GetFieldName("CostCenter", testNamespaceName).Return("Keyword01", nil).AnyTimes()
+	m.registry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespaceName},
+		&persistencespb.NamespaceConfig{Retention: durationpb.New(24 * time.Hour)},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: activeCluster,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+	), nil).AnyTimes()
+
+	aggregator := NewAggregator(config, nil, m.timeSource, log.NewNoopLogger())
+	m.executionManager = newExecutionManager(
+		m.base,
+		aggregator,
+		config,
+		m.registry,
+		mapperProvider,
+		cluster.TestCurrentClusterName,
+	)
+	return m
+}
+
+func (m *testExecutionManager) pending() map[Key]Usage {
+	usage := make(map[Key]Usage)
+	for key, p := range m.aggregator.pending {
+		usage[key] = p.Usage
+	}
+	return usage
+}
+
+func testExecutionInfo(startTime time.Time) *persistencespb.WorkflowExecutionInfo {
+	return &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      testNamespaceID,
+		WorkflowTypeName: testWorkflowType,
+		StartTime:        timestamppb.New(startTime),
+		ExecutionStats:   &persistencespb.ExecutionStats{HistorySize: 1000},
+		SearchAttributes: map[string]*commonpb.Payload{
+			"Keyword01": payload.EncodeString("team-a"),
+		},
+	}
+}
+
+func testEvents(eventTime time.Time, eventTypes ...enumspb.EventType) []*persistence.WorkflowEvents {
+	events := make([]*historypb.HistoryEvent, len(eventTypes))
+	for i, eventType := range eventTypes {
+		events[i] = &historypb.HistoryEvent{EventType: eventType, EventTime: timestamppb.New(eventTime)}
+	}
+	return []*persistence.WorkflowEvents{{NamespaceID: testNamespaceID, Events: events}}
+}
+
+func TestExecutionManager_CreateWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	m := newTestExecutionManager(t, cluster.TestCurrentClusterName)
+	request := &persistence.CreateWorkflowExecutionRequest{
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{ExecutionInfo: testExecutionInfo(time.Now())},
+		NewWorkflowEvents: testEvents(time.Now(),
+			enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		),
+	}
+	m.base.EXPECT().CreateWorkflowExecution(gomock.Any(), request).Return(&persistence.CreateWorkflowExecutionResponse{
+		NewMutableStateStats: persistence.MutableStateStatistics{
+			HistoryStatistics: &persistence.HistoryStatistics{SizeDiff: 300, CountDiff: 2},
+		},
+	}, nil)
+
+	_, err := m.CreateWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, map[Key]Usage{
+		{NamespaceID: testNamespaceID, WorkflowType: testWorkflowType, CostCenter: "team-a"}: {
+			WorkflowStarts:   1,
+			HistoryEvents:    2,
+			StateTransitions: 1,
+			HistoryBytes:     300,
+		},
+	}, m.pending())
+}
+
+func TestExecutionManager_UpdateWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	m := newTestExecutionManager(t, cluster.TestCurrentClusterName)
+	startTime := time.Now().Add(-time.Hour)
+	closeTime := time.Now()
+	events := testEvents(closeTime,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		enumspb.EVENT_TYPE_TIMER_STARTED,
+		enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+	)
+	events[0].Events[2].Attributes = &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{
+		ActivityTaskStartedEventAttributes: &historypb.ActivityTaskStartedEventAttributes{Attempt: 3},
+	}
+	request := &persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.WorkflowMutation{ExecutionInfo: testExecutionInfo(startTime)},
+		UpdateWorkflowEvents:   events,
+	}
+	m.base.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(&persistence.UpdateWorkflowExecutionResponse{
+		UpdateMutableStateStats: persistence.MutableStateStatistics{
+			HistoryStatistics: &persistence.HistoryStatistics{SizeDiff: 500, CountDiff: 4},
+		},
+	}, nil)
+
+	_, err := m.UpdateWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	retained := closeTime.Sub(startTime) + 24*time.Hour
+	require.Equal(t, map[Key]Usage{
+		{NamespaceID: testNamespaceID, WorkflowType: testWorkflowType, CostCenter: "team-a"}: {
+			Signals:            1,
+			Timers:             1,
+			ActivityAttempts:   3,
+			HistoryEvents:      4,
+			StateTransitions:   1,
+			HistoryBytes:       500,
+			StorageByteSeconds: 1000 * int64(retained/time.Second),
+		},
+	}, m.pending())
+}
+
+func TestExecutionManager_SkipsWhenNotMetered(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		activeCluster string
+		enabled       bool
+	}{
+		{name: "Disabled", activeCluster: cluster.TestCurrentClusterName, enabled: false},
+		{name: "StandbyNamespace", activeCluster: cluster.TestAlternativeClusterName, enabled: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := newTestExecutionManager(t, tc.activeCluster)
+			m.enabled = tc.enabled
+			request := &persistence.UpdateWorkflowExecutionRequest{
+				UpdateWorkflowMutation: persistence.WorkflowMutation{ExecutionInfo: testExecutionInfo(time.Now())},
+				UpdateWorkflowEvents:   testEvents(time.Now(), enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED),
+			}
+			m.base.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+
+			_, err := m.UpdateWorkflowExecution(context.Background(), request)
+			require.NoError(t, err)
+			require.Empty(t, m.pending())
+		})
+	}
+}
+
+func TestExecutionManager_SkipsFailedWrites(t *testing.T) {
+	t.Parallel()
+
+	m := newTestExecutionManager(t, cluster.TestCurrentClusterName)
+	request := &persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.WorkflowMutation{ExecutionInfo: testExecutionInfo(time.Now())},
+		UpdateWorkflowEvents:   testEvents(time.Now(), enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED),
+	}
+	m.base.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(nil, &persistence.ConditionFailedError{})
+
+	_, err := m.UpdateWorkflowExecution(context.Background(), request)
+	require.Error(t, err)
+	require.Empty(t, m.pending())
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Key identifies the dimensions usage is aggregated by.
+	Key struct {
+		NamespaceID  string
+		WorkflowType string
+		CostCenter   string
+	}
+
+	// Usage is the resource usage of one or more workflow updates.
+	Usage struct {
+		WorkflowStarts     int64
+		Signals            int64
+		ActivityAttempts   int64
+		Timers             int64
+		HistoryEvents      int64
+		StateTransitions   int64
+		HistoryBytes       int64
+		StorageByteSeconds int64
+	}
+)
+
+// Actions returns the number of billable actions, i.e. workflow starts, signals, activity attempts and timers.
+func (u Usage) Actions() int64 {
+	return u.WorkflowStarts + u.Signals + u.ActivityAttempts + u.Timers
+}
+
+// Add adds other to u.
+func (u *Usage) Add(other Usage) {
+	u.WorkflowStarts += other.WorkflowStarts
+	u.Signals += other.Signals
+	u.ActivityAttempts += other.ActivityAttempts
+	u.Timers += other.Timers
+	u.HistoryEvents += other.HistoryEvents
+	u.StateTransitions += other.StateTransitions
+	u.HistoryBytes += other.HistoryBytes
+	u.StorageByteSeconds += other.StorageByteSeconds
+}
+
+// IsZero returns true if u doesn't contain any usage.
+func (u Usage) IsZero() bool {
+	return u == Usage{}
+}
+
+func (u Usage) toRecord(key Key, windowStart time.Time, windowEnd time.Time) *persistencespb.UsageRecord {
+	return &persistencespb.UsageRecord{
+		NamespaceId:        key.NamespaceID,
+		WorkflowType:       key.WorkflowType,
+		CostCenter:         key.CostCenter,
+		WindowStartTime:    timestamppb.New(windowStart),
+		WindowEndTime:      timestamppb.New(windowEnd),
+		Actions:            u.Actions(),
+		WorkflowStarts:     u.WorkflowStarts,
+		Signals:            u.Signals,
+		ActivityAttempts:   u.ActivityAttempts,
+		Timers:             u.Timers,
+		HistoryEvents:      u.HistoryEvents,
+		StateTransitions:   u.StateTransitions,
+		HistoryBytes:       u.HistoryBytes,
+		StorageByteSeconds: u.StorageByteSeconds,
+	}
+}
+
+// usageFromEvents counts the actions and events in the given batches of history events. It also returns the close
+// event of the workflow if one of the batches contains it.
+func usageFromEvents(workflowEvents []*persistence.WorkflowEvents) (Usage, *historypb.HistoryEvent) {
+	var usage Usage
+	var closeEvent *historypb.HistoryEvent
+	for _, batch := range workflowEvents {
+		if batch == nil {
+			continue
+		}
+		for _, event := range batch.Events {
+			usage.HistoryEvents++
+			switch event.GetEventType() {
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+				usage.WorkflowStarts++
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+				usage.Signals++
+			case enumspb.EVENT_TYPE_TIMER_STARTED:
+				usage.Timers++
+			case enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+				// Retried attempts don't write events of their own, the started event of the last attempt records
+				// how many attempts were made.
+				usage.ActivityAttempts += int64(max(event.GetActivityTaskStartedEventAttributes().GetAttempt(), 1))
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+				closeEvent = event
+			}
+		}
+	}
+	return usage, closeEvent
+}
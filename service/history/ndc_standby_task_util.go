@@ -25,6 +25,7 @@
 package history
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"time"
@@ -44,6 +45,7 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -157,6 +159,9 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
+		activityType                       *commonpb.ActivityType
+		notBeforeTime                      *timestamppb.Timestamp
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -168,6 +173,8 @@ type (
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
+		workflowType                       *commonpb.WorkflowType
 	}
 )
 
@@ -190,9 +197,27 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        activityFairnessKey(mutableState, activityInfo),
+		activityType:                       activityInfo.GetActivityType(),
+		notBeforeTime:                      activityNotBeforeTime(activityInfo, time.Now()),
 	}, nil
 }
 
+// activityFairnessKey returns the fairness key of the activity's tasks: its own if set at schedule
+// time, otherwise the workflow's.
+func activityFairnessKey(mutableState historyi.MutableState, activityInfo *persistencespb.ActivityInfo) string {
+	return cmp.Or(activityInfo.GetFairnessKey(), mutableState.GetExecutionInfo().GetFairnessKey())
+}
+
+// activityNotBeforeTime returns the time before which matching must not dispatch the activity: its
+// scheduled time if that's after now, which is the case for retries delayed in matching.
+func activityNotBeforeTime(activityInfo *persistencespb.ActivityInfo, now time.Time) *timestamppb.Timestamp {
+	if scheduledTime := activityInfo.GetScheduledTime(); scheduledTime != nil && scheduledTime.AsTime().After(now) {
+		return scheduledTime
+	}
+	return nil
+}
+
 func newActivityRetryTimePostActionInfo(
 	mutableState historyi.MutableState,
 	taskQueue string,
@@ -207,6 +232,8 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        activityFairnessKey(mutableState, activityInfo),
+		activityType:                       activityInfo.GetActivityType(),
 	}, nil
 }
 
@@ -223,6 +250,8 @@ func newWorkflowTaskPostActionInfo(
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        mutableState.GetExecutionInfo().FairnessKey,
+		workflowType:                       &commonpb.WorkflowType{Name: mutableState.GetExecutionInfo().WorkflowTypeName},
 	}, nil
 }
 
@@ -34,6 +34,7 @@ import (
 	"math"
 	"regexp"
 	"runtime/debug"
+	"strconv"
 	"sync"
 	"time"
 
@@ -52,6 +53,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/pprof"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/util"
@@ -167,6 +169,7 @@ type (
 		logger            log.Logger
 		metricsHandler    metrics.Handler
 		tracer            trace.Tracer
+		spanContext       trace.SpanContext // span of the latest attempt, to correlate its logs
 		dlqWriter         *DLQWriter
 
 		readerID                   int64
@@ -282,24 +285,42 @@ func (e *executableImpl) Execute() (retErr error) {
 		metrics.AddMetricsContext(context.Background()),
 		callerInfo,
 	)
+	e.spanContext = trace.SpanContext{}
 	e.Unlock()
 
 	// Wrapped in if block to avoid unnecessary allocations when OTEL is disabled.
 	if telemetry.IsEnabled(e.tracer) {
-		var span trace.Span
-		ctx, span = e.tracer.Start(
-			ctx,
-			fmt.Sprintf("queue.Execute/%v", e.GetType().String()),
+		spanOpts := []trace.SpanStartOption{
 			trace.WithSpanKind(trace.SpanKindConsumer),
 			trace.WithAttributes(
+				attribute.Key(telemetry.NamespaceKey).String(ns.String()),
 				attribute.Key(telemetry.WorkflowIDKey).String(e.GetWorkflowID()),
 				attribute.Key(telemetry.WorkflowRunIDKey).String(e.GetRunID()),
 				attribute.Key("queue.task.type").String(e.GetType().String()),
-				attribute.Key("queue.task.id").Int64(e.GetTaskID())))
+				attribute.Key("queue.task.id").Int64(e.GetTaskID())),
+		}
+		if task, ok := e.GetTask().(tasks.HasTraceContext); ok {
+			// Immediate tasks continue the trace they were generated in. Scheduled tasks may fire long after
+			// that trace ended, so they start a new trace linked to it instead.
+			if e.GetCategory().Type() == tasks.CategoryTypeScheduled {
+				if sc := telemetry.ExtractTraceContext(task.GetTraceContext()); sc.IsValid() {
+					spanOpts = append(spanOpts, trace.WithLinks(trace.Link{SpanContext: sc}))
+				}
+			} else {
+				ctx = telemetry.ContinueTrace(ctx, task.GetTraceContext())
+			}
+		}
+
+		var span trace.Span
+		ctx, span = e.tracer.Start(
+			ctx,
+			fmt.Sprintf("queue.Execute/%v", e.GetType().String()),
+			spanOpts...)
+		e.spanContext = span.SpanContext()
 
 		if telemetry.DebugMode() {
 			if taskPayload, err := json.Marshal(e.GetTask()); err != nil {
-				e.logger.Error("failed to serialize task payload for OTEL span", tag.Error(err))
+				e.attemptLogger().Error("failed to serialize task payload for OTEL span", tag.Error(err))
 			} else {
 				span.SetAttributes(attribute.Key("queue.task.payload").String(string(taskPayload)))
 			}
@@ -320,7 +341,7 @@ func (e *executableImpl) Execute() (retErr error) {
 				err = serviceerror.NewInternal(fmt.Sprintf("panic: %v", panicObj))
 			}
 
-			e.logger.Error("Panic is captured", tag.SysStackTrace(string(debug.Stack())), tag.Error(err))
+			e.attemptLogger().Error("Panic is captured", tag.SysStackTrace(string(debug.Stack())), tag.Error(err))
 			retErr = err
 
 			// we need to guess the metrics tags here as we don't know which execution logic
@@ -361,17 +382,25 @@ func (e *executableImpl) Execute() (retErr error) {
 			return e.writeToDLQ(ctx)
 		}
 		if errors.As(e.terminalFailureCause, new(MaybeTerminalTaskError)) {
-			e.logger.Warn(
+			e.attemptLogger().Warn(
 				"Dropping task with terminal failure because DLQ was disabled",
 				tag.Error(e.terminalFailureCause),
 			)
 			return nil
 		}
-		e.logger.Info("Retrying task with non-terminal DLQ failure because DLQ was disabled", tag.Error(e.terminalFailureCause))
+		e.attemptLogger().Info("Retrying task with non-terminal DLQ failure because DLQ was disabled", tag.Error(e.terminalFailureCause))
 		e.terminalFailureCause = nil
 	}
 
-	resp := e.executor.Execute(ctx, e)
+	var resp ExecuteResponse
+	pprof.Do(ctx, func() []string {
+		return []string{
+			pprof.LabelNamespace, ns.String(),
+			pprof.LabelShardID, strconv.Itoa(e.shardID()),
+		}
+	}, func(ctx context.Context) {
+		resp = e.executor.Execute(ctx, e)
+	})
 	e.metricsHandler = e.metricsHandler.WithTags(resp.ExecutionMetricTags...)
 
 	if resp.ExecutedAsActive != e.lastActiveness {
@@ -384,22 +413,32 @@ func (e *executableImpl) Execute() (retErr error) {
 	return resp.ExecutionErr
 }
 
-func (e *executableImpl) writeToDLQ(ctx context.Context) error {
+// attemptLogger returns the task logger with the trace context of the latest attempt.
+func (e *executableImpl) attemptLogger() log.Logger {
+	return log.With(e.logger, tag.TraceContext(trace.ContextWithSpanContext(context.Background(), e.spanContext)))
+}
 
+func (e *executableImpl) shardID() int {
 	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
 	numShards := e.clusterMetadata.GetAllClusterInfo()[currentClusterName].ShardCount
+	return tasks.GetShardIDForTask(e.Task, int(numShards))
+}
+
+func (e *executableImpl) writeToDLQ(ctx context.Context) error {
+
+	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
 
 	start := e.timeSource.Now()
 	err := e.dlqWriter.WriteTaskToDLQ(
 		ctx,
 		currentClusterName,
 		currentClusterName,
-		tasks.GetShardIDForTask(e.Task, int(numShards)),
+		e.shardID(),
 		e.GetTask(),
 	)
 	if err != nil {
 		metrics.TaskDLQFailures.With(e.metricsHandler).Record(1)
-		e.logger.Error("Failed to write task to DLQ", tag.Error(err))
+		e.attemptLogger().Error("Failed to write task to DLQ", tag.Error(err))
 	}
 	metrics.TaskDLQSendLatency.With(e.metricsHandler).Record(e.timeSource.Now().Sub(start))
 	return err
@@ -420,7 +459,7 @@ func (e *executableImpl) isSafeToDropError(err error) bool {
 		// Even though ErrStaleReference is castable to serviceerror.NotFound, we give this error special treatment
 		// because we're interested in the metric.
 		metrics.TaskSkipped.With(e.metricsHandler).Record(1)
-		e.logger.Info("Skipped task due to stale reference", tag.Error(err))
+		e.attemptLogger().Info("Skipped task due to stale reference", tag.Error(err))
 		return true
 	}
 
@@ -552,7 +591,7 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 	// Unexpected errors handled below
 	e.unexpectedErrorAttempts++
 	metrics.TaskFailures.With(e.metricsHandler).Record(1)
-	logger := log.With(e.logger,
+	logger := log.With(e.attemptLogger(),
 		tag.Error(err),
 		tag.ErrorType(err),
 		tag.Attempt(int32(attempt)),
@@ -572,19 +611,19 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 		metrics.TaskCorruptionCounter.With(e.metricsHandler).Record(1)
 		if e.dlqEnabled() {
 			// Keep this message in sync with the log line mentioned in Investigation section of docs/admin/dlq.md
-			e.logger.Error("Marking task as terminally failed, will send to DLQ", tag.Error(err), tag.ErrorType(err))
+			e.attemptLogger().Error("Marking task as terminally failed, will send to DLQ", tag.Error(err), tag.ErrorType(err))
 			e.terminalFailureCause = err // <- Execute() examines this attribute on the next attempt.
 			metrics.TaskTerminalFailures.With(e.metricsHandler).Record(1)
 			return fmt.Errorf("%w: %v", ErrTerminalTaskFailure, err)
 		}
-		e.logger.Error("Dropping task due to terminal error", tag.Error(err), tag.ErrorType(err))
+		e.attemptLogger().Error("Dropping task due to terminal error", tag.Error(err), tag.ErrorType(err))
 		return nil
 	}
 
 	// Unexpected but retryable error
 	if e.unexpectedErrorAttempts >= e.maxUnexpectedErrorAttempts() && e.dlqEnabled() {
 		// Keep this message in sync with the log line mentioned in Investigation section of docs/admin/dlq.md
-		e.logger.Error("Marking task as terminally failed, will send to DLQ. Maximum number of attempts with unexpected errors",
+		e.attemptLogger().Error("Marking task as terminally failed, will send to DLQ. Maximum number of attempts with unexpected errors",
 			tag.UnexpectedErrorAttempts(int32(e.unexpectedErrorAttempts)), tag.Error(err))
 		e.terminalFailureCause = err // <- Execute() examines this attribute on the next attempt.
 		metrics.TaskTerminalFailures.With(e.metricsHandler).Record(1)
@@ -600,14 +639,14 @@ func (e *executableImpl) matchDLQErrorPattern(err error) error {
 	}
 	match, mErr := regexp.MatchString(e.dlqErrorPattern(), err.Error())
 	if mErr != nil {
-		e.logger.Error(fmt.Sprintf("Failed to match task processing error with %s", dynamicconfig.HistoryTaskDLQErrorPattern.Key()))
+		e.attemptLogger().Error(fmt.Sprintf("Failed to match task processing error with %s", dynamicconfig.HistoryTaskDLQErrorPattern.Key()))
 		return nil
 	}
 	if !match {
 		return nil
 	}
 
-	e.logger.Error(
+	e.attemptLogger().Error(
 		fmt.Sprintf("Error matches with %s. Marking task as terminally failed, will send to DLQ",
 			dynamicconfig.HistoryTaskDLQErrorPattern.Key()),
 		tag.Error(err),
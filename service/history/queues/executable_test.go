@@ -34,6 +34,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	otelsdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/clock"
@@ -42,6 +45,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
@@ -55,6 +59,9 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 type (
@@ -79,6 +86,8 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		logger                     log.Logger
+		tracer                     trace.Tracer
 	}
 	option func(*params)
 )
@@ -765,6 +774,28 @@ func (s *executableSuite) TestHandleErr_RandomErr() {
 	s.Error(executable.HandleErr(errors.New("random error")))
 }
 
+func (s *executableSuite) TestHandleErr_LogsTraceContext() {
+	core, logs := observer.New(zapcore.InfoLevel)
+	spanRecorder := tracetest.NewSpanRecorder()
+	executable := s.newTestExecutable(func(p *params) {
+		p.logger = log.NewZapLogger(zap.New(core))
+		p.tracer = otelsdktrace.NewTracerProvider(otelsdktrace.WithSpanProcessor(spanRecorder)).Tracer("test")
+	})
+
+	execErr := errors.New("some random error")
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionErr: execErr,
+	})
+	s.Equal(execErr, executable.HandleErr(executable.Execute()))
+
+	spans := spanRecorder.Ended()
+	s.Len(spans, 1)
+	entries := logs.FilterMessage("Fail to process task").All()
+	s.Len(entries, 1)
+	s.Equal(spans[0].SpanContext().TraceID().String(), entries[0].ContextMap()[tag.TraceIDKey])
+	s.Equal(spans[0].SpanContext().SpanID().String(), entries[0].ContextMap()[tag.SpanIDKey])
+}
+
 func (s *executableSuite) TestTaskAck() {
 	executable := s.newTestExecutable()
 
@@ -1082,6 +1113,8 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 		dlqErrorPattern: func() string {
 			return ""
 		},
+		logger: log.NewTestLogger(),
+		tracer: telemetry.NoopTracer,
 	}
 	for _, opt := range opts {
 		opt(&p)
@@ -1104,9 +1137,9 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 		s.timeSource,
 		s.mockNamespaceRegistry,
 		s.mockClusterMetadata,
-		log.NewTestLogger(),
+		p.logger,
 		s.metricsHandler,
-		telemetry.NoopTracer,
+		p.tracer,
 		func(params *queues.ExecutableParams) {
 			params.DLQEnabled = p.dlqEnabled
 			params.DLQWriter = p.dlqWriter
@@ -146,7 +146,7 @@ func (e *ExecutableActivityStateTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableActivityStateTask) Execute() error {
+func (e *ExecutableActivityStateTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -173,6 +173,8 @@ func (e *ExecutableActivityStateTask) Execute() error {
 	}
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 
 	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
 		namespace.ID(e.NamespaceID),
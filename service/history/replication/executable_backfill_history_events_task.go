@@ -93,7 +93,7 @@ func (e *ExecutableBackfillHistoryEventsTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableBackfillHistoryEventsTask) Execute() error {
+func (e *ExecutableBackfillHistoryEventsTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -121,6 +121,8 @@ func (e *ExecutableBackfillHistoryEventsTask) Execute() error {
 
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 
 	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
 		namespace.ID(e.NamespaceID),
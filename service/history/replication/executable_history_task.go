@@ -120,7 +120,7 @@ func (e *ExecutableHistoryTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableHistoryTask) Execute() error {
+func (e *ExecutableHistoryTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -146,6 +146,8 @@ func (e *ExecutableHistoryTask) Execute() error {
 	}
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 
 	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
 		namespace.ID(e.NamespaceID),
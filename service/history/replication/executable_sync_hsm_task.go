@@ -96,7 +96,7 @@ func (e *ExecutableSyncHSMTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableSyncHSMTask) Execute() error {
+func (e *ExecutableSyncHSMTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -123,6 +123,8 @@ func (e *ExecutableSyncHSMTask) Execute() error {
 	}
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 
 	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
 		namespace.ID(e.NamespaceID),
@@ -88,7 +88,7 @@ func (e *ExecutableSyncVersionedTransitionTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableSyncVersionedTransitionTask) Execute() error {
+func (e *ExecutableSyncVersionedTransitionTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -116,6 +116,8 @@ func (e *ExecutableSyncVersionedTransitionTask) Execute() error {
 
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
 		namespace.ID(e.NamespaceID),
 		e.WorkflowID,
@@ -31,6 +31,9 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	otelnoop "go.opentelemetry.io/otel/trace/noop"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -51,6 +54,7 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
@@ -842,3 +846,37 @@ func newTaskContext(
 	ctx = headers.SetCallerName(ctx, namespaceName)
 	return context.WithTimeout(ctx, timeout)
 }
+
+// startApplySpan starts a span for applying the replication task. The span continues the trace the task was
+// generated in on the source cluster, if the source cluster sent one.
+func startApplySpan(
+	ctx context.Context,
+	tracerProvider trace.TracerProvider,
+	task ExecutableTask,
+	namespaceName string,
+	workflowKey definition.WorkflowKey,
+) (context.Context, trace.Span) {
+	if tracerProvider == nil {
+		return ctx, otelnoop.Span{}
+	}
+	tracer := tracerProvider.Tracer(telemetry.ComponentReplicationApply)
+	if !telemetry.IsEnabled(tracer) {
+		return ctx, otelnoop.Span{}
+	}
+	replicationTask := task.ReplicationTask()
+	return tracer.Start(
+		telemetry.ContinueTrace(ctx, replicationTask.GetTraceContext()),
+		fmt.Sprintf("replication.Apply/%v", replicationTask.GetTaskType().String()),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.Key(telemetry.NamespaceKey).String(namespaceName),
+			attribute.Key(telemetry.WorkflowIDKey).String(workflowKey.WorkflowID),
+			attribute.Key(telemetry.WorkflowRunIDKey).String(workflowKey.RunID)))
+}
+
+func endApplySpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
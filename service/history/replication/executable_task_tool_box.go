@@ -25,6 +25,7 @@
 package replication
 
 import (
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -62,5 +63,6 @@ type (
 		HistoryEventsHandler     eventhandler.HistoryEventsHandler
 		WorkflowCache            wcache.Cache
 		RemoteHistoryFetcher     eventhandler.HistoryPaginatedFetcher
+		TracerProvider           trace.TracerProvider `optional:"true"`
 	}
 )
@@ -94,7 +94,7 @@ func (e *ExecutableVerifyVersionedTransitionTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableVerifyVersionedTransitionTask) Execute() error {
+func (e *ExecutableVerifyVersionedTransitionTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -122,6 +122,8 @@ func (e *ExecutableVerifyVersionedTransitionTask) Execute() error {
 
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 
 	ms, err := e.getMutableState(ctx, e.RunID)
 	if err != nil {
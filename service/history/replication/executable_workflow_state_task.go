@@ -99,7 +99,7 @@ func (e *ExecutableWorkflowStateTask) QueueID() interface{} {
 	return e.WorkflowKey
 }
 
-func (e *ExecutableWorkflowStateTask) Execute() error {
+func (e *ExecutableWorkflowStateTask) Execute() (retErr error) {
 	if e.TerminalState() {
 		return nil
 	}
@@ -126,6 +126,8 @@ func (e *ExecutableWorkflowStateTask) Execute() error {
 	}
 	ctx, cancel := newTaskContext(namespaceName, e.Config.ReplicationTaskApplyTimeout())
 	defer cancel()
+	ctx, span := startApplySpan(ctx, e.TracerProvider, e.ExecutableTask, namespaceName, e.WorkflowKey)
+	defer func() { endApplySpan(span, retErr) }()
 
 	shardContext, err := e.ShardController.GetShardByNamespaceWorkflow(
 		namespace.ID(e.NamespaceID),
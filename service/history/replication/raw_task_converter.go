@@ -127,6 +127,7 @@ func (c *SourceTaskConverterImpl) Convert(
 			return nil, err
 		}
 		replicationTask.RawTaskInfo = rawTaskInfo
+		replicationTask.TraceContext = rawTaskInfo.GetTraceContext()
 	}
 	return replicationTask, nil
 }
@@ -70,6 +70,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -627,6 +628,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 		return nil, err
 	}
 
+	setTaskTraceContext(ctx, request.NewWorkflowSnapshot.Tasks)
 	requestCompletionFn, err := s.taskKeyManager.setAndTrackTaskKeys(
 		request.NewWorkflowSnapshot.Tasks,
 	)
@@ -690,6 +692,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	if request.NewWorkflowSnapshot != nil {
 		taskMaps = append(taskMaps, request.NewWorkflowSnapshot.Tasks)
 	}
+	setTaskTraceContext(ctx, taskMaps...)
 	requestCompletionFn, err := s.taskKeyManager.setAndTrackTaskKeys(taskMaps...)
 	if err != nil {
 		s.wUnlock()
@@ -771,6 +774,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 		taskMaps = append(taskMaps, request.NewWorkflowSnapshot.Tasks)
 	}
 
+	setTaskTraceContext(ctx, taskMaps...)
 	requestCompletionFn, err := s.taskKeyManager.setAndTrackTaskKeys(taskMaps...)
 	if err != nil {
 		s.wUnlock()
@@ -824,6 +828,7 @@ func (s *ContextImpl) SetWorkflowExecution(
 		return nil, err
 	}
 
+	setTaskTraceContext(ctx, request.SetWorkflowSnapshot.Tasks)
 	snapShotRequestCompletionFn, err := s.taskKeyManager.setAndTrackTaskKeys(
 		request.SetWorkflowSnapshot.Tasks,
 	)
@@ -906,6 +911,7 @@ func (s *ContextImpl) addTasksSemaphoreAcquired(
 		return err
 	}
 
+	setTaskTraceContext(ctx, request.Tasks)
 	requestCompletionFn, err := s.taskKeyManager.setAndTrackTaskKeys(
 		request.Tasks,
 	)
@@ -2328,6 +2334,27 @@ func trimShardInfo(
 	return shardInfo
 }
 
+// setTaskTraceContext records the trace of ctx in tasks that don't have a trace context yet so that it's continued
+// when the tasks are processed.
+func setTaskTraceContext(
+	ctx context.Context,
+	taskMaps ...map[tasks.Category][]tasks.Task,
+) {
+	traceContext := telemetry.InjectTraceContext(ctx)
+	if traceContext == nil {
+		return
+	}
+	for _, taskMap := range taskMaps {
+		for _, categoryTasks := range taskMap {
+			for _, task := range categoryTasks {
+				if task, ok := task.(tasks.HasTraceContext); ok && task.GetTraceContext() == nil {
+					task.SetTraceContext(traceContext)
+				}
+			}
+		}
+	}
+}
+
 func clusterNameInfoFromClusterID(
 	allClusterInfo map[string]cluster.ClusterInformation,
 	clusterID int64,
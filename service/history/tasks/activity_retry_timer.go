@@ -36,6 +36,7 @@ var _ Task = (*ActivityRetryTimerTask)(nil)
 type (
 	ActivityRetryTimerTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		EventID             int64
@@ -36,6 +36,7 @@ var _ Task = (*ActivityTask)(nil)
 type (
 	ActivityTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		TaskQueue           string
@@ -37,6 +37,7 @@ var _ Task = (*ActivityTimeoutTask)(nil)
 type (
 	ActivityTimeoutTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		TimeoutType         enumspb.TimeoutType
@@ -36,6 +36,7 @@ var _ Task = (*StartChildExecutionTask)(nil)
 type (
 	StartChildExecutionTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		TargetNamespaceID   string
@@ -36,6 +36,7 @@ var _ Task = (*CloseExecutionTask)(nil)
 type (
 	CloseExecutionTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Version             int64
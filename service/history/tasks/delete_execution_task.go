@@ -36,6 +36,7 @@ var _ Task = (*DeleteExecutionTask)(nil)
 type (
 	DeleteExecutionTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 
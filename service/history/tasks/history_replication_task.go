@@ -36,6 +36,7 @@ var _ Task = (*HistoryReplicationTask)(nil)
 type (
 	HistoryReplicationTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		FirstEventID        int64
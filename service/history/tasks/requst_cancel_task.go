@@ -36,6 +36,7 @@ var _ Task = (*CancelExecutionTask)(nil)
 type (
 	CancelExecutionTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp     time.Time
 		TaskID                  int64
 		TargetNamespaceID       string
@@ -36,6 +36,7 @@ var _ Task = (*SignalExecutionTask)(nil)
 type (
 	SignalExecutionTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp     time.Time
 		TaskID                  int64
 		TargetNamespaceID       string
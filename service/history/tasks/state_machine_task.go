@@ -89,6 +89,7 @@ var _ HasDestination = &StateMachineOutboundTask{}
 // StateMachineCallbackTask is a generic timer task that can be emitted by any hierarchical state machine.
 type StateMachineTimerTask struct {
 	definition.WorkflowKey
+	TraceContext
 	VisibilityTimestamp time.Time
 	TaskID              int64
 	Version             int64
@@ -34,6 +34,7 @@ var _ Task = (*SyncHSMTask)(nil)
 type (
 	SyncHSMTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 	}
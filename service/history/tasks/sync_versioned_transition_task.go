@@ -37,6 +37,7 @@ var _ Task = (*SyncVersionedTransitionTask)(nil)
 type (
 	SyncVersionedTransitionTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Priority            enumsspb.TaskPriority
@@ -36,6 +36,7 @@ var _ Task = (*SyncWorkflowStateTask)(nil)
 type (
 	SyncWorkflowStateTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		// TODO: validate this version in source task converter
@@ -0,0 +1,48 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+type (
+	// HasTraceContext is implemented by tasks which continue the trace they were generated in when they are
+	// processed.
+	HasTraceContext interface {
+		GetTraceContext() map[string]string
+		SetTraceContext(traceContext map[string]string)
+	}
+
+	// TraceContext holds the context of the trace a task was generated in, as encoded by
+	// telemetry.InjectTraceContext. Tasks embed it to implement HasTraceContext.
+	TraceContext struct {
+		Carrier map[string]string
+	}
+)
+
+func (t *TraceContext) GetTraceContext() map[string]string {
+	return t.Carrier
+}
+
+func (t *TraceContext) SetTraceContext(traceContext map[string]string) {
+	t.Carrier = traceContext
+}
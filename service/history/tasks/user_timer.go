@@ -36,6 +36,7 @@ var _ Task = (*UserTimerTask)(nil)
 type (
 	UserTimerTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		EventID             int64
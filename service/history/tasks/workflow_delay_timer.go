@@ -36,6 +36,7 @@ var _ Task = (*WorkflowBackoffTimerTask)(nil)
 type (
 	WorkflowBackoffTimerTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		// TODO: this is not used right now, but we should check it
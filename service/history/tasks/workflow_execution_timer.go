@@ -41,6 +41,7 @@ type (
 
 		VisibilityTimestamp time.Time
 		TaskID              int64
+		TraceContext
 
 		// Check the comment in timerQueueTaskExecutorBase.isValidExecutionTimeoutTask()
 		// for why version is not needed here
@@ -36,6 +36,7 @@ var _ Task = (*WorkflowRunTimeoutTask)(nil)
 type (
 	WorkflowRunTimeoutTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		Version             int64
@@ -36,6 +36,7 @@ var _ Task = (*WorkflowTask)(nil)
 type (
 	WorkflowTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		TaskQueue           string
@@ -46,6 +46,7 @@ var _ Task = (*WorkflowTaskTimeoutTask)(nil)
 type (
 	WorkflowTaskTimeoutTask struct {
 		definition.WorkflowKey
+		TraceContext
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		EventID             int64
@@ -218,13 +218,16 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
+	fairnessKey := activityFairnessKey(mutableState, ai)
+	activityType := ai.GetActivityType()
+	notBeforeTime := activityNotBeforeTime(ai, t.shardContext.GetTimeSource().Now())
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, fairnessKey, activityType, notBeforeTime, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -267,6 +270,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
+	fairnessKey := mutableState.GetExecutionInfo().FairnessKey
+	workflowType := &commonpb.WorkflowType{Name: mutableState.GetExecutionInfo().WorkflowTypeName}
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
@@ -280,6 +285,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		scheduleToStartTimeout.AsDuration(),
 		directive,
 		priority,
+		fairnessKey,
+		workflowType,
 		historyi.TransactionPolicyActive,
 	)
 
@@ -303,6 +310,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 			scheduleToStartTimeout.AsDuration(),
 			directive,
 			priority,
+			fairnessKey,
+			workflowType,
 			historyi.TransactionPolicyActive,
 		)
 	}
@@ -2641,6 +2641,7 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddActivityTaskRequest(
 		Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), task.TaskID),
 		VersionDirective:       worker_versioning.MakeUseAssignmentRulesDirective(),
 		Stamp:                  ai.Stamp,
+		ActivityType:           ai.ActivityType,
 	}
 }
 
@@ -2806,6 +2807,7 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddWorkflowTaskRequest(
 		ScheduleToStartTimeout: timeout,
 		Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), task.TaskID),
 		VersionDirective:       directive,
+		WorkflowType:           &commonpb.WorkflowType{Name: executionInfo.WorkflowTypeName},
 	})
 }
 
@@ -530,6 +530,9 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
+		pushActivityInfo.activityType,
+		pushActivityInfo.notBeforeTime,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -552,6 +555,8 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
 		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
+		pushwtInfo.workflowType,
 		historyi.TransactionPolicyPassive,
 	)
 }
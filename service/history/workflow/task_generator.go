@@ -563,6 +563,22 @@ func (r *TaskGeneratorImpl) GenerateActivityTasks(
 }
 
 func (r *TaskGeneratorImpl) GenerateActivityRetryTasks(activityInfo *persistencespb.ActivityInfo) error {
+	// Short enough backoffs are left to matching: the activity task is dispatched right away and held in
+	// the matching backlog until its scheduled time, so that retries don't each need a history timer.
+	namespaceName := r.mutableState.GetNamespaceEntry().Name().String()
+	backoff := time.Until(activityInfo.GetScheduledTime().AsTime())
+	if backoff > 0 && backoff <= r.config.ActivityRetryDispatchDelayMax(namespaceName) {
+		r.mutableState.AddTasks(&tasks.ActivityTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey:      r.mutableState.GetWorkflowKey(),
+			TaskQueue:        activityInfo.TaskQueue,
+			ScheduledEventID: activityInfo.ScheduledEventId,
+			Version:          activityInfo.Version,
+			Stamp:            activityInfo.Stamp,
+		})
+		return nil
+	}
+
 	r.mutableState.AddTasks(&tasks.ActivityRetryTimerTask{
 		// TaskID is set by shard
 		WorkflowKey:         r.mutableState.GetWorkflowKey(),
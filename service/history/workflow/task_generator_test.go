@@ -921,3 +921,59 @@ func TestTaskGeneratorImpl_GenerateDirtySubStateMachineTasks_TrimsTimersForDelet
 	require.Empty(t, genTasks)
 	require.Empty(t, ms.GetExecutionInfo().StateMachineTimers) // Timer should be trimmed
 }
+
+func TestTaskGeneratorImpl_GenerateActivityRetryTasks(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		backoff          time.Duration
+		delayMax         time.Duration
+		expectedTaskType enumsspb.TaskType
+	}{
+		{
+			name:             "delay in matching disabled",
+			backoff:          time.Second,
+			expectedTaskType: enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER,
+		},
+		{
+			name:             "backoff within delay in matching",
+			backoff:          time.Second,
+			delayMax:         time.Minute,
+			expectedTaskType: enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+		},
+		{
+			name:             "backoff exceeds delay in matching",
+			backoff:          time.Hour,
+			delayMax:         time.Minute,
+			expectedTaskType: enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mutableState := historyi.NewMockMutableState(ctrl)
+			mutableState.EXPECT().GetNamespaceEntry().Return(tests.LocalNamespaceEntry).AnyTimes()
+			mutableState.EXPECT().GetWorkflowKey().Return(tests.WorkflowKey).AnyTimes()
+			var allTasks []tasks.Task
+			mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
+				allTasks = append(allTasks, ts...)
+			}).AnyTimes()
+			cfg := &configs.Config{
+				ActivityRetryDispatchDelayMax: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(tc.delayMax),
+			}
+
+			taskGenerator := NewTaskGenerator(namespace.NewMockRegistry(ctrl), mutableState, cfg, archiver.NewMockArchivalMetadata(ctrl))
+			require.NoError(t, taskGenerator.GenerateActivityRetryTasks(&persistencespb.ActivityInfo{
+				ScheduledEventId: 5,
+				ScheduledTime:    timestamppb.New(time.Now().Add(tc.backoff)),
+				Attempt:          2,
+				Stamp:            3,
+				TaskQueue:        "task-queue",
+			}))
+			require.Len(t, allTasks, 1)
+			require.Equal(t, tc.expectedTaskType, allTasks[0].GetType())
+			if activityTask, ok := allTasks[0].(*tasks.ActivityTask); ok {
+				require.Equal(t, int64(5), activityTask.ScheduledEventID)
+				require.Equal(t, int32(3), activityTask.Stamp)
+			}
+		})
+	}
+}
@@ -35,6 +35,8 @@ import (
 )
 
 // Used to convert out of order acks into ackLevel movement.
+// Tasks held back until their not-before time stay outstanding here, so the ack level (and task
+// GC) doesn't move past them before they're dispatched.
 type ackManager struct {
 	sync.RWMutex
 	db               *taskQueueDB // to update approximateBacklogCount
@@ -278,7 +278,12 @@ func (c *backlogManagerImpl) completeTask(itask *internalTask, err error) {
 		c.taskReader.Signal()
 	}
 
-	ackLevel, numAcked := c.taskAckManager.completeTask(task.GetTaskId())
+	c.ackTask(task.GetTaskId())
+}
+
+// ackTask marks an outstanding task as done in the ack manager and lets task GC move past it.
+func (c *backlogManagerImpl) ackTask(taskID int64) {
+	ackLevel, numAcked := c.taskAckManager.completeTask(taskID)
 	if numAcked > 0 {
 		var backlogHead time.Time
 		if nanos := c.taskReader.backlogHeadCreateTime.Load(); nanos > 0 {
@@ -34,6 +34,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/testlogger"
 	"go.temporal.io/server/common/tqid"
@@ -283,6 +284,73 @@ func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_IncrementedBySpool
 		"backlog count should match the number of tasks")
 }
 
+func (s *BacklogManagerTestSuite) TestSpoolTask_FairnessKeyBacklogCounts() {
+	if !s.newMatcher {
+		s.T().Skip("fairness keys require the new backlog manager")
+	}
+	blm := s.blm.(*priBacklogManagerImpl)
+	blm.config.FairnessMaxKeys = func() int { return 2 }
+
+	blm.Start()
+	defer blm.Stop()
+	s.NoError(blm.WaitUntilInitialized(context.Background()))
+
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).Return(nil).AnyTimes()
+	for _, key := range []string{"a", "b", "a", "c", ""} {
+		s.NoError(blm.SpoolTask(&persistencespb.TaskInfo{
+			ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(3000),
+			CreateTime:  timestamp.TimeNowPtrUtc(),
+			FairnessKey: key,
+		}))
+	}
+
+	// all keys share the subqueue of their priority, "c" is over the key limit and is counted
+	// under the empty key
+	subqueues := blm.getDB().cloneSubqueues()
+	s.Len(subqueues, 1)
+	s.Equal(map[string]int64{"": 1, "a": 2, "b": 1}, subqueues[0].FairnessKeyBacklogCounts)
+	s.Equal(int64(5), blm.TotalApproximateBacklogCount())
+
+	// keys are dropped once they have no backlog
+	blm.getDB().ackFairnessKey(0, "b")
+	blm.getDB().ackFairnessKey(0, "c")
+	s.Equal(map[string]int64{"a": 2}, blm.getDB().cloneSubqueues()[0].FairnessKeyBacklogCounts)
+}
+
+func (s *BacklogManagerTestSuite) TestBacklogGauges_FairnessKeyBreakdown() {
+	if !s.newMatcher {
+		s.T().Skip("fairness keys require the new backlog manager")
+	}
+	blm := s.blm.(*priBacklogManagerImpl)
+	blm.config.BreakdownMetricsByFairnessKey = func() []string { return []string{"a"} }
+	handler := metricstest.NewCaptureHandler()
+	capture := handler.StartCapture()
+	defer handler.StopCapture(capture)
+	blm.getDB().metricsHandler = handler
+
+	blm.Start()
+	defer blm.Stop()
+	s.NoError(blm.WaitUntilInitialized(context.Background()))
+
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).Return(nil).AnyTimes()
+	for _, key := range []string{"a", "b", "c", "c", ""} {
+		s.NoError(blm.SpoolTask(&persistencespb.TaskInfo{
+			ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(3000),
+			CreateTime:  timestamp.TimeNowPtrUtc(),
+			FairnessKey: key,
+		}))
+	}
+
+	s.NoError(blm.getDB().SyncState(context.Background()))
+
+	// keys that aren't listed are summed up under a single tag value
+	latest := make(map[string]float64)
+	for _, r := range capture.Snapshot()[metrics.ApproximateBacklogCountPerFairnessKey.Name()] {
+		latest[r.Tags[metrics.FairnessKeyTagName]] = r.Value.(float64)
+	}
+	s.Equal(map[string]float64{"a": 1, "__omitted__": 3, "": 1}, latest)
+}
+
 func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_IncrementedBySpoolTask_ServiceError() {
 	s.logger.Expect(testlogger.Error, "Persistent store operation failure")
 	s.taskMgr.dbServiceError = true
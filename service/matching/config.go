@@ -30,6 +30,7 @@ package matching
 import (
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
@@ -64,13 +65,19 @@ type (
 		NewMatcher                               dynamicconfig.TypedSubscribableWithTaskQueueFilter[bool]
 		GetTasksBatchSize                        dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		GetTasksReloadAt                         dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		MaxDelayedBacklogTasks                   dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		UpdateAckInterval                        dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		MaxTaskQueueIdleTime                     dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		NumTaskqueueWritePartitions              dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		NumTaskqueueReadPartitions               dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnablePartitionAutoScaling               dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingInterval             dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingTargetRate           dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingScaleDownDelay       dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByTaskQueue              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByPartition              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByBuildID                dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		BreakdownMetricsByFairnessKey            dynamicconfig.TypedPropertyFnWithTaskQueueFilter[[]string]
 		ForwarderMaxOutstandingPolls             dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		ForwarderMaxOutstandingTasks             dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		ForwarderMaxRatePerSecond                dynamicconfig.FloatPropertyFnWithTaskQueueFilter
@@ -95,6 +102,9 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnableFairness                           dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		FairnessKeyWeights                       dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		FairnessMaxKeys                          dynamicconfig.IntPropertyFnWithTaskQueueFilter
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueFilter
@@ -152,12 +162,16 @@ type (
 		NewMatcher                 func(func(bool)) (bool, func())
 		GetTasksBatchSize          func() int
 		GetTasksReloadAt           func() int
+		MaxDelayedBacklogTasks     func() int
 		UpdateAckInterval          func() time.Duration
 		MaxTaskQueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
 		TaskDeleteInterval         func() time.Duration
 		PriorityLevels             func() int32
+		EnableFairness             func() bool
+		FairnessKeyWeights         func() map[string]float64
+		FairnessMaxKeys            func() int
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -170,6 +184,11 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// partition auto-scaling, read from the workflow task queue config of the family
+		EnablePartitionAutoScaling         func() bool
+		PartitionAutoScalingInterval       func() time.Duration
+		PartitionAutoScalingTargetRate     func() float64
+		PartitionAutoScalingScaleDownDelay func() time.Duration
 
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
@@ -187,6 +206,8 @@ type (
 		BreakdownMetricsByTaskQueue func() bool
 		BreakdownMetricsByPartition func() bool
 		BreakdownMetricsByBuildID   func() bool
+		// Fairness keys that aren't listed here are omitted from metric tags.
+		BreakdownMetricsByFairnessKey func() []string
 
 		PollerHistoryTTL func() time.Duration
 
@@ -250,6 +271,7 @@ func NewConfig(
 		NewMatcher:                               dynamicconfig.MatchingUseNewMatcher.Subscribe(dc),
 		GetTasksBatchSize:                        dynamicconfig.MatchingGetTasksBatchSize.Get(dc),
 		GetTasksReloadAt:                         dynamicconfig.MatchingGetTasksReloadAt.Get(dc),
+		MaxDelayedBacklogTasks:                   dynamicconfig.MatchingMaxDelayedBacklogTasks.Get(dc),
 		UpdateAckInterval:                        dynamicconfig.MatchingUpdateAckInterval.Get(dc),
 		MaxTaskQueueIdleTime:                     dynamicconfig.MatchingMaxTaskQueueIdleTime.Get(dc),
 		LongPollExpirationInterval:               dynamicconfig.MatchingLongPollExpirationInterval.Get(dc),
@@ -262,9 +284,14 @@ func NewConfig(
 		ThrottledLogRPS:                          dynamicconfig.MatchingThrottledLogRPS.Get(dc),
 		NumTaskqueueWritePartitions:              dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
 		NumTaskqueueReadPartitions:               dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
+		EnablePartitionAutoScaling:               dynamicconfig.MatchingEnablePartitionAutoScaling.Get(dc),
+		PartitionAutoScalingInterval:             dynamicconfig.MatchingPartitionAutoScalingInterval.Get(dc),
+		PartitionAutoScalingTargetRate:           dynamicconfig.MatchingPartitionAutoScalingTargetRate.Get(dc),
+		PartitionAutoScalingScaleDownDelay:       dynamicconfig.MatchingPartitionAutoScalingScaleDownDelay.Get(dc),
 		BreakdownMetricsByTaskQueue:              dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 		BreakdownMetricsByPartition:              dynamicconfig.MetricsBreakdownByPartition.Get(dc),
 		BreakdownMetricsByBuildID:                dynamicconfig.MetricsBreakdownByBuildID.Get(dc),
+		BreakdownMetricsByFairnessKey:            dynamicconfig.MetricsBreakdownByFairnessKey.Get(dc),
 		ForwarderMaxOutstandingPolls:             dynamicconfig.MatchingForwarderMaxOutstandingPolls.Get(dc),
 		ForwarderMaxOutstandingTasks:             dynamicconfig.MatchingForwarderMaxOutstandingTasks.Get(dc),
 		ForwarderMaxRatePerSecond:                dynamicconfig.MatchingForwarderMaxRatePerSecond.Get(dc),
@@ -291,6 +318,9 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		EnableFairness:                           dynamicconfig.MatchingEnableFairness.Get(dc),
+		FairnessKeyWeights:                       dynamicconfig.MatchingFairnessKeyWeights.Get(dc),
+		FairnessMaxKeys:                          dynamicconfig.MatchingFairnessMaxKeys.Get(dc),
 		MatchingDropNonRetryableTasks:            dynamicconfig.MatchingDropNonRetryableTasks.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
@@ -333,6 +363,9 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		GetTasksReloadAt: func() int {
 			return config.GetTasksReloadAt(ns.String(), taskQueueName, taskType)
 		},
+		MaxDelayedBacklogTasks: func() int {
+			return config.MaxDelayedBacklogTasks(ns.String(), taskQueueName, taskType)
+		},
 		UpdateAckInterval: func() time.Duration {
 			return config.UpdateAckInterval(ns.String(), taskQueueName, taskType)
 		},
@@ -367,6 +400,15 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		PriorityLevels: func() int32 {
 			return int32(config.PriorityLevels(ns.String(), taskQueueName, taskType))
 		},
+		EnableFairness: func() bool {
+			return config.EnableFairness(ns.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]float64 {
+			return config.FairnessKeyWeights(ns.String(), taskQueueName, taskType)
+		},
+		FairnessMaxKeys: func() int {
+			return config.FairnessMaxKeys(ns.String(), taskQueueName, taskType)
+		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
 		GetUserDataReturnBudget:    returnEmptyTaskTimeBudget,
@@ -384,6 +426,18 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(ns.String(), taskQueueName, taskType))
 		},
+		EnablePartitionAutoScaling: func() bool {
+			return config.EnablePartitionAutoScaling(ns.String(), taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+		},
+		PartitionAutoScalingInterval: func() time.Duration {
+			return config.PartitionAutoScalingInterval(ns.String(), taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+		},
+		PartitionAutoScalingTargetRate: func() float64 {
+			return config.PartitionAutoScalingTargetRate(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingScaleDownDelay: func() time.Duration {
+			return config.PartitionAutoScalingScaleDownDelay(ns.String(), taskQueueName, taskType)
+		},
 		BreakdownMetricsByTaskQueue: func() bool {
 			return config.BreakdownMetricsByTaskQueue(ns.String(), taskQueueName, taskType)
 		},
@@ -393,6 +447,9 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		BreakdownMetricsByBuildID: func() bool {
 			return config.BreakdownMetricsByBuildID(ns.String(), taskQueueName, taskType)
 		},
+		BreakdownMetricsByFairnessKey: func() []string {
+			return config.BreakdownMetricsByFairnessKey(ns.String(), taskQueueName, taskType)
+		},
 		AdminNamespaceToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceToPartitionDispatchRate(ns.String())
 		},
@@ -441,3 +498,11 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 func defaultPriorityLevel(priorityLevels int32) int32 {
 	return (priorityLevels + 1) / 2
 }
+
+// fairnessWeight returns the dispatch weight of a fairness key, defaulting to 1.
+func fairnessWeight(weights map[string]float64, key string) float64 {
+	if w, ok := weights[key]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
@@ -69,6 +69,10 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules":               1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData":                 1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig":                  1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListBacklogTasks":                       1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/DeleteBacklogTasks":                     1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueueWorkers":                   1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
@@ -25,10 +25,12 @@
 package matching
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -63,6 +65,10 @@ type (
 		logger         log.Logger
 		metricsHandler metrics.Handler
 		subqueues      []*dbSubqueue
+		// Task id ranges deleted by an operator, persisted in TaskQueueInfo. Sorted, merged and
+		// trimmed to the ack level. Replaced (never modified) with the lock held, and read
+		// without the lock by isTaskDeleted.
+		deletedRanges atomic.Pointer[[]*persistencespb.TaskIdRange]
 
 		// used to avoid unnecessary metadata writes:
 		lastChange time.Time // updated when metadata is changed in memory
@@ -183,6 +189,7 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 			response.TaskQueueInfo.AckLevel,
 			response.TaskQueueInfo.ApproximateBacklogCount,
 		)
+		db.setDeletedRangesLocked(response.TaskQueueInfo.DeletedTaskRanges)
 		err := db.updateTaskQueueLocked(ctx, true)
 		if err != nil {
 			db.rangeID = 0
@@ -262,6 +269,7 @@ func (db *taskQueueDB) OldUpdateState(
 	})
 	if err == nil {
 		db.subqueues[subqueueZero].AckLevel = ackLevel
+		db.pruneDeletedRangesLocked()
 	}
 	db.emitBacklogGaugesLocked()
 	return err
@@ -295,10 +303,12 @@ func (db *taskQueueDB) updateAckLevelAndBacklogStats(subqueue int, newAckLevel i
 				subqueue, dbQueue.AckLevel, newAckLevel))
 	}
 	dbQueue.AckLevel = newAckLevel
+	db.pruneDeletedRangesLocked()
 
 	if newAckLevel == db.getMaxReadLevelLocked(subqueue) {
 		// Reset approximateBacklogCount to fix the count divergence issue
 		dbQueue.ApproximateBacklogCount = 0
+		dbQueue.FairnessKeyBacklogCounts = nil
 		dbQueue.oldestTime = oldestTime
 	} else if countDelta != 0 {
 		db.updateBacklogStatsLocked(subqueue, countDelta, oldestTime)
@@ -328,6 +338,36 @@ func (db *taskQueueDB) updateBacklogStatsLocked(subqueue int, countDelta int64,
 	db.subqueues[subqueue].oldestTime = oldestTime
 }
 
+// ackFairnessKey decrements the backlog count of the fairness key of an acked task.
+func (db *taskQueueDB) ackFairnessKey(subqueue int, key string) {
+	if key == "" {
+		return
+	}
+	db.Lock()
+	defer db.Unlock()
+	db.updateFairnessKeyCountLocked(subqueue, key, -1)
+}
+
+// updateFairnessKeyCountLocked adds delta to the backlog count of a non-empty fairness key.
+// Once a subqueue tracks FairnessMaxKeys keys, new keys are counted under the empty key. Keys
+// are removed when their count drops to zero so that idle keys don't accumulate.
+func (db *taskQueueDB) updateFairnessKeyCountLocked(subqueue int, key string, delta int64) {
+	counts := db.subqueues[subqueue].FairnessKeyBacklogCounts
+	if _, ok := counts[key]; !ok && (delta < 0 || len(counts) >= db.config.FairnessMaxKeys()) {
+		key = ""
+	}
+	n := counts[key] + delta
+	if n <= 0 {
+		delete(counts, key)
+		return
+	}
+	if counts == nil {
+		counts = make(map[string]int64)
+		db.subqueues[subqueue].FairnessKeyBacklogCounts = counts
+	}
+	counts[key] = n
+}
+
 func (db *taskQueueDB) getApproximateBacklogCount(subqueue int) int64 {
 	db.Lock()
 	defer db.Unlock()
@@ -378,6 +418,11 @@ func (db *taskQueueDB) CreateTasks(
 	for i, update := range updates {
 		db.subqueues[i].ApproximateBacklogCount += int64(len(update.tasks))
 	}
+	for _, req := range reqs {
+		if key := req.taskInfo.GetFairnessKey(); key != "" {
+			db.updateFairnessKeyCountLocked(req.subqueue, key, 1)
+		}
+	}
 
 	resp, err := db.store.CreateTasks(
 		ctx,
@@ -408,6 +453,11 @@ func (db *taskQueueDB) CreateTasks(
 		for i, update := range updates {
 			db.subqueues[i].ApproximateBacklogCount -= int64(len(update.tasks))
 		}
+		for _, req := range reqs {
+			if key := req.taskInfo.GetFairnessKey(); key != "" {
+				db.updateFairnessKeyCountLocked(req.subqueue, key, -1)
+			}
+		}
 	}
 	return createTasksResponse{bySubqueue: updates}, err
 }
@@ -460,6 +510,153 @@ func (db *taskQueueDB) CompleteTasksLessThan(
 	return n, err
 }
 
+// ListTasks returns a page of tasks of the given subqueue that are above the ack level and
+// match the filter, if any. Tasks in deleted ranges are skipped. Filtering happens before the
+// page size is applied, so a page is only short if there are no more tasks.
+func (db *taskQueueDB) ListTasks(
+	ctx context.Context,
+	subqueue int,
+	pageSize int,
+	nextPageToken []byte,
+	filter func(*persistencespb.AllocatedTaskInfo) bool,
+) (*persistence.GetTasksResponse, error) {
+	db.Lock()
+	if subqueue < 0 || subqueue >= len(db.subqueues) {
+		db.Unlock()
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("subqueue %d does not exist", subqueue))
+	}
+	inclusiveMinTaskID := db.subqueues[subqueue].AckLevel + 1
+	exclusiveMaxTaskID := db.getMaxReadLevelLocked(subqueue) + 1
+	db.Unlock()
+
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for {
+		// Only read as many tasks as are missing, so that the store's page token points
+		// exactly after the last task we return.
+		resp, err := db.store.GetTasks(ctx, &persistence.GetTasksRequest{
+			NamespaceID:        db.queue.NamespaceId(),
+			TaskQueue:          db.queue.PersistenceName(),
+			TaskType:           db.queue.TaskType(),
+			InclusiveMinTaskID: inclusiveMinTaskID,
+			ExclusiveMaxTaskID: exclusiveMaxTaskID,
+			Subqueue:           subqueue,
+			PageSize:           pageSize - len(tasks),
+			NextPageToken:      nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range resp.Tasks {
+			if !db.isTaskDeleted(t.TaskId) && (filter == nil || filter(t)) {
+				tasks = append(tasks, t)
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(tasks) >= pageSize || len(nextPageToken) == 0 {
+			return &persistence.GetTasksResponse{Tasks: tasks, NextPageToken: nextPageToken}, nil
+		}
+	}
+}
+
+// DeleteTaskRange marks a range of task ids as deleted and persists it. Tasks in the range
+// are dropped by the task readers instead of being dispatched, which lets them be acked and
+// garbage collected normally. The range is clamped to tasks that have already been written.
+func (db *taskQueueDB) DeleteTaskRange(
+	ctx context.Context,
+	inclusiveMinTaskID int64,
+	exclusiveMaxTaskID int64,
+) error {
+	db.Lock()
+	defer db.Unlock()
+
+	var maxReadLevel int64
+	for _, s := range db.subqueues {
+		maxReadLevel = max(maxReadLevel, s.maxReadLevel)
+	}
+	exclusiveMaxTaskID = min(exclusiveMaxTaskID, maxReadLevel+1)
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil
+	}
+
+	prev := db.getDeletedRanges()
+	db.setDeletedRangesLocked(append(slices.Clone(prev), &persistencespb.TaskIdRange{
+		InclusiveMinTaskId: inclusiveMinTaskID,
+		ExclusiveMaxTaskId: exclusiveMaxTaskID,
+	}))
+	if err := db.updateTaskQueueLocked(ctx, false); err != nil {
+		db.deletedRanges.Store(&prev)
+		return err
+	}
+	return nil
+}
+
+// isTaskDeleted returns true if the task id is in a range deleted by DeleteTaskRange. It's
+// called for every task read, so it doesn't take the db lock.
+func (db *taskQueueDB) isTaskDeleted(taskID int64) bool {
+	ranges := db.getDeletedRanges()
+	// find the first range that ends after taskID
+	i, _ := slices.BinarySearchFunc(ranges, taskID, func(r *persistencespb.TaskIdRange, id int64) int {
+		if r.ExclusiveMaxTaskId <= id {
+			return -1
+		}
+		return 1
+	})
+	return i < len(ranges) && ranges[i].InclusiveMinTaskId <= taskID
+}
+
+func (db *taskQueueDB) getDeletedRanges() []*persistencespb.TaskIdRange {
+	if ranges := db.deletedRanges.Load(); ranges != nil {
+		return *ranges
+	}
+	return nil
+}
+
+// setDeletedRangesLocked replaces the deleted ranges with the given ones, sorted, merged and
+// trimmed to the ack level.
+func (db *taskQueueDB) setDeletedRangesLocked(ranges []*persistencespb.TaskIdRange) {
+	ranges = normalizeTaskIDRanges(ranges, db.minAckLevelLocked())
+	db.deletedRanges.Store(&ranges)
+}
+
+// pruneDeletedRangesLocked forgets deleted ranges, or parts of them, that all subqueue ack
+// levels have passed.
+func (db *taskQueueDB) pruneDeletedRangesLocked() {
+	ranges := db.getDeletedRanges()
+	if len(ranges) == 0 || ranges[0].InclusiveMinTaskId > db.minAckLevelLocked() {
+		return
+	}
+	db.setDeletedRangesLocked(ranges)
+}
+
+func (db *taskQueueDB) minAckLevelLocked() int64 {
+	minAckLevel := db.subqueues[subqueueZero].AckLevel
+	for _, s := range db.subqueues {
+		minAckLevel = min(minAckLevel, s.AckLevel)
+	}
+	return minAckLevel
+}
+
+// normalizeTaskIDRanges returns a sorted list of non-overlapping ranges that covers the task
+// ids of the given ranges above ackLevel. The given ranges are not modified.
+func normalizeTaskIDRanges(ranges []*persistencespb.TaskIdRange, ackLevel int64) []*persistencespb.TaskIdRange {
+	sorted := slices.SortedFunc(slices.Values(ranges), func(a, b *persistencespb.TaskIdRange) int {
+		return cmp.Compare(a.InclusiveMinTaskId, b.InclusiveMinTaskId)
+	})
+	var result []*persistencespb.TaskIdRange
+	for _, r := range sorted {
+		minID, maxID := max(r.InclusiveMinTaskId, ackLevel+1), r.ExclusiveMaxTaskId
+		if minID >= maxID {
+			continue
+		}
+		if n := len(result); n > 0 && minID <= result[n-1].ExclusiveMaxTaskId {
+			result[n-1].ExclusiveMaxTaskId = max(result[n-1].ExclusiveMaxTaskId, maxID)
+			continue
+		}
+		result = append(result, &persistencespb.TaskIdRange{InclusiveMinTaskId: minID, ExclusiveMaxTaskId: maxID})
+	}
+	return result
+}
+
 func (db *taskQueueDB) AllocateSubqueue(
 	ctx context.Context,
 	key *persistencespb.SubqueueKey,
@@ -510,6 +707,7 @@ func (db *taskQueueDB) cachedQueueInfo() *persistencespb.TaskQueueInfo {
 		LastUpdateTime:          timestamp.TimeNowPtrUtc(),
 		ApproximateBacklogCount: db.subqueues[subqueueZero].ApproximateBacklogCount, // backwards compatibility
 		Subqueues:               infos,
+		DeletedTaskRanges:       db.getDeletedRanges(),
 	}
 }
 
@@ -540,6 +738,31 @@ func (db *taskQueueDB) emitBacklogGaugesLocked() {
 		metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(time.Since(oldestTime).Seconds())
 	}
 	metrics.TaskLagPerTaskQueueGauge.With(db.metricsHandler).Record(float64(totalLag))
+
+	// Break down the backlog by fairness key only if there are any keys. Counts for the same key
+	// at different priorities are summed up, and so are keys that aren't broken down. The empty
+	// key gets the rest of the backlog.
+	var countByKey map[string]int64
+	breakdownKeys := db.config.BreakdownMetricsByFairnessKey()
+	remaining := approximateBacklogCount
+	for _, s := range db.subqueues {
+		for key, count := range s.FairnessKeyBacklogCounts {
+			if countByKey == nil {
+				countByKey = make(map[string]int64)
+			}
+			if key != "" {
+				countByKey[metrics.GetFairnessKeyTagValue(key, breakdownKeys)] += count
+				remaining -= count
+			}
+		}
+	}
+	if countByKey != nil {
+		countByKey[""] = max(remaining, 0)
+		for key, count := range countByKey {
+			metrics.ApproximateBacklogCountPerFairnessKey.With(db.metricsHandler).Record(
+				float64(count), metrics.FairnessKeyTag(key))
+		}
+	}
 }
 
 func (db *taskQueueDB) ensureDefaultSubqueuesLocked(
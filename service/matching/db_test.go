@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func TestNormalizeTaskIDRanges(t *testing.T) {
+	t.Parallel()
+
+	r := func(minID, maxID int64) *persistencespb.TaskIdRange {
+		return &persistencespb.TaskIdRange{InclusiveMinTaskId: minID, ExclusiveMaxTaskId: maxID}
+	}
+	input := []*persistencespb.TaskIdRange{r(50, 60), r(10, 20), r(15, 30), r(30, 35), r(1, 5), r(40, 41)}
+
+	// sorted, overlapping and adjacent ranges merged, ranges below the ack level dropped or trimmed
+	got := normalizeTaskIDRanges(input, 12)
+	assert.Equal(t, []*persistencespb.TaskIdRange{r(13, 35), r(40, 41), r(50, 60)}, got)
+	assert.Equal(t, r(50, 60), input[0], "input should not be modified")
+
+	assert.Empty(t, normalizeTaskIDRanges(input, 100))
+}
+
+func TestIsTaskDeleted(t *testing.T) {
+	t.Parallel()
+
+	db := &taskQueueDB{subqueues: []*dbSubqueue{{}}}
+	db.setDeletedRangesLocked([]*persistencespb.TaskIdRange{
+		{InclusiveMinTaskId: 20, ExclusiveMaxTaskId: 30},
+		{InclusiveMinTaskId: 5, ExclusiveMaxTaskId: 10},
+	})
+
+	for id, deleted := range map[int64]bool{
+		1: false, 5: true, 9: true, 10: false, 19: false, 20: true, 29: true, 30: false, 100: false,
+	} {
+		assert.Equal(t, deleted, db.isTaskDeleted(id), "task %d", id)
+	}
+	assert.False(t, (&taskQueueDB{}).isTaskDeleted(1))
+}
@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/emirpasic/gods/maps/treemap"
+	godsutils "github.com/emirpasic/gods/utils"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// delayedTasks holds backlog tasks that were read before their not-before time. Tasks are
+	// released to the callback in not-before order once they're ready, from a single goroutine
+	// started by start. The owner must keep delayed tasks outstanding in its ack manager so the
+	// ack level (and task GC) doesn't move past them. To keep them from holding back the ack
+	// level for long, the owner should periodically take the tasks that aren't due soon (see
+	// takeDueAfter) and write them back to the end of the backlog.
+	// At most limit() tasks are held in memory. Past the limit, the tasks due last are only
+	// recorded as skipped by ID; the owner should re-read them from persistence (starting after
+	// rereadLevel) once there's room again.
+	delayedTasks struct {
+		ctx     context.Context
+		release func([]*persistencespb.AllocatedTaskInfo)
+		limit   func() int
+		wakeC   chan struct{} // signals the release loop that the earliest task changed
+
+		lock    sync.Mutex
+		heap    delayedTaskHeap
+		skipped *treemap.Map // TaskID->struct{}
+	}
+
+	delayedTaskHeap []*persistencespb.AllocatedTaskInfo
+)
+
+func newDelayedTasks(
+	ctx context.Context,
+	release func([]*persistencespb.AllocatedTaskInfo),
+	limit func() int,
+) *delayedTasks {
+	return &delayedTasks{
+		ctx:     ctx,
+		release: release,
+		limit:   limit,
+		wakeC:   make(chan struct{}, 1),
+		skipped: treemap.NewWith(godsutils.Int64Comparator),
+	}
+}
+
+// start starts the goroutine that releases tasks. It exits when ctx is done.
+func (d *delayedTasks) start() {
+	go d.releaseLoop()
+}
+
+// isTaskDelayed returns true if the task has a not-before time after now.
+func isTaskDelayed(t *persistencespb.TaskInfo, now time.Time) bool {
+	return t.GetNotBeforeTime() != nil && t.GetNotBeforeTime().AsTime().After(now)
+}
+
+// backlogStartTime returns the time from which a backlog task counts towards backlog age: its
+// create time, or its not-before time if that's later.
+func backlogStartTime(t *persistencespb.TaskInfo) *timestamppb.Timestamp {
+	if nb := t.GetNotBeforeTime(); nb != nil && nb.AsTime().After(t.GetCreateTime().AsTime()) {
+		return nb
+	}
+	return t.GetCreateTime()
+}
+
+// add holds t until its not-before time. If too many tasks are held already, the one with the
+// latest not-before time (t or a held one) is dropped and its ID is recorded as skipped instead.
+func (d *delayedTasks) add(t *persistencespb.AllocatedTaskInfo) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if len(d.heap) >= d.limit() {
+		latest := d.heap.latest()
+		if latest < 0 || !delayedTaskBefore(t, d.heap[latest]) {
+			d.skipped.Put(t.TaskId, struct{}{})
+			return
+		}
+		evicted := heap.Remove(&d.heap, latest).(*persistencespb.AllocatedTaskInfo)
+		d.skipped.Put(evicted.TaskId, struct{}{})
+	}
+	heap.Push(&d.heap, t)
+	if d.heap[0] == t {
+		d.wake()
+	}
+}
+
+// takeDueAfter removes and returns the held tasks with a not-before time after t.
+func (d *delayedTasks) takeDueAfter(t time.Time) []*persistencespb.AllocatedTaskInfo {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	var taken []*persistencespb.AllocatedTaskInfo
+	d.heap = slices.DeleteFunc(d.heap, func(task *persistencespb.AllocatedTaskInfo) bool {
+		if task.Data.NotBeforeTime.AsTime().After(t) {
+			taken = append(taken, task)
+			return true
+		}
+		return false
+	})
+	heap.Init(&d.heap)
+	return taken
+}
+
+func (d *delayedTasks) len() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return len(d.heap)
+}
+
+// rereadLevel returns the level to re-read skipped tasks from, if there are skipped tasks and
+// room to hold more.
+func (d *delayedTasks) rereadLevel() (int64, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if len(d.heap) >= d.limit() {
+		return 0, false
+	}
+	return d.skippedLevelLocked()
+}
+
+// skippedLevel returns the level to re-read skipped tasks from, if there are skipped tasks,
+// regardless of whether there's room to hold them.
+func (d *delayedTasks) skippedLevel() (int64, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.skippedLevelLocked()
+}
+
+func (d *delayedTasks) skippedLevelLocked() (int64, bool) {
+	minID, _ := d.skipped.Min()
+	if minID == nil {
+		return 0, false
+	}
+	return minID.(int64) - 1, true
+}
+
+// takeSkipped removes and returns the IDs of skipped tasks up to and including maxID.
+func (d *delayedTasks) takeSkipped(maxID int64) map[int64]struct{} {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	ids := make(map[int64]struct{})
+	for {
+		minID, _ := d.skipped.Min()
+		if minID == nil || minID.(int64) > maxID {
+			return ids
+		}
+		ids[minID.(int64)] = struct{}{}
+		d.skipped.Remove(minID)
+	}
+}
+
+// restoreSkipped records ids as skipped again, after they were taken by takeSkipped but couldn't
+// be handled.
+func (d *delayedTasks) restoreSkipped(ids map[int64]struct{}) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for id := range ids {
+		d.skipped.Put(id, struct{}{})
+	}
+}
+
+func (d *delayedTasks) wake() {
+	select {
+	case d.wakeC <- struct{}{}:
+	default:
+	}
+}
+
+func (d *delayedTasks) releaseLoop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-d.wakeC:
+		case <-timer.C:
+		}
+
+		ready, next := d.popReady(time.Now())
+		if len(ready) > 0 {
+			d.release(ready)
+		}
+		timer.Stop()
+		if next > 0 {
+			timer.Reset(next)
+		}
+	}
+}
+
+// popReady removes and returns the tasks that are due at now, and the time until the next one
+// is due (or zero if no tasks are left).
+func (d *delayedTasks) popReady(now time.Time) ([]*persistencespb.AllocatedTaskInfo, time.Duration) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	var ready []*persistencespb.AllocatedTaskInfo
+	for len(d.heap) > 0 && !isTaskDelayed(d.heap[0].Data, now) {
+		ready = append(ready, heap.Pop(&d.heap).(*persistencespb.AllocatedTaskInfo))
+	}
+	if len(d.heap) == 0 {
+		return ready, 0
+	}
+	return ready, max(d.heap[0].Data.NotBeforeTime.AsTime().Sub(now), time.Millisecond)
+}
+
+// delayedTaskBefore orders tasks by not-before time, then task ID.
+func delayedTaskBefore(a, b *persistencespb.AllocatedTaskInfo) bool {
+	at, bt := a.Data.NotBeforeTime.AsTime(), b.Data.NotBeforeTime.AsTime()
+	if !at.Equal(bt) {
+		return at.Before(bt)
+	}
+	return a.TaskId < b.TaskId
+}
+
+// latest returns the index of the task that would be released last, or -1 if h is empty. This
+// is a linear scan, it's only used when the heap is full.
+func (h delayedTaskHeap) latest() int {
+	idx := -1
+	for i := range h {
+		if idx < 0 || delayedTaskBefore(h[idx], h[i]) {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// implements heap.Interface
+func (h delayedTaskHeap) Len() int {
+	return len(h)
+}
+
+// implements heap.Interface, do not call directly
+func (h delayedTaskHeap) Less(i, j int) bool {
+	return delayedTaskBefore(h[i], h[j])
+}
+
+// implements heap.Interface, do not call directly
+func (h delayedTaskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// implements heap.Interface, do not call directly
+func (h *delayedTaskHeap) Push(x any) {
+	*h = append(*h, x.(*persistencespb.AllocatedTaskInfo))
+}
+
+// implements heap.Interface, do not call directly
+func (h *delayedTaskHeap) Pop() any {
+	last := len(*h) - 1
+	t := (*h)[last]
+	*h = (*h)[:last]
+	return t
+}
@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDelayedTasks_ReleasesInOrder(t *testing.T) {
+	t.Parallel()
+
+	released := make(chan *persistencespb.AllocatedTaskInfo, 10)
+	d := newDelayedTasks(context.Background(), func(tasks []*persistencespb.AllocatedTaskInfo) {
+		for _, task := range tasks {
+			released <- task
+		}
+	}, func() int { return 10 })
+	d.start()
+
+	now := time.Now()
+	mk := func(id int64, delay time.Duration) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: id,
+			Data: &persistencespb.TaskInfo{
+				CreateTime:    timestamppb.New(now),
+				NotBeforeTime: timestamppb.New(now.Add(delay)),
+			},
+		}
+	}
+	d.add(mk(3, 150*time.Millisecond))
+	d.add(mk(1, 100*time.Millisecond))
+	d.add(mk(2, 100*time.Millisecond))
+	require.Equal(t, 3, d.len())
+
+	for _, id := range []int64{1, 2, 3} {
+		select {
+		case task := <-released:
+			assert.Equal(t, id, task.TaskId)
+			assert.False(t, time.Now().Before(task.Data.NotBeforeTime.AsTime()))
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for delayed task")
+		}
+	}
+	assert.Equal(t, 0, d.len())
+}
+
+func TestDelayedTasks_StopsOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d := newDelayedTasks(ctx, func([]*persistencespb.AllocatedTaskInfo) {
+		t.Error("task should not be released after context is done")
+	}, func() int { return 10 })
+	d.start()
+	d.add(&persistencespb.AllocatedTaskInfo{
+		TaskId: 1,
+		Data:   &persistencespb.TaskInfo{NotBeforeTime: timestamppb.New(time.Now().Add(50 * time.Millisecond))},
+	})
+	cancel()
+	time.Sleep(100 * time.Millisecond) //nolint:forbidigo // need to sleep for negative check
+	assert.Equal(t, 1, d.len())
+}
+
+func TestDelayedTasks_SkipsPastLimit(t *testing.T) {
+	t.Parallel()
+
+	limit := 2
+	d := newDelayedTasks(context.Background(), func([]*persistencespb.AllocatedTaskInfo) {
+		t.Error("task should not be released yet")
+	}, func() int { return limit })
+
+	now := time.Now()
+	mk := func(id int64, delay time.Duration) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: id,
+			Data:   &persistencespb.TaskInfo{NotBeforeTime: timestamppb.New(now.Add(delay))},
+		}
+	}
+	d.add(mk(1, time.Hour))
+	d.add(mk(2, 2*time.Hour))
+	d.add(mk(3, 3*time.Hour)) // due last, skipped
+	d.add(mk(4, time.Minute)) // replaces 2
+	assert.Equal(t, 2, d.len())
+	_, ok := d.rereadLevel()
+	assert.False(t, ok, "no room to re-read skipped tasks")
+
+	limit = 5
+	level, ok := d.rereadLevel()
+	require.True(t, ok)
+	assert.Equal(t, int64(1), level)
+
+	assert.Equal(t, map[int64]struct{}{2: {}}, d.takeSkipped(2))
+	level, ok = d.rereadLevel()
+	require.True(t, ok)
+	assert.Equal(t, int64(2), level)
+
+	assert.Equal(t, map[int64]struct{}{3: {}}, d.takeSkipped(10))
+	_, ok = d.rereadLevel()
+	assert.False(t, ok, "nothing left to re-read")
+}
+
+func TestDelayedTasks_TakeDueAfter(t *testing.T) {
+	t.Parallel()
+
+	d := newDelayedTasks(context.Background(), func([]*persistencespb.AllocatedTaskInfo) {
+		t.Error("task should not be released yet")
+	}, func() int { return 10 })
+	d.start()
+
+	now := time.Now()
+	mk := func(id int64, delay time.Duration) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: id,
+			Data:   &persistencespb.TaskInfo{NotBeforeTime: timestamppb.New(now.Add(delay))},
+		}
+	}
+	d.add(mk(1, time.Hour))
+	d.add(mk(2, time.Minute))
+	d.add(mk(3, 2*time.Hour))
+
+	taken := d.takeDueAfter(now.Add(10 * time.Minute))
+	assert.ElementsMatch(t, []int64{1, 3}, []int64{taken[0].TaskId, taken[1].TaskId})
+	assert.Equal(t, 1, d.len())
+	assert.Empty(t, d.takeDueAfter(now.Add(10*time.Minute)))
+}
+
+func TestBacklogStartTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	create := timestamppb.New(now)
+	later := timestamppb.New(now.Add(time.Minute))
+	earlier := timestamppb.New(now.Add(-time.Minute))
+
+	assert.Equal(t, create, backlogStartTime(&persistencespb.TaskInfo{CreateTime: create}))
+	assert.Equal(t, later, backlogStartTime(&persistencespb.TaskInfo{CreateTime: create, NotBeforeTime: later}))
+	assert.Equal(t, create, backlogStartTime(&persistencespb.TaskInfo{CreateTime: create, NotBeforeTime: earlier}))
+
+	assert.True(t, isTaskDelayed(&persistencespb.TaskInfo{NotBeforeTime: later}, now))
+	assert.False(t, isTaskDelayed(&persistencespb.TaskInfo{NotBeforeTime: earlier}, now))
+	assert.False(t, isTaskDelayed(&persistencespb.TaskInfo{}, now))
+}
@@ -30,6 +30,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -155,6 +156,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				ForwardInfo:            fwdr.getForwardInfo(task),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
+				WorkflowType:           &commonpb.WorkflowType{Name: task.event.Data.GetTypeName()},
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
@@ -173,6 +176,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
+				ActivityType:           &commonpb.ActivityType{Name: task.event.Data.GetTypeName()},
 			},
 		)
 	default:
@@ -271,6 +276,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				WorkerVersionCapabilities: pollMetadata.workerVersionCapabilities,
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
+			WorkerMetadata:  pollMetadata.workerMetadata,
 			ForwardedSource: fwdr.partition.RpcName(),
 		})
 		if err != nil {
@@ -291,6 +297,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				WorkerVersionCapabilities: pollMetadata.workerVersionCapabilities,
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
+			WorkerMetadata:  pollMetadata.workerMetadata,
 			ForwardedSource: fwdr.partition.RpcName(),
 		})
 		if err != nil {
@@ -311,6 +318,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 				// Namespace is ignored here.
 			},
+			WorkerMetadata:  pollMetadata.workerMetadata,
 			ForwardedSource: fwdr.partition.RpcName(),
 		})
 		if err != nil {
@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
@@ -89,6 +90,7 @@ type (
 		SearchAttributeProvider       searchattribute.Provider
 		SearchAttributeMapperProvider searchattribute.MapperProvider
 		RateLimiter                   TaskDispatchRateLimiter `optional:"true"`
+		TracerProvider                trace.TracerProvider
 	}
 )
 
@@ -130,6 +132,7 @@ func NewHandler(
 			params.SearchAttributeProvider,
 			params.SearchAttributeMapperProvider,
 			params.RateLimiter,
+			params.TracerProvider,
 		),
 		namespaceRegistry: params.NamespaceRegistry,
 	}
@@ -420,6 +423,38 @@ func (h *Handler) SyncDeploymentUserData(
 	return h.engine.SyncDeploymentUserData(ctx, request)
 }
 
+func (h *Handler) UpdateTaskQueueConfig(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueueConfigRequest,
+) (_ *matchingservice.UpdateTaskQueueConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.UpdateTaskQueueConfig(ctx, request)
+}
+
+func (h *Handler) ListBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListBacklogTasksRequest,
+) (_ *matchingservice.ListBacklogTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ListBacklogTasks(ctx, request)
+}
+
+func (h *Handler) DeleteBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteBacklogTasksRequest,
+) (_ *matchingservice.DeleteBacklogTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.DeleteBacklogTasks(ctx, request)
+}
+
+func (h *Handler) ListTaskQueueWorkers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueueWorkersRequest,
+) (_ *matchingservice.ListTaskQueueWorkersResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ListTaskQueueWorkers(ctx, request)
+}
+
 func (h *Handler) ApplyTaskQueueUserDataReplicationEvent(
 	ctx context.Context,
 	request *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest,
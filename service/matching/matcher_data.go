@@ -99,14 +99,32 @@ type taskPQ struct {
 
 	// rate limiter for overall queue
 	wholeQueueLimiter simpleLimiter
+
+	// rate limiters for workflow/activity types that have a configured limit
+	typeLimiters map[string]*simpleLimiter
+	// set on child partitions: type limits are enforced on the root partition, so tasks of
+	// limited types must be forwarded and the local limiters aren't used
+	forwardLimitedTypes bool
+
+	// fairness pass state for tasks within a priority level
+	fairness fairnessState
 }
 
 func (t *taskPQ) Add(task *internalTask) {
+	t.fairness.assign(task)
 	heap.Push(t, task)
 }
 
+// Remove removes a task that was not dispatched.
 func (t *taskPQ) Remove(task *internalTask) {
 	heap.Remove(t, task.matchHeapIndex)
+	t.fairness.unassign(task)
+}
+
+// RemoveMatched removes a task that is being dispatched.
+func (t *taskPQ) RemoveMatched(task *internalTask) {
+	heap.Remove(t, task.matchHeapIndex)
+	t.fairness.dispatched(task, len(t.heap))
 }
 
 func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
@@ -115,21 +133,57 @@ func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
 	// 	// don't count any rate limit for forwarded tasks, it was counted on the child
 	// 	return 0
 	// }
+	var typeReady int64
+	if limited, ready := t.typeLimit(task); limited && !t.forwardLimitedTypes {
+		typeReady = ready
+	}
 	return max(
 		t.wholeQueueLimiter.ready,
+		typeReady,
 		// TODO(pri): add more times here, e.g. fairness key limit, per-task backoff
 	)
 }
 
+// typeLimit returns whether the task's type has a rate limit, and if so, the ready time of the
+// limit. Type limits are enforced on the root partition only, so unlike the whole-queue limit,
+// they apply to forwarded tasks too.
+func (t *taskPQ) typeLimit(task *internalTask) (limited bool, ready int64) {
+	if len(t.typeLimiters) == 0 || task.isPollForwarder {
+		return false, 0
+	}
+	if limiter, ok := t.typeLimiters[task.getTypeName()]; ok {
+		return true, limiter.ready
+	}
+	return false, 0
+}
+
 func (t *taskPQ) consumeTokens(now int64, task *internalTask, tokens int64) {
+	if limiter, ok := t.typeLimiters[task.getTypeName()]; ok && !t.forwardLimitedTypes {
+		limiter.consume(now, tokens)
+	}
+
 	if task.isForwarded() {
-		// don't count any rate limit for forwarded tasks, it was counted on the child
+		// don't count the whole-queue rate limit for forwarded tasks, it was counted on the child
 		return
 	}
-
 	t.wholeQueueLimiter.consume(now, tokens)
 }
 
+// setTypeLimits replaces the set of type rate limits. Limiters of types that keep a limit
+// retain their state.
+func (t *taskPQ) setTypeLimits(rates map[string]float64, burstDuration time.Duration) {
+	limiters := make(map[string]*simpleLimiter, len(rates))
+	for typeName, rate := range rates {
+		limiter, ok := t.typeLimiters[typeName]
+		if !ok {
+			limiter = &simpleLimiter{}
+		}
+		limiter.set(rate, burstDuration)
+		limiters[typeName] = limiter
+	}
+	t.typeLimiters = limiters
+}
+
 // implements heap.Interface
 func (t *taskPQ) Len() int {
 	return len(t.heap)
@@ -173,6 +227,13 @@ func (t *taskPQ) Less(i int, j int) bool {
 		return false
 	}
 
+	// try fairness pass
+	if a.fairnessPass < b.fairnessPass {
+		return true
+	} else if a.fairnessPass > b.fairnessPass {
+		return false
+	}
+
 	// Note: sync match tasks have a fixed negative id.
 	// Query tasks will get 0 here.
 	var aid, bid int64
@@ -199,7 +260,7 @@ func (t *taskPQ) Push(x any) {
 	t.heap = append(t.heap, task)
 
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
-		t.ages.record(task.event.Data.CreateTime, 1)
+		t.ages.record(backlogStartTime(task.event.Data), 1)
 	}
 }
 
@@ -211,7 +272,7 @@ func (t *taskPQ) Pop() any {
 	task.matchHeapIndex = invalidHeapIndex
 
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
-		t.ages.record(task.event.Data.CreateTime, -1)
+		t.ages.record(backlogStartTime(task.event.Data), -1)
 	}
 
 	return task
@@ -227,8 +288,9 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 		}
 		task.matchHeapIndex = invalidHeapIndex - 1 // maintain heap/index invariant
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
-			t.ages.record(task.event.Data.CreateTime, -1)
+			t.ages.record(backlogStartTime(task.event.Data), -1)
 		}
+		t.fairness.unassign(task)
 		post(task)
 		return true
 	})
@@ -239,6 +301,83 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 	heap.Init(t)
 }
 
+// fairnessState implements weighted fair queueing across fairness keys within a priority
+// level. Each task is assigned a virtual "pass" when it's first enqueued: the later of the
+// last pass assigned to its key and the pass of the most recently dispatched task, plus the
+// inverse of its key's weight. Dispatching in pass order interleaves keys in proportion to
+// their weights, and a key that was idle can't save up credit to starve others later.
+// Passes are only assigned while fairness is enabled, otherwise all tasks have pass zero and
+// are ordered by task id. Note that within a key, tasks are ordered by enqueue time.
+// State for a key is dropped once it has no queued tasks left, so idle keys don't accumulate.
+type fairnessState struct {
+	enabled  func() bool
+	weights  func() map[string]float64
+	vtime    float64            // pass of the most recently dispatched task
+	lastPass map[string]float64 // last pass assigned per key, only for keys with pass > vtime
+	queued   map[string]int     // number of queued tasks with a pass per key
+}
+
+func (f *fairnessState) assign(task *internalTask) {
+	if task.isPollForwarder || task.fairnessPass != 0 || f.enabled == nil || !f.enabled() {
+		return
+	}
+	if f.lastPass == nil {
+		f.lastPass = make(map[string]float64)
+		f.queued = make(map[string]int)
+	}
+	var weights map[string]float64
+	if f.weights != nil {
+		weights = f.weights()
+	}
+	key := task.getFairnessKey()
+	prev := max(f.lastPass[key], f.vtime)
+	task.fairnessPrevPass = prev
+	task.fairnessPass = prev + 1/fairnessWeight(weights, key)
+	f.lastPass[key] = task.fairnessPass
+	f.queued[key]++
+}
+
+// unassign gives back the pass of a task that was removed without being dispatched, if no
+// later task of the same key was assigned a pass in the meantime.
+func (f *fairnessState) unassign(task *internalTask) {
+	if task.fairnessPass == 0 {
+		return
+	}
+	key := task.getFairnessKey()
+	if last, ok := f.lastPass[key]; ok && last == task.fairnessPass {
+		f.lastPass[key] = task.fairnessPrevPass
+	}
+	task.fairnessPass, task.fairnessPrevPass = 0, 0
+	f.release(key)
+}
+
+func (f *fairnessState) dispatched(task *internalTask, remainingTasks int) {
+	f.vtime = max(f.vtime, task.fairnessPass)
+	if task.fairnessPass != 0 {
+		f.release(task.getFairnessKey())
+	}
+	// Keys whose last pass is behind vtime would get vtime anyway, drop them to bound memory.
+	if len(f.lastPass) > 2*remainingTasks+16 {
+		for key, pass := range f.lastPass {
+			if pass <= f.vtime {
+				delete(f.lastPass, key)
+			}
+		}
+	}
+}
+
+// release drops the state of a key once it has no queued tasks left. Its last pass is kept
+// while it's ahead of vtime, so that removing and re-adding tasks can't gain a key credit.
+func (f *fairnessState) release(key string) {
+	if f.queued[key]--; f.queued[key] > 0 {
+		return
+	}
+	delete(f.queued, key)
+	if f.lastPass[key] <= f.vtime {
+		delete(f.lastPass, key)
+	}
+}
+
 type matcherData struct {
 	config     *taskQueueConfig
 	logger     log.Logger
@@ -265,7 +404,12 @@ func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock
 		timeSource: timeSource,
 		canForward: canForward,
 		tasks: taskPQ{
-			ages: newBacklogAgeTracker(),
+			ages:                newBacklogAgeTracker(),
+			forwardLimitedTypes: canForward,
+			fairness: fairnessState{
+				enabled: config.EnableFairness,
+				weights: config.FairnessKeyWeights,
+			},
 		},
 	}
 }
@@ -277,6 +421,16 @@ func (d *matcherData) UpdateRateLimit(rate float64, burstDuration time.Duration)
 	d.tasks.wholeQueueLimiter.set(rate, burstDuration)
 }
 
+// UpdateTypeRateLimits sets per-type rate limits, keyed by workflow or activity type name.
+// Types without an entry are not limited beyond the whole-queue limit.
+func (d *matcherData) UpdateTypeRateLimits(rates map[string]float64, burstDuration time.Duration) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.tasks.setTypeLimits(rates, burstDuration)
+	d.findAndWakeMatches() // tasks of a type whose limit was raised or removed may be ready now
+}
+
 func (d *matcherData) EnqueueTaskNoWait(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -406,17 +560,30 @@ func (d *matcherData) ReprocessTasks(pred func(*internalTask) bool) []*internalT
 	return reprocess
 }
 
-// findMatch should return the highest priority task+poller match even if the per-task rate
-// limit doesn't allow the task to be matched yet.
+// findMatch should return the highest priority task+poller match even if the whole-queue rate
+// limit doesn't allow the task to be matched yet. Tasks held back by their type rate limit are
+// skipped so they don't block tasks of other types; in that case nextTypeReady is the earliest
+// time one of the skipped tasks becomes ready.
+// Type rate limits are for the whole task queue and are enforced on the root partition, so on
+// child partitions, tasks of a limited type are only matched with the task forwarder. They're
+// forwarded even if forwarding is otherwise paused, since they can't be dispatched locally.
 // call with lock held
 // nolint:revive // will improve later
-func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPoller) {
+func (d *matcherData) findMatch(allowForwarding bool, now int64) (_ *internalTask, _ *waitingPoller, nextTypeReady int64) {
 	// TODO(pri): optimize so it's not O(d*n) worst case
 	// TODO(pri): this iterates over heap as slice, which isn't quite correct, but okay for now
 	for _, task := range d.tasks.heap {
 		if !allowForwarding && task.isPollForwarder {
 			continue
 		}
+		limited, ready := d.tasks.typeLimit(task)
+		mustForward := limited && d.tasks.forwardLimitedTypes
+		if limited && !mustForward && ready > now {
+			if nextTypeReady == 0 || ready < nextTypeReady {
+				nextTypeReady = ready
+			}
+			continue
+		}
 
 		for _, poller := range d.pollers.heap {
 			// can't match cases:
@@ -424,16 +591,18 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 				continue
 			} else if task.isPollForwarder && poller.forwardCtx == nil {
 				continue
-			} else if poller.isTaskForwarder && !allowForwarding {
+			} else if mustForward && (!poller.isTaskForwarder || poller.isTaskValidator) {
+				continue
+			} else if poller.isTaskForwarder && !allowForwarding && !mustForward {
 				continue
 			} else if poller.isTaskValidator && task.forwardCtx != nil {
 				continue
 			}
 
-			return task, poller
+			return task, poller, 0
 		}
 	}
-	return nil, nil
+	return nil, nil, nextTypeReady
 }
 
 // call with lock held
@@ -478,8 +647,13 @@ func (d *matcherData) findAndWakeMatches() {
 
 	for {
 		// search for highest priority match
-		task, poller := d.findMatch(allowForwarding)
+		task, poller, nextTypeReady := d.findMatch(allowForwarding, now)
 		if task == nil || poller == nil {
+			if nextTypeReady > 0 && len(d.pollers.heap) > 0 {
+				// tasks are only held back by their type rate limit, try again when one is ready
+				d.rateLimitTimer.set(d.timeSource, d.rematchAfterTimer, time.Duration(nextTypeReady-now))
+				return
+			}
 			// no more current matches, stop rate limit timer if was running
 			d.rateLimitTimer.unset()
 			return
@@ -493,7 +667,7 @@ func (d *matcherData) findAndWakeMatches() {
 		}
 
 		// ready to signal match
-		d.tasks.Remove(task)
+		d.tasks.RemoveMatched(task)
 		d.pollers.Remove(poller)
 
 		// TODO(pri): maybe we can allow tasks to have costs other than 1
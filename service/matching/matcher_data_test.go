@@ -387,6 +387,177 @@ func (s *MatcherDataSuite) TestOrder() {
 	// poll forwarder is last to match, but it does a half-match so we won't see it here
 }
 
+func (s *MatcherDataSuite) newBacklogTaskWithFairnessKey(id int64, key string) *internalTask {
+	t := s.newBacklogTask(id, 0, nil)
+	t.event.Data.FairnessKey = key
+	return t
+}
+
+func (s *MatcherDataSuite) enableFairness(weights map[string]float64) {
+	s.md.tasks.fairness.enabled = func() bool { return true }
+	s.md.tasks.fairness.weights = func() map[string]float64 { return weights }
+}
+
+func (s *MatcherDataSuite) TestFairnessOrder() {
+	s.enableFairness(nil)
+
+	// a burst for key "a" is enqueued before the tasks for key "b"
+	var tasks []*internalTask
+	for i := range 4 {
+		tasks = append(tasks, s.newBacklogTaskWithFairnessKey(int64(i+1), "a"))
+	}
+	for i := range 2 {
+		tasks = append(tasks, s.newBacklogTaskWithFairnessKey(int64(i+5), "b"))
+	}
+	for _, t := range tasks {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	for _, id := range []int64{1, 5, 2, 6, 3, 4} {
+		s.Equal(id, s.pollFakeTime(time.Second).task.event.TaskId)
+	}
+}
+
+func (s *MatcherDataSuite) TestFairnessWeights() {
+	s.enableFairness(map[string]float64{"a": 2})
+	for i := range 4 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(i+1), "a"))
+	}
+	for i := range 2 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(int64(i+5), "b"))
+	}
+
+	// "a" gets two dispatches for every one of "b", ties are broken by task id
+	for _, id := range []int64{1, 2, 5, 3, 4, 6} {
+		s.Equal(id, s.pollFakeTime(time.Second).task.event.TaskId)
+	}
+}
+
+func (s *MatcherDataSuite) TestFairnessPriorityFirst() {
+	s.enableFairness(nil)
+	t1 := s.newBacklogTaskWithFairnessKey(1, "a")
+	t1.event.Data.Priority = &commonpb.Priority{PriorityKey: 3}
+	t2 := s.newBacklogTaskWithFairnessKey(2, "a")
+	t2.event.Data.Priority = &commonpb.Priority{PriorityKey: 1}
+	t3 := s.newBacklogTaskWithFairnessKey(3, "b")
+	t3.event.Data.Priority = &commonpb.Priority{PriorityKey: 3}
+
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+	s.md.EnqueueTaskNoWait(t3)
+
+	s.Equal(t2, s.pollFakeTime(time.Second).task)
+	s.Equal(t1, s.pollFakeTime(time.Second).task)
+	s.Equal(t3, s.pollFakeTime(time.Second).task)
+}
+
+func (s *MatcherDataSuite) TestFairnessUnmatchedSyncTaskDoesNotConsumePass() {
+	s.enableFairness(nil)
+	for range 3 {
+		t := s.newSyncTask(nil)
+		t.event.Data.FairnessKey = "a"
+		canSyncMatch, gotSyncMatch := s.md.MatchTaskImmediately(t)
+		s.True(canSyncMatch)
+		s.False(gotSyncMatch)
+	}
+
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(2, "b"))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1, "a"))
+
+	s.Equal(int64(1), s.pollFakeTime(time.Second).task.event.TaskId)
+	s.Equal(int64(2), s.pollFakeTime(time.Second).task.event.TaskId)
+}
+
+func (s *MatcherDataSuite) TestFairnessEvictsIdleKeys() {
+	s.enableFairness(nil)
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1, "a"))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(2, "b"))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(3, "a"))
+
+	s.Equal(int64(1), s.pollFakeTime(time.Second).task.event.TaskId)
+	s.Equal(int64(2), s.pollFakeTime(time.Second).task.event.TaskId)
+	s.NotContains(s.md.tasks.fairness.lastPass, "b", "key with no queued tasks should be evicted")
+	s.Contains(s.md.tasks.fairness.lastPass, "a")
+
+	s.Equal(int64(3), s.pollFakeTime(time.Second).task.event.TaskId)
+	s.Empty(s.md.tasks.fairness.lastPass)
+	s.Empty(s.md.tasks.fairness.queued)
+}
+
+func (s *MatcherDataSuite) newBacklogTaskWithTypeName(id int64, typeName string) *internalTask {
+	t := s.newBacklogTask(id, 0, nil)
+	t.event.Data.TypeName = typeName
+	return t
+}
+
+// asRoot makes s.md behave like the root partition, which enforces type rate limits.
+func (s *MatcherDataSuite) asRoot() {
+	s.md.canForward = false
+	s.md.tasks.forwardLimitedTypes = false
+}
+
+func (s *MatcherDataSuite) TestTypeRateLimit() {
+	s.asRoot()
+	s.md.UpdateTypeRateLimits(map[string]float64{"slow": 1}, 0)
+	for i := range 3 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithTypeName(int64(i+1), "slow"))
+	}
+	for i := range 2 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithTypeName(int64(i+4), "fast"))
+	}
+
+	// the first "slow" task uses up its type's limit, the other types are not held back
+	for _, id := range []int64{1, 4, 5} {
+		s.Equal(id, s.pollFakeTime(time.Second).task.event.TaskId)
+	}
+
+	// the next "slow" task is dispatched once the limit allows it
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- s.pollFakeTime(10 * time.Second) }()
+	s.waitForPollers(1)
+	s.ts.Advance(500 * time.Millisecond)
+	gosched(3)
+	s.Empty(resC)
+	s.ts.Advance(600 * time.Millisecond)
+	s.Equal(int64(2), (<-resC).task.event.TaskId)
+}
+
+func (s *MatcherDataSuite) TestTypeRateLimitRemoved() {
+	s.asRoot()
+	s.md.UpdateTypeRateLimits(map[string]float64{"slow": 0.001}, 0)
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithTypeName(1, "slow"))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithTypeName(2, "slow"))
+	s.Equal(int64(1), s.pollFakeTime(time.Second).task.event.TaskId)
+
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- s.pollFakeTime(10 * time.Second) }()
+	s.waitForPollers(1)
+
+	// removing the limit wakes the waiting poller right away
+	s.md.UpdateTypeRateLimits(nil, 0)
+	s.Equal(int64(2), (<-resC).task.event.TaskId)
+}
+
+func (s *MatcherDataSuite) TestTypeRateLimitForwardedOnChild() {
+	s.md.UpdateTypeRateLimits(map[string]float64{"slow": 1000}, 0)
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithTypeName(1, "slow"))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithTypeName(2, "fast"))
+
+	// the limited type is not dispatched locally even though its limit allows it
+	s.Equal(int64(2), s.pollFakeTime(time.Second).task.event.TaskId)
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- s.pollFakeTime(time.Second) }()
+	s.waitForPollers(1)
+	gosched(3)
+	s.Empty(resC)
+
+	// but it's forwarded to the root, which enforces the limit
+	res := s.md.EnqueuePollerAndWait(nil, &waitingPoller{isTaskForwarder: true})
+	s.Equal(int64(1), res.task.event.TaskId)
+	s.ts.Advance(2 * time.Second)
+	s.Nil((<-resC).task)
+}
+
 func (s *MatcherDataSuite) TestPollForwardSuccess() {
 	t1 := s.newBacklogTask(1, 0, nil)
 	t2 := s.newBacklogTask(2, 0, nil)
@@ -31,12 +31,16 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/pborman/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	otelnoop "go.opentelemetry.io/otel/trace/noop"
 	commonpb "go.temporal.io/api/common/v1"
 	deploymentpb "go.temporal.io/api/deployment/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -74,6 +78,7 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/stream_batcher"
 	"go.temporal.io/server/common/tasktoken"
+	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/util"
@@ -81,6 +86,8 @@ import (
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/workerdeployment"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -94,6 +101,7 @@ const (
 	versioningPollerSeenWindow        = 70 * time.Second
 	recordTaskStartedDefaultTimeout   = 10 * time.Second
 	recordTaskStartedSyncMatchTimeout = 1 * time.Second
+	defaultListBacklogTasksPageSize   = 100
 )
 
 type (
@@ -112,6 +120,7 @@ type (
 		taskQueueMetadata         *taskqueuepb.TaskQueueMetadata
 		workerVersionCapabilities *commonpb.WorkerVersionCapabilities
 		deploymentOptions         *deploymentpb.WorkerDeploymentOptions
+		workerMetadata            *taskqueuespb.WorkerMetadata
 		forwardedFrom             string
 		localPollStartTime        time.Time
 	}
@@ -153,6 +162,7 @@ type (
 		saMapperProvider              searchattribute.MapperProvider
 		saProvider                    searchattribute.Provider
 		metricsHandler                metrics.Handler
+		tracer                        trace.Tracer
 		partitionsLock                sync.RWMutex // locks mutation of partitions
 		partitions                    map[tqid.PartitionKey]taskQueuePartitionManager
 		gaugeMetrics                  gaugeMetrics // per-namespace task queue counters
@@ -234,6 +244,7 @@ func NewEngine(
 	saProvider searchattribute.Provider,
 	saMapperProvider searchattribute.MapperProvider,
 	rateLimiter TaskDispatchRateLimiter,
+	tracerProvider trace.TracerProvider,
 ) Engine {
 	scopedMetricsHandler := metricsHandler.WithTags(metrics.OperationTag(metrics.MatchingEngineScope))
 	e := &matchingEngineImpl{
@@ -259,6 +270,7 @@ func NewEngine(
 		saProvider:                    saProvider,
 		saMapperProvider:              saMapperProvider,
 		metricsHandler:                scopedMetricsHandler,
+		tracer:                        tracerProvider.Tracer(telemetry.ComponentMatchingDispatch),
 		partitions:                    make(map[tqid.PartitionKey]taskQueuePartitionManager),
 		gaugeMetrics: gaugeMetrics{
 			loadedTaskQueueFamilyCount:    make(map[taskQueueCounterKey]int),
@@ -535,6 +547,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	} else if sticky && !stickyWorkerAvailable(pm) {
 		return "", false, serviceerrors.NewStickyWorkerUnavailable()
 	}
+	setPartitionCountHeaders(ctx, pm)
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
 	var expirationTime *timestamppb.Timestamp
@@ -553,6 +566,9 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.GetFairnessKey(),
+		TypeName:         addRequest.GetWorkflowType().GetName(),
+		TraceContext:     telemetry.InjectTraceContext(ctx),
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -574,12 +590,14 @@ func (e *matchingEngineImpl) AddActivityTask(
 	if err != nil {
 		return "", false, err
 	}
+	setPartitionCountHeaders(ctx, pm)
 
 	var expirationTime *timestamppb.Timestamp
 	now := time.Now().UTC()
 	expirationDuration := timestamp.DurationValue(addRequest.GetScheduleToStartTimeout())
 	if expirationDuration != 0 {
-		expirationTime = timestamppb.New(now.Add(expirationDuration))
+		// schedule-to-start of a delayed task counts from its not-before time
+		expirationTime = timestamppb.New(util.MaxTime(now, addRequest.GetNotBeforeTime().AsTime()).Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
 		NamespaceId:      addRequest.NamespaceId,
@@ -592,6 +610,10 @@ func (e *matchingEngineImpl) AddActivityTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.GetFairnessKey(),
+		TypeName:         addRequest.GetActivityType().GetName(),
+		NotBeforeTime:    addRequest.GetNotBeforeTime(),
+		TraceContext:     telemetry.InjectTraceContext(ctx),
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -637,6 +659,7 @@ pollLoop:
 		pollMetadata := &pollMetadata{
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			deploymentOptions:         request.DeploymentOptions,
+			workerMetadata:            req.GetWorkerMetadata(),
 			forwardedFrom:             req.GetForwardedSource(),
 		}
 		task, versionSetUsed, err := e.pollTask(pollerCtx, partition, pollMetadata)
@@ -904,6 +927,7 @@ pollLoop:
 			taskQueueMetadata:         request.TaskQueueMetadata,
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			deploymentOptions:         request.DeploymentOptions,
+			workerMetadata:            req.GetWorkerMetadata(),
 			forwardedFrom:             req.GetForwardedSource(),
 		}
 		task, versionSetUsed, err := e.pollTask(pollerCtx, partition, pollMetadata)
@@ -1297,7 +1321,29 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	if err != nil {
 		return nil, err
 	}
-	return pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus())
+	resp, err := pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus())
+	if err != nil {
+		return nil, err
+	}
+	if pm.Partition().Kind() == enumspb.TASK_QUEUE_KIND_NORMAL {
+		if userData, _, err := pm.GetUserDataManager().GetUserData(); err == nil {
+			resp.PartitionScaling = userData.GetData().GetPerType()[int32(pm.Partition().TaskType())].GetPartitionScaling()
+		}
+	}
+	return resp, nil
+}
+
+// setPartitionCountHeaders tells the matching client the partition counts currently in use for the task queue, so
+// that its load balancer follows partition auto-scaling. It is a no-op outside a gRPC call.
+func setPartitionCountHeaders(ctx context.Context, pm taskQueuePartitionManager) {
+	if pm.Partition().Kind() != enumspb.TASK_QUEUE_KIND_NORMAL {
+		return
+	}
+	read, write := pm.PartitionCounts()
+	_ = grpc.SetHeader(ctx, metadata.Pairs(
+		headers.ReadPartitionsHeaderName, strconv.Itoa(read),
+		headers.WritePartitionsHeaderName, strconv.Itoa(write),
+	))
 }
 
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
@@ -1813,6 +1859,203 @@ func (e *matchingEngineImpl) SyncDeploymentUserData(
 	return &matchingservice.SyncDeploymentUserDataResponse{Version: version}, nil
 }
 
+func (e *matchingEngineImpl) UpdateTaskQueueConfig(
+	ctx context.Context,
+	req *matchingservice.UpdateTaskQueueConfigRequest,
+) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+	taskQueueType := req.GetTaskQueueType()
+	if taskQueueType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return nil, serviceerror.NewInvalidArgument("type rate limits are only supported for workflow and activity task queues")
+	}
+	for typeName, limit := range req.GetSetTypeRateLimits() {
+		if typeName == "" {
+			return nil, serviceerror.NewInvalidArgument("type rate limit must specify a type name")
+		}
+		if rps := limit.GetRequestsPerSecond(); rps <= 0 || math.IsInf(rps, 0) || math.IsNaN(rps) {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid requests per second for type %q: %v", typeName, rps))
+		}
+	}
+	if len(req.GetSetTypeRateLimits()) > 0 {
+		// Only the priority matcher enforces type rate limits, don't accept limits that would
+		// be silently ignored.
+		ns, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(req.GetNamespaceId()))
+		if err != nil {
+			return nil, err
+		}
+		newMatcher, cancel := e.config.NewMatcher(ns.String(), req.GetTaskQueue(), taskQueueType, func(bool) {})
+		cancel()
+		if !newMatcher {
+			return nil, serviceerror.NewFailedPrecondition("type rate limits require the priority matcher (matching.useNewMatcher)")
+		}
+	}
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(req.GetNamespaceId(), req.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, _, err := e.getTaskQueuePartitionManager(ctx, taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(), true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOptions := UserDataUpdateOptions{Source: "UpdateTaskQueueConfig"}
+	var limits map[string]*persistencespb.TaskTypeRateLimit
+
+	_, err = tqMgr.GetUserDataManager().UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		limits = data.GetPerType()[int32(taskQueueType)].GetTypeRateLimits()
+		changed := false
+		updated := make(map[string]*persistencespb.TaskTypeRateLimit, len(limits))
+		for typeName, limit := range limits {
+			updated[typeName] = limit
+		}
+		for _, typeName := range req.GetUnsetTypeRateLimits() {
+			if _, ok := updated[typeName]; ok {
+				delete(updated, typeName)
+				changed = true
+			}
+		}
+		for typeName, limit := range req.GetSetTypeRateLimits() {
+			if existing, ok := updated[typeName]; !ok || existing.GetRequestsPerSecond() != limit.GetRequestsPerSecond() {
+				updated[typeName] = limit
+				changed = true
+			}
+		}
+		if !changed {
+			return nil, false, errUserDataUnmodified
+		}
+
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(e.clusterMeta.GetClusterID())
+		}
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		if data.PerType[int32(taskQueueType)] == nil {
+			data.PerType[int32(taskQueueType)] = &persistencespb.TaskQueueTypeUserData{}
+		}
+		data.PerType[int32(taskQueueType)].TypeRateLimits = updated
+		data.Clock = hlc.Next(clk, e.timeSource)
+		limits = updated
+		return data, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateTaskQueueConfigResponse{TypeRateLimits: limits}, nil
+}
+
+func (e *matchingEngineImpl) ListBacklogTasks(
+	ctx context.Context,
+	req *matchingservice.ListBacklogTasksRequest,
+) (*matchingservice.ListBacklogTasksResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), req.GetNamespaceId())
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherRead)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListBacklogTasksPageSize
+	}
+	filter := func(t *persistencespb.AllocatedTaskInfo) bool {
+		return (req.GetWorkflowId() == "" || t.GetData().GetWorkflowId() == req.GetWorkflowId()) &&
+			(req.GetTypeName() == "" || t.GetData().GetTypeName() == req.GetTypeName())
+	}
+	resp, err := pm.ListBacklogTasks(ctx, req.GetBuildId(), int(req.GetSubqueue()), pageSize, req.GetNextPageToken(), filter)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.ListBacklogTasksResponse{
+		Tasks:         resp.Tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (e *matchingEngineImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	req *matchingservice.DeleteBacklogTasksRequest,
+) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	if req.GetInclusiveMinTaskId() < 0 || req.GetInclusiveMinTaskId() >= req.GetExclusiveMaxTaskId() {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid task id range [%d, %d)",
+			req.GetInclusiveMinTaskId(), req.GetExclusiveMaxTaskId()))
+	}
+	partition := tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), req.GetNamespaceId())
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	err = pm.DeleteBacklogTasks(ctx, req.GetBuildId(), req.GetInclusiveMinTaskId(), req.GetExclusiveMaxTaskId())
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.DeleteBacklogTasksResponse{}, nil
+}
+
+func (e *matchingEngineImpl) ListTaskQueueWorkers(
+	ctx context.Context,
+	req *matchingservice.ListTaskQueueWorkersRequest,
+) (*matchingservice.ListTaskQueueWorkersResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), req.GetNamespaceId())
+	if !req.GetFanOut() {
+		pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseDescribe)
+		if err != nil {
+			return nil, err
+		}
+		return &matchingservice.ListTaskQueueWorkersResponse{
+			Workers: dedupWorkers(pm.GetAllWorkerInfo()),
+		}, nil
+	}
+
+	if !partition.IsRoot() || partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil, serviceerror.NewInvalidArgument("ListTaskQueueWorkers must be called on the root partition of a normal task queue if fan_out is set")
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	tqConfig := newTaskQueueConfig(partition.TaskQueue(), e.config, nsName)
+	numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
+
+	var workers []*taskqueuespb.WorkerInfo
+	for i := 0; i < numPartitions; i++ {
+		partitionResp, err := e.matchingRawClient.ListTaskQueueWorkers(ctx, &matchingservice.ListTaskQueueWorkersRequest{
+			NamespaceId: req.GetNamespaceId(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     partition.TaskQueue().Name(),
+				TaskQueueType: partition.TaskType(),
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		workers = append(workers, partitionResp.GetWorkers()...)
+	}
+	return &matchingservice.ListTaskQueueWorkersResponse{
+		Workers: dedupWorkers(workers),
+	}, nil
+}
+
+// dedupWorkers merges worker infos by identity, keeping the most recently reported one.
+func dedupWorkers(workers []*taskqueuespb.WorkerInfo) []*taskqueuespb.WorkerInfo {
+	byIdentity := make(map[string]int)
+	var list []*taskqueuespb.WorkerInfo
+	for _, item := range workers {
+		idx, ok := byIdentity[item.GetIdentity()]
+		if !ok {
+			byIdentity[item.GetIdentity()] = len(list)
+			list = append(list, item)
+			continue
+		}
+		if item.GetLastAccessTime().AsTime().After(list[idx].GetLastAccessTime().AsTime()) {
+			list[idx] = item
+		}
+	}
+	return list
+}
+
 func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 	ctx context.Context,
 	req *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest,
@@ -2107,6 +2350,7 @@ pollLoop:
 		pollMetadata := &pollMetadata{
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			deploymentOptions:         request.DeploymentOptions,
+			workerMetadata:            req.GetWorkerMetadata(),
 			forwardedFrom:             req.GetForwardedSource(),
 		}
 		task, _, err := e.pollTask(pollerCtx, partition, pollMetadata)
@@ -2368,6 +2612,7 @@ func (e *matchingEngineImpl) pollTask(
 	if err != nil {
 		return nil, false, err
 	}
+	setPartitionCountHeaders(ctx, pm)
 
 	pollMetadata.localPollStartTime = e.timeSource.Now()
 
@@ -2637,7 +2882,7 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 	ctx context.Context,
 	pollReq *workflowservice.PollWorkflowTaskQueueRequest,
 	task *internalTask,
-) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
+) (_ *historyservice.RecordWorkflowTaskStartedResponse, retErr error) {
 	if e.rateLimiter != nil {
 		err := e.rateLimiter.Wait(ctx, quotas.Request{
 			API:        "RecordWorkflowTaskStarted",
@@ -2653,6 +2898,9 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 	ctx, cancel := newRecordTaskStartedContext(ctx, task)
 	defer cancel()
 
+	ctx, span := e.startDispatchSpan(ctx, "matching.RecordWorkflowTaskStarted", task)
+	defer func() { endDispatchSpan(span, retErr) }()
+
 	recordStartedRequest := &historyservice.RecordWorkflowTaskStartedRequest{
 		NamespaceId:         task.event.Data.GetNamespaceId(),
 		WorkflowExecution:   task.workflowExecution(),
@@ -2687,7 +2935,7 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	ctx context.Context,
 	pollReq *workflowservice.PollActivityTaskQueueRequest,
 	task *internalTask,
-) (*historyservice.RecordActivityTaskStartedResponse, error) {
+) (_ *historyservice.RecordActivityTaskStartedResponse, retErr error) {
 	if e.rateLimiter != nil {
 		err := e.rateLimiter.Wait(ctx, quotas.Request{
 			API:        "RecordActivityTaskStarted",
@@ -2703,6 +2951,9 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	ctx, cancel := newRecordTaskStartedContext(ctx, task)
 	defer cancel()
 
+	ctx, span := e.startDispatchSpan(ctx, "matching.RecordActivityTaskStarted", task)
+	defer func() { endDispatchSpan(span, retErr) }()
+
 	recordStartedRequest := &historyservice.RecordActivityTaskStartedRequest{
 		NamespaceId:         task.event.Data.GetNamespaceId(),
 		WorkflowExecution:   task.workflowExecution(),
@@ -2720,6 +2971,41 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	return e.historyClient.RecordActivityTaskStarted(ctx, recordStartedRequest)
 }
 
+// startDispatchSpan starts a span for handing the task to a poller. The span continues the trace the task was added
+// in and links the trace of the poll, so the processing of the task in history can be followed from the trace that
+// scheduled it. If the task has no trace context, the span is a child of the poll.
+func (e *matchingEngineImpl) startDispatchSpan(
+	ctx context.Context,
+	name string,
+	task *internalTask,
+) (context.Context, trace.Span) {
+	if !telemetry.IsEnabled(e.tracer) {
+		return ctx, otelnoop.Span{}
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.Key(telemetry.NamespaceKey).String(task.namespace.String()),
+			attribute.Key(telemetry.WorkflowIDKey).String(task.event.Data.GetWorkflowId()),
+			attribute.Key(telemetry.WorkflowRunIDKey).String(task.event.Data.GetRunId())),
+	}
+	if sc := telemetry.ExtractTraceContext(task.event.Data.GetTraceContext()); sc.IsValid() {
+		if pollSpan := trace.SpanContextFromContext(ctx); pollSpan.IsValid() {
+			opts = append(opts, trace.WithLinks(trace.Link{SpanContext: pollSpan}))
+		}
+		ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+	return e.tracer.Start(ctx, name, opts...)
+}
+
+func endDispatchSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
 // newRecordTaskStartedContext creates a context for recording
 // activity or workflow task started. The parentCtx from
 // pollActivity/WorkflowTaskQueue endpoint (which is a long poll
@@ -27,6 +27,7 @@ package matching
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -79,6 +80,8 @@ import (
 	"go.temporal.io/server/common/quotas"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/tasktoken"
+	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testlogger"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/worker_versioning"
@@ -241,6 +244,7 @@ func newMatchingEngine(
 		logger:                        logger,
 		throttledLogger:               log.ThrottledLogger(logger),
 		metricsHandler:                metrics.NoopMetricsHandler,
+		tracer:                        telemetry.NoopTracer,
 		matchingRawClient:             mockMatchingClient,
 		tokenSerializer:               tasktoken.NewSerializer(),
 		config:                        config,
@@ -286,6 +290,45 @@ func (s *matchingEngineSuite) TestPollWorkflowTaskQueuesEmptyResultWithShortCont
 	s.PollForTasksEmptyResultTest(callContext, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 }
 
+func (s *matchingEngineSuite) TestListTaskQueueWorkers() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(10 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	workerMetadata := &taskqueuespb.WorkerMetadata{
+		HostName:       "toaster-host",
+		SdkName:        "temporal-go",
+		SlotsReported:  true,
+		AvailableSlots: 5,
+		UsedSlots:      2,
+	}
+
+	pollResp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "selfDrivingToaster",
+		},
+		WorkerMetadata: workerMetadata,
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.Equal(emptyPollActivityTaskQueueResponse, pollResp)
+
+	resp, err := s.matchingEngine.ListTaskQueueWorkers(context.Background(), &matchingservice.ListTaskQueueWorkersRequest{
+		NamespaceId: namespaceId,
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     tl,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 0},
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.GetWorkers(), 1)
+	s.Equal("selfDrivingToaster", resp.GetWorkers()[0].GetIdentity())
+	protorequire.ProtoEqual(s.T(), workerMetadata, resp.GetWorkers()[0].GetMetadata())
+}
+
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Context, taskType enumspb.TaskQueueType) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
 	if _, ok := callContext.Deadline(); !ok {
@@ -995,6 +1038,375 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.True(expectedRange <= s.taskManager.getQueueManagerByKey(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestListAndDeleteBacklogTasks() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	partition := &taskqueuespb.TaskQueuePartition{TaskQueue: tl, TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+
+	const taskCount = 5
+	for i := 0; i < taskCount; i++ {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceId,
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: fmt.Sprintf("wf%d", i)},
+			ScheduledEventId:       int64(i),
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			ActivityType:           &commonpb.ActivityType{Name: fmt.Sprintf("type%d", i%2)},
+		})
+		s.NoError(err)
+	}
+
+	listResp, err := s.matchingEngine.ListBacklogTasks(context.Background(), &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		PageSize:           taskCount,
+	})
+	s.NoError(err)
+	s.Len(listResp.Tasks, taskCount)
+
+	listResp, err = s.matchingEngine.ListBacklogTasks(context.Background(), &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		TypeName:           "type1",
+		PageSize:           taskCount,
+	})
+	s.NoError(err)
+	s.Len(listResp.Tasks, 2)
+
+	// filters are applied before the page size
+	listResp, err = s.matchingEngine.ListBacklogTasks(context.Background(), &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		TypeName:           "type1",
+		PageSize:           2,
+	})
+	s.NoError(err)
+	s.Len(listResp.Tasks, 2)
+
+	listResp, err = s.matchingEngine.ListBacklogTasks(context.Background(), &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		WorkflowId:         "wf1",
+		PageSize:           taskCount,
+	})
+	s.NoError(err)
+	s.Len(listResp.Tasks, 1)
+	deletedID := listResp.Tasks[0].TaskId
+
+	_, err = s.matchingEngine.DeleteBacklogTasks(context.Background(), &matchingservice.DeleteBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		InclusiveMinTaskId: deletedID,
+		ExclusiveMaxTaskId: deletedID,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.matchingEngine.DeleteBacklogTasks(context.Background(), &matchingservice.DeleteBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		InclusiveMinTaskId: deletedID,
+		ExclusiveMaxTaskId: deletedID + 1,
+	})
+	s.NoError(err)
+
+	// deleted tasks aren't listed
+	listResp, err = s.matchingEngine.ListBacklogTasks(context.Background(), &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition,
+		PageSize:           taskCount,
+	})
+	s.NoError(err)
+	s.Len(listResp.Tasks, taskCount-1)
+	for _, t := range listResp.Tasks {
+		s.NotEqual(deletedID, t.TaskId)
+	}
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordActivityTaskStartedRequest, arg2 ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:             "activityId1",
+						TaskQueue:              taskQueue,
+						ActivityType:           &commonpb.ActivityType{Name: "activity1"},
+						ScheduleToCloseTimeout: durationpb.New(100 * time.Second),
+						StartToCloseTimeout:    durationpb.New(50 * time.Second),
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).AnyTimes()
+
+	var polled []string
+	s.Eventually(func() bool {
+		result, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceId,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		if len(result.GetTaskToken()) > 0 {
+			polled = append(polled, result.WorkflowExecution.GetWorkflowId())
+		}
+		return len(polled) == taskCount-1
+	}, 10*time.Second, time.Millisecond)
+	s.ElementsMatch([]string{"wf0", "wf2", "wf3", "wf4"}, polled)
+}
+
+func (s *matchingEngineSuite) TestAddDelayedActivityTask() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	tl := "makeToast"
+	tlID := newUnversionedRootQueueKey(namespaceId, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	workflowExecution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordActivityTaskStartedRequest, arg2 ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:             "activityId1",
+						TaskQueue:              taskQueue,
+						ActivityType:           &commonpb.ActivityType{Name: "activity1"},
+						ScheduleToCloseTimeout: durationpb.New(100 * time.Second),
+						StartToCloseTimeout:    durationpb.New(50 * time.Second),
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).AnyTimes()
+
+	poll := func() *matchingservice.PollActivityTaskQueueResponse {
+		result, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceId,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		return result
+	}
+
+	// start a poller so the task would be sync matched if it weren't delayed
+	pollerDone := make(chan *matchingservice.PollActivityTaskQueueResponse, 1)
+	go func() { pollerDone <- poll() }()
+	time.Sleep(20 * time.Millisecond) //nolint:forbidigo // let the poller start waiting
+
+	notBefore := time.Now().Add(500 * time.Millisecond)
+	_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              workflowExecution,
+		ScheduledEventId:       3,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		NotBeforeTime:          timestamppb.New(notBefore),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+	s.Empty((<-pollerDone).GetTaskToken())
+
+	s.Eventually(func() bool {
+		result := poll()
+		if len(result.GetTaskToken()) == 0 {
+			return false
+		}
+		s.False(time.Now().Before(notBefore))
+		s.Equal("activityId1", result.ActivityId)
+		return true
+	}, 10*time.Second, time.Millisecond)
+}
+
+func (s *matchingEngineSuite) TestDelayedActivityTasksPastLimit() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+	s.matchingEngine.config.MaxDelayedBacklogTasks = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(1)
+
+	namespaceId := uuid.New()
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordActivityTaskStartedRequest, arg2 ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:             taskRequest.WorkflowExecution.GetWorkflowId(),
+						TaskQueue:              taskQueue,
+						ActivityType:           &commonpb.ActivityType{Name: "activity1"},
+						ScheduleToCloseTimeout: durationpb.New(100 * time.Second),
+						StartToCloseTimeout:    durationpb.New(50 * time.Second),
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).AnyTimes()
+
+	add := func(workflowID string, notBefore time.Time) {
+		req := &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceId,
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: workflowID},
+			ScheduledEventId:       3,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		}
+		if !notBefore.IsZero() {
+			req.NotBeforeTime = timestamppb.New(notBefore)
+		}
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), req)
+		s.NoError(err)
+	}
+	pollUntil := func(activityID string) {
+		s.Eventually(func() bool {
+			result, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+				NamespaceId: namespaceId,
+				PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+					TaskQueue: taskQueue,
+					Identity:  "nobody",
+				},
+			}, metrics.NoopMetricsHandler)
+			s.NoError(err)
+			if len(result.GetTaskToken()) == 0 {
+				return false
+			}
+			s.Equal(activityID, result.ActivityId)
+			return true
+		}, 10*time.Second, time.Millisecond)
+	}
+
+	// Only one delayed task fits in memory. The later one is left in persistence and the ready
+	// task behind both of them must still be loaded.
+	add("far", time.Now().Add(time.Hour))
+	time.Sleep(100 * time.Millisecond) //nolint:forbidigo // let the reader load the first task
+	soon := time.Now().Add(500 * time.Millisecond)
+	add("soon", soon)
+	add("ready", time.Time{})
+	pollUntil("ready")
+
+	// The task that's due sooner is held in place of the far one and released on time.
+	pollUntil("soon")
+	s.False(time.Now().Before(soon))
+}
+
+func (s *matchingEngineSuite) TestDelayedActivityTaskSurvivesReload() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	tl := "makeToast"
+	tlID := newUnversionedRootQueueKey(namespaceId, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	s.expectDelayedActivityTaskStarted(taskQueue)
+
+	notBefore := time.Now().Add(time.Second)
+	_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+		ScheduledEventId:       3,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		NotBeforeTime:          timestamppb.New(notBefore),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+	time.Sleep(100 * time.Millisecond) //nolint:forbidigo // let the reader load the task
+
+	// Unload the partition while the task is held in memory. It's reloaded from persistence
+	// by the next poll and still dispatched once it's due.
+	partitionManager, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), tlID.Partition(), false, loadCauseTask)
+	s.NoError(err)
+	s.matchingEngine.unloadTaskQueuePartition(partitionManager, unloadCauseForce)
+
+	s.Eventually(func() bool {
+		result := s.pollDelayedActivityTask(namespaceId, taskQueue)
+		if len(result.GetTaskToken()) == 0 {
+			return false
+		}
+		s.False(time.Now().Before(notBefore))
+		s.Equal("workflow1", result.ActivityId)
+		return true
+	}, 10*time.Second, time.Millisecond)
+}
+
+func (s *matchingEngineSuite) TestDelayedActivityTaskDoesNotPinAckLevel() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+	s.matchingEngine.config.UpdateAckInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(100 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	tl := "makeToast"
+	tlID := newUnversionedRootQueueKey(namespaceId, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	s.expectDelayedActivityTaskStarted(taskQueue)
+
+	add := func(workflowID string, notBefore *timestamppb.Timestamp) {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceId,
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: workflowID},
+			ScheduledEventId:       3,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			NotBeforeTime:          notBefore,
+		})
+		s.NoError(err)
+	}
+	add("far", timestamppb.New(time.Now().Add(time.Hour)))
+	farTaskID, ok := s.taskManager.minTaskID(tlID)
+	s.True(ok)
+	add("ready", nil)
+	s.Eventually(func() bool {
+		return len(s.pollDelayedActivityTask(namespaceId, taskQueue).GetTaskToken()) > 0
+	}, 10*time.Second, time.Millisecond)
+
+	// The far task is written back behind the ready one, so the ack level moves past both
+	// original tasks while the far one is still in the backlog.
+	pqMgr := s.getPhysicalTaskQueueManagerImpl(tlID)
+	s.Eventually(func() bool {
+		return pqMgr.backlogMgr.InternalStatus()[0].AckLevel > farTaskID+1
+	}, 10*time.Second, 10*time.Millisecond)
+	minTaskID, ok := s.taskManager.minTaskID(tlID)
+	s.True(ok)
+	s.Greater(minTaskID, farTaskID)
+	s.Empty(s.pollDelayedActivityTask(namespaceId, taskQueue).GetTaskToken())
+}
+
+func (s *matchingEngineSuite) expectDelayedActivityTaskStarted(taskQueue *taskqueuepb.TaskQueue) {
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordActivityTaskStartedRequest, arg2 ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:             taskRequest.WorkflowExecution.GetWorkflowId(),
+						TaskQueue:              taskQueue,
+						ActivityType:           &commonpb.ActivityType{Name: "activity1"},
+						ScheduleToCloseTimeout: durationpb.New(100 * time.Second),
+						StartToCloseTimeout:    durationpb.New(50 * time.Second),
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).AnyTimes()
+}
+
+func (s *matchingEngineSuite) pollDelayedActivityTask(
+	namespaceId string,
+	taskQueue *taskqueuepb.TaskQueue,
+) *matchingservice.PollActivityTaskQueueResponse {
+	result, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "nobody",
+		},
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	return result
+}
+
 // TODO: this unit test does not seem to belong to matchingEngine, move it to the right place
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	if s.newMatcher {
@@ -2528,6 +2940,67 @@ func (s *matchingEngineSuite) TestUpdateUserData_FailsOnKnownVersionMismatch() {
 	s.ErrorAs(err, &failedPreconditionError)
 }
 
+func (s *matchingEngineSuite) TestUpdateTaskQueueConfig() {
+	namespaceID := namespace.ID(uuid.New())
+	tq := "tupac"
+
+	res, err := s.matchingEngine.UpdateTaskQueueConfig(context.Background(), &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetTypeRateLimits: map[string]*persistencespb.TaskTypeRateLimit{
+			"a": {RequestsPerSecond: 10},
+			"b": {RequestsPerSecond: 20},
+		},
+	})
+	if !s.newMatcher {
+		// the classic matcher doesn't enforce type rate limits
+		var failedPrecondition *serviceerror.FailedPrecondition
+		s.ErrorAs(err, &failedPrecondition)
+		return
+	}
+	s.NoError(err)
+	s.Len(res.TypeRateLimits, 2)
+
+	res, err = s.matchingEngine.UpdateTaskQueueConfig(context.Background(), &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId:         namespaceID.String(),
+		TaskQueue:           tq,
+		TaskQueueType:       enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetTypeRateLimits:   map[string]*persistencespb.TaskTypeRateLimit{"b": {RequestsPerSecond: 5}},
+		UnsetTypeRateLimits: []string{"a"},
+	})
+	s.NoError(err)
+	s.Len(res.TypeRateLimits, 1)
+	s.Equal(5.0, res.TypeRateLimits["b"].GetRequestsPerSecond())
+
+	getRes, err := s.matchingEngine.GetTaskQueueUserData(context.Background(), &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	perType := getRes.GetUserData().GetData().GetPerType()
+	s.Equal(5.0, perType[int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)].GetTypeRateLimits()["b"].GetRequestsPerSecond())
+	s.Empty(perType[int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW)].GetTypeRateLimits())
+
+	_, err = s.matchingEngine.UpdateTaskQueueConfig(context.Background(), &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskQueue:         tq,
+		TaskQueueType:     enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetTypeRateLimits: map[string]*persistencespb.TaskTypeRateLimit{"b": {RequestsPerSecond: 0}},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.matchingEngine.UpdateTaskQueueConfig(context.Background(), &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskQueue:         tq,
+		TaskQueueType:     enumspb.TASK_QUEUE_TYPE_NEXUS,
+		SetTypeRateLimits: map[string]*persistencespb.TaskTypeRateLimit{"b": {RequestsPerSecond: 1}},
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *matchingEngineSuite) TestUnknownBuildId_Match() {
 	namespaceId := uuid.New()
 	tq := "makeToast"
@@ -3738,21 +4211,31 @@ func (m *testTaskManager) GetTasks(
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistencespb.AllocatedTaskInfo
+	var nextPageToken []byte
 
+	minTaskID := request.InclusiveMinTaskID
+	if len(request.NextPageToken) > 0 {
+		minTaskID = int64(binary.BigEndian.Uint64(request.NextPageToken))
+	}
 	it := tlm.tasks.Iterator()
 	for it.Next() {
 		taskID := it.Key().(int64)
-		if taskID < request.InclusiveMinTaskID {
+		if taskID < minTaskID {
 			continue
 		}
 		if taskID >= request.ExclusiveMaxTaskID {
 			break
 		}
+		if request.PageSize > 0 && len(tasks) == request.PageSize {
+			nextPageToken = binary.BigEndian.AppendUint64(nil, uint64(taskID))
+			break
+		}
 		tasks = append(tasks, it.Value().(*persistencespb.AllocatedTaskInfo))
 	}
 	tlm.getTasksCount++
 	return &persistence.GetTasksResponse{
-		Tasks: tasks,
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
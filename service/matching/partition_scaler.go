@@ -0,0 +1,275 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/log/tag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// partitionScaler periodically adjusts the partition counts of the task queues of a family based on their add
+	// and dispatch rates. It runs on the root workflow partition, which owns the user data of the family, and stores
+	// its decisions there so that all partitions and, through them, matching clients pick them up.
+	//
+	// Partitions are added by first raising the read count and then, on the next evaluation, the write count, so
+	// that pollers are on the new partitions before tasks are sent there. Partitions are removed by first lowering
+	// the write count and then, once the removed partitions have no backlog left, the read count.
+	partitionScaler struct {
+		pm        *taskQueuePartitionManagerImpl
+		goroGroup goro.Group
+	}
+
+	partitionCounts struct {
+		read  int32
+		write int32
+	}
+)
+
+// Task queue types whose partitions are auto-scaled.
+var partitionScalerTaskQueueTypes = []enumspb.TaskQueueType{
+	enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+}
+
+func newPartitionScaler(pm *taskQueuePartitionManagerImpl) *partitionScaler {
+	return &partitionScaler{pm: pm}
+}
+
+func (s *partitionScaler) Start() {
+	s.goroGroup.Go(s.run)
+}
+
+func (s *partitionScaler) Stop() {
+	s.goroGroup.Cancel()
+}
+
+func (s *partitionScaler) run(ctx context.Context) error {
+	ctx = s.pm.callerInfoContext(ctx)
+	for {
+		timerC, timer := s.pm.engine.timeSource.NewTimer(s.pm.config.PartitionAutoScalingInterval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timerC:
+		}
+		if !s.pm.config.EnablePartitionAutoScaling() {
+			continue
+		}
+		for _, taskQueueType := range partitionScalerTaskQueueTypes {
+			if err := s.scale(ctx, taskQueueType); err != nil && ctx.Err() == nil {
+				s.pm.logger.Warn("Failed to evaluate partition auto-scaling",
+					tag.NewStringTag("task-queue-type", taskQueueType.String()), tag.Error(err))
+			}
+		}
+	}
+}
+
+func (s *partitionScaler) scale(ctx context.Context, taskQueueType enumspb.TaskQueueType) error {
+	taskQueue := s.pm.partition.TaskQueue().Family().TaskQueue(taskQueueType)
+	tqConfig := newTaskQueueConfig(taskQueue, s.pm.engine.config, s.pm.ns.Name())
+
+	userData, _, err := s.pm.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	scaling := userData.GetData().GetPerType()[int32(taskQueueType)].GetPartitionScaling()
+	current := effectivePartitionCounts(scaling, tqConfig)
+
+	// Look at all configured partitions, not only the ones in use, so that tasks that still end up on removed
+	// partitions are noticed (and loaded, so they can be forwarded to the root partition).
+	rate, backlogs, err := s.collectStats(ctx, taskQueue.Name(), taskQueueType, tqConfig.NumReadPartitions())
+	if err != nil {
+		return err
+	}
+	targetRate := tqConfig.PartitionAutoScalingTargetRate()
+	if targetRate <= 0 {
+		return nil
+	}
+	desired := int32(math.Min(math.Ceil(rate/targetRate), math.MaxInt32))
+	maxCount := int32(min(tqConfig.NumReadPartitions(), tqConfig.NumWritePartitions()))
+	now := s.pm.engine.timeSource.Now()
+	settled := scaling.GetUpdateTime() == nil ||
+		now.Sub(scaling.GetUpdateTime().AsTime()) >= tqConfig.PartitionAutoScalingScaleDownDelay()
+
+	next := nextPartitionCounts(current, desired, maxCount, backlogs, settled)
+	if next == current && scaling != nil {
+		return nil
+	}
+
+	_, err = s.pm.userDataManager.UpdateUserData(ctx, UserDataUpdateOptions{Source: "PartitionAutoScaling"},
+		func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			existing := data.GetPerType()[int32(taskQueueType)].GetPartitionScaling()
+			if existing.GetReadPartitions() == next.read && existing.GetWritePartitions() == next.write {
+				return nil, false, errUserDataUnmodified
+			}
+			clk := data.GetClock()
+			if clk == nil {
+				clk = hlc.Zero(s.pm.engine.clusterMeta.GetClusterID())
+			}
+			data = common.CloneProto(data)
+			if data.PerType == nil {
+				data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+			}
+			if data.PerType[int32(taskQueueType)] == nil {
+				data.PerType[int32(taskQueueType)] = &persistencespb.TaskQueueTypeUserData{}
+			}
+			data.PerType[int32(taskQueueType)].PartitionScaling = &persistencespb.TaskQueuePartitionScaling{
+				ReadPartitions:  next.read,
+				WritePartitions: next.write,
+				UpdateTime:      timestamppb.New(now),
+			}
+			data.Clock = hlc.Next(clk, s.pm.engine.timeSource)
+			// replicate so that the partition counts are known in other clusters after a failover
+			return data, true, nil
+		})
+	if err != nil {
+		return err
+	}
+	s.pm.logger.Info("Partition auto-scaling changed partition counts",
+		tag.NewStringTag("task-queue-type", taskQueueType.String()),
+		tag.NewInt32("read-partitions", next.read),
+		tag.NewInt32("write-partitions", next.write),
+		tag.NewFloat64("task-rate", rate))
+	return nil
+}
+
+// collectStats returns the larger of the total add and dispatch rates across all partitions and versions of a
+// task queue, and the backlog count of each partition. Partitions are described concurrently.
+func (s *partitionScaler) collectStats(
+	ctx context.Context,
+	taskQueueName string,
+	taskQueueType enumspb.TaskQueueType,
+	numPartitions int,
+) (float64, []int64, error) {
+	addRates := make([]float64, numPartitions)
+	dispatchRates := make([]float64, numPartitions)
+	backlogs := make([]int64, numPartitions)
+	errs := make([]error, numPartitions)
+	var wg sync.WaitGroup
+	for i := 0; i < numPartitions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.pm.matchingClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
+				NamespaceId: s.pm.partition.NamespaceId(),
+				TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+					TaskQueue:     taskQueueName,
+					TaskQueueType: taskQueueType,
+					PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+				},
+				Versions:    &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+				ReportStats: true,
+			})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			for _, vii := range resp.GetVersionsInfoInternal() {
+				stats := vii.GetPhysicalTaskQueueInfo().GetTaskQueueStats()
+				addRates[i] += float64(stats.GetTasksAddRate())
+				dispatchRates[i] += float64(stats.GetTasksDispatchRate())
+				backlogs[i] += stats.GetApproximateBacklogCount()
+			}
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return 0, nil, err
+	}
+
+	var addRate, dispatchRate float64
+	for i := 0; i < numPartitions; i++ {
+		addRate += addRates[i]
+		dispatchRate += dispatchRates[i]
+	}
+	return max(addRate, dispatchRate), backlogs, nil
+}
+
+// effectivePartitionCounts returns the partition counts chosen by auto-scaling capped by the configured ones,
+// or the configured ones if auto-scaling has not made a decision yet.
+func effectivePartitionCounts(scaling *persistencespb.TaskQueuePartitionScaling, tqConfig *taskQueueConfig) partitionCounts {
+	maxRead := int32(tqConfig.NumReadPartitions())
+	maxWrite := int32(tqConfig.NumWritePartitions())
+	if scaling == nil {
+		return partitionCounts{read: maxRead, write: maxWrite}
+	}
+	return partitionCounts{
+		read:  min(max(scaling.GetReadPartitions(), 1), maxRead),
+		write: min(max(scaling.GetWritePartitions(), 1), maxWrite),
+	}
+}
+
+// nextPartitionCounts makes one partition auto-scaling step from the current counts towards the desired write
+// partition count. Removing partitions, including dropping drained read partitions, only happens once the
+// previous change has settled. backlogs has the backlog count of every configured partition, including the ones
+// past the current read count.
+func nextPartitionCounts(current partitionCounts, desired, maxCount int32, backlogs []int64, settled bool) partitionCounts {
+	desired = min(max(desired, 1), maxCount)
+	next := current
+	// Tasks can still end up on removed partitions, e.g. sent by clients that didn't see a lower write count yet
+	// or that were spooled before the read count was lowered. Read from those partitions again until they're
+	// drained, otherwise their tasks would be stranded.
+	for i := len(backlogs) - 1; i >= int(current.read); i-- {
+		if backlogs[i] > 0 {
+			next.read = int32(i + 1)
+			return next
+		}
+	}
+	switch {
+	case desired > current.write:
+		if current.read >= desired {
+			next.write = desired
+		} else {
+			next.read = desired
+		}
+	case desired < current.write:
+		if settled {
+			next.write = desired
+		}
+	case current.read > current.write && settled:
+		for i := current.write; i < current.read; i++ {
+			if int(i) >= len(backlogs) || backlogs[i] > 0 {
+				return next
+			}
+		}
+		next.read = current.write
+	}
+	return next
+}
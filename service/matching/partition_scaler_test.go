@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPartitionCounts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  partitionCounts
+		desired  int32
+		backlogs []int64
+		settled  bool
+		expected partitionCounts
+	}{
+		{
+			name:     "unchanged",
+			current:  partitionCounts{read: 2, write: 2},
+			desired:  2,
+			backlogs: []int64{5, 5},
+			settled:  true,
+			expected: partitionCounts{read: 2, write: 2},
+		},
+		{
+			name:     "scale up adds read partitions first",
+			current:  partitionCounts{read: 2, write: 2},
+			desired:  4,
+			backlogs: []int64{0, 0},
+			expected: partitionCounts{read: 4, write: 2},
+		},
+		{
+			name:     "scale up adds write partitions once readable",
+			current:  partitionCounts{read: 4, write: 2},
+			desired:  4,
+			backlogs: []int64{0, 0, 0, 0},
+			expected: partitionCounts{read: 4, write: 4},
+		},
+		{
+			name:     "scale up is capped",
+			current:  partitionCounts{read: 2, write: 2},
+			desired:  100,
+			backlogs: []int64{0, 0},
+			expected: partitionCounts{read: 8, write: 2},
+		},
+		{
+			name:     "scale down waits until settled",
+			current:  partitionCounts{read: 4, write: 4},
+			desired:  1,
+			backlogs: []int64{0, 0, 0, 0},
+			settled:  false,
+			expected: partitionCounts{read: 4, write: 4},
+		},
+		{
+			name:     "scale down removes write partitions first",
+			current:  partitionCounts{read: 4, write: 4},
+			desired:  0,
+			backlogs: []int64{0, 0, 0, 0},
+			settled:  true,
+			expected: partitionCounts{read: 4, write: 1},
+		},
+		{
+			name:     "read partitions are kept while they have backlog",
+			current:  partitionCounts{read: 4, write: 1},
+			desired:  1,
+			backlogs: []int64{0, 0, 3, 0},
+			settled:  true,
+			expected: partitionCounts{read: 4, write: 1},
+		},
+		{
+			name:     "drained read partitions are removed",
+			current:  partitionCounts{read: 4, write: 1},
+			desired:  1,
+			backlogs: []int64{7, 0, 0, 0},
+			settled:  true,
+			expected: partitionCounts{read: 1, write: 1},
+		},
+		{
+			name:     "removed read partitions with backlog are added back",
+			current:  partitionCounts{read: 2, write: 1},
+			desired:  1,
+			backlogs: []int64{0, 0, 0, 4, 0, 0, 0, 0},
+			settled:  true,
+			expected: partitionCounts{read: 4, write: 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, nextPartitionCounts(tc.current, tc.desired, 8, tc.backlogs, tc.settled))
+		})
+	}
+}
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/worker_versioning"
@@ -266,6 +267,7 @@ func (c *physicalTaskQueueManagerImpl) Start() {
 	c.liveness.Start()
 	c.backlogMgr.Start()
 	c.matcher.Start()
+	c.updateTypeRateLimits()
 	c.logger.Info("Started physicalTaskQueueManager", tag.LifeCycleStarted, tag.Cause(c.config.loadCause.String()))
 	c.metricsHandler.Counter(metrics.TaskQueueStartedCounter.Name()).Record(1)
 	c.partitionMgr.engine.updatePhysicalTaskQueueGauge(c.partitionMgr.ns, c.partitionMgr.partition, c.queue.version, 1)
@@ -363,6 +365,11 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 			task.finish(nil, false)
 			continue
 		}
+		// Same for tasks that were deleted from the backlog while in memory.
+		if task.isDeletedFromBacklog() {
+			task.finish(nil, false)
+			continue
+		}
 
 		task.namespace = c.partitionMgr.ns.Name()
 		task.backlogCountHint = c.backlogCountHint
@@ -418,9 +425,29 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTas
 }
 
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
+	c.updateTypeRateLimits()
 	c.matcher.ReprocessAllTasks()
 }
 
+// updateTypeRateLimits applies the per-type dispatch rate limits from user data to the
+// matcher. Only the priority matcher enforces them.
+func (c *physicalTaskQueueManagerImpl) updateTypeRateLimits() {
+	if c.priMatcher == nil || c.queue.Partition().Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	userData, _, err := c.partitionMgr.GetUserDataManager().GetUserData()
+	if err != nil {
+		// user data not loaded yet, limits will be applied when it changes
+		return
+	}
+	limits := userData.GetData().GetPerType()[int32(c.queue.TaskType())].GetTypeRateLimits()
+	rates := make(map[string]float64, len(limits))
+	for typeName, limit := range limits {
+		rates[typeName] = limit.GetRequestsPerSecond()
+	}
+	c.priMatcher.UpdateTypeRateLimits(rates)
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
@@ -472,6 +499,14 @@ func (c *physicalTaskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerI
 	return c.pollerHistory.getPollerInfo(time.Time{})
 }
 
+// GetAllWorkerInfo returns the metadata reported by workers that polled from this taskqueue in last few minutes
+func (c *physicalTaskQueueManagerImpl) GetAllWorkerInfo() []*taskqueuespb.WorkerInfo {
+	if c.pollerHistory == nil {
+		return nil
+	}
+	return c.pollerHistory.getWorkerInfo(time.Time{})
+}
+
 func (c *physicalTaskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	if c.currentPolls.Load() > 0 {
 		return true
@@ -722,6 +757,24 @@ func (c *physicalTaskQueueManagerImpl) QueueKey() *PhysicalTaskQueueKey {
 	return c.queue
 }
 
+func (c *physicalTaskQueueManagerImpl) ListBacklogTasks(
+	ctx context.Context,
+	subqueue int,
+	pageSize int,
+	nextPageToken []byte,
+	filter func(*persistencespb.AllocatedTaskInfo) bool,
+) (*persistence.GetTasksResponse, error) {
+	return c.backlogMgr.getDB().ListTasks(ctx, subqueue, pageSize, nextPageToken, filter)
+}
+
+func (c *physicalTaskQueueManagerImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	inclusiveMinTaskID int64,
+	exclusiveMaxTaskID int64,
+) error {
+	return c.backlogMgr.getDB().DeleteTaskRange(ctx, inclusiveMinTaskID, exclusiveMaxTaskID)
+}
+
 func (c *physicalTaskQueueManagerImpl) UnloadFromPartitionManager(unloadCause unloadCause) {
 	c.partitionMgr.unloadPhysicalQueue(c, unloadCause)
 }
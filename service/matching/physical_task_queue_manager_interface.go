@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/persistence"
 )
 
 type (
@@ -68,6 +69,8 @@ type (
 		DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error)
 		UpdatePollerInfo(pollerIdentity, *pollMetadata)
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
+		// GetAllWorkerInfo returns the metadata reported by recent pollers of this queue.
+		GetAllWorkerInfo() []*taskqueuespb.WorkerInfo
 		HasPollerAfter(accessTime time.Time) bool
 		// LegacyDescribeTaskQueue returns pollers info and legacy TaskQueueStatus for this physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
@@ -78,5 +81,10 @@ type (
 		// MakePollerScalingDecision makes a decision on whether to scale pollers up or down based on the current state
 		// of the task queue and the task about to be returned.
 		MakePollerScalingDecision(pollStartTime time.Time) *taskqueuepb.PollerScalingDecision
+		// ListBacklogTasks returns a page of tasks of the given subqueue that are above the ack level,
+		// aren't deleted and match the filter, if given.
+		ListBacklogTasks(ctx context.Context, subqueue int, pageSize int, nextPageToken []byte, filter func(*persistencespb.AllocatedTaskInfo) bool) (*persistence.GetTasksResponse, error)
+		// DeleteBacklogTasks deletes a range of backlog tasks without changing ack levels.
+		DeleteBacklogTasks(ctx context.Context, inclusiveMinTaskID int64, exclusiveMaxTaskID int64) error
 	}
 )
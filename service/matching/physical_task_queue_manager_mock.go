@@ -42,6 +42,7 @@ import (
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
 	persistence "go.temporal.io/server/api/persistence/v1"
 	taskqueue0 "go.temporal.io/server/api/taskqueue/v1"
+	persistence0 "go.temporal.io/server/common/persistence"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -95,6 +96,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) AddSpooledTaskToMatcher(task
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpooledTaskToMatcher", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).AddSpooledTaskToMatcher), task)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) DeleteBacklogTasks(ctx context.Context, inclusiveMinTaskID, exclusiveMaxTaskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", ctx, inclusiveMinTaskID, exclusiveMaxTaskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) DeleteBacklogTasks(ctx, inclusiveMinTaskID, exclusiveMaxTaskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).DeleteBacklogTasks), ctx, inclusiveMinTaskID, exclusiveMaxTaskID)
+}
+
 // DispatchNexusTask mocks base method.
 func (m *MockphysicalTaskQueueManager) DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +168,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllPollerInfo() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllPollerInfo))
 }
 
+// GetAllWorkerInfo mocks base method.
+func (m *MockphysicalTaskQueueManager) GetAllWorkerInfo() []*taskqueue0.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWorkerInfo")
+	ret0, _ := ret[0].([]*taskqueue0.WorkerInfo)
+	return ret0
+}
+
+// GetAllWorkerInfo indicates an expected call of GetAllWorkerInfo.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllWorkerInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWorkerInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllWorkerInfo))
+}
+
 // GetInternalTaskQueueStatus mocks base method.
 func (m *MockphysicalTaskQueueManager) GetInternalTaskQueueStatus() []*taskqueue0.InternalTaskQueueStatus {
 	m.ctrl.T.Helper()
@@ -209,6 +238,21 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) LegacyDescribeTaskQueue(incl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegacyDescribeTaskQueue", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).LegacyDescribeTaskQueue), includeTaskQueueStatus)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockphysicalTaskQueueManager) ListBacklogTasks(ctx context.Context, subqueue, pageSize int, nextPageToken []byte, filter func(*persistence.AllocatedTaskInfo) bool) (*persistence0.GetTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", ctx, subqueue, pageSize, nextPageToken, filter)
+	ret0, _ := ret[0].(*persistence0.GetTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) ListBacklogTasks(ctx, subqueue, pageSize, nextPageToken, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ListBacklogTasks), ctx, subqueue, pageSize, nextPageToken, filter)
+}
+
 // MakePollerScalingDecision mocks base method.
 func (m *MockphysicalTaskQueueManager) MakePollerScalingDecision(pollStartTime time.Time) *taskqueue.PollerScalingDecision {
 	m.ctrl.T.Helper()
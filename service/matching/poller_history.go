@@ -28,6 +28,7 @@ import (
 	"time"
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/cache"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -90,6 +91,30 @@ func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*tas
 	return result
 }
 
+// getWorkerInfo returns the metadata reported by pollers that polled after earliestAccessTime.
+// Pollers that did not report any metadata are still listed, with only their identity set.
+func (pollers *pollerHistory) getWorkerInfo(earliestAccessTime time.Time) []*taskqueuespb.WorkerInfo {
+	var result []*taskqueuespb.WorkerInfo
+
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		key := entry.Key().(pollerIdentity)
+		value := entry.Value().(*pollerInfo)
+		lastAccessTime := entry.CreateTime()
+		if earliestAccessTime.Before(lastAccessTime) {
+			result = append(result, &taskqueuespb.WorkerInfo{
+				Identity:       string(key),
+				LastAccessTime: timestamppb.New(lastAccessTime),
+				Metadata:       value.workerMetadata,
+			})
+		}
+	}
+
+	return result
+}
+
 func defaultRPS(wrapper *wrapperspb.DoubleValue) float64 {
 	if wrapper != nil {
 		return wrapper.Value
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPollerHistory_GetWorkerInfo(t *testing.T) {
+	history := newPollerHistory(time.Minute)
+	history.updatePollerInfo("worker-1", &pollMetadata{
+		workerMetadata: &taskqueuespb.WorkerMetadata{
+			HostName:       "host-1",
+			SlotsReported:  true,
+			AvailableSlots: 4,
+			UsedSlots:      1,
+		},
+	})
+	history.updatePollerInfo("worker-2", &pollMetadata{})
+
+	workers := history.getWorkerInfo(time.Time{})
+	require.Len(t, workers, 2)
+	byIdentity := make(map[string]*taskqueuespb.WorkerInfo)
+	for _, w := range workers {
+		require.NotNil(t, w.GetLastAccessTime())
+		byIdentity[w.GetIdentity()] = w
+	}
+	require.Equal(t, "host-1", byIdentity["worker-1"].GetMetadata().GetHostName())
+	require.Equal(t, int32(4), byIdentity["worker-1"].GetMetadata().GetAvailableSlots())
+	require.Nil(t, byIdentity["worker-2"].GetMetadata())
+
+	require.Empty(t, history.getWorkerInfo(time.Now().Add(time.Minute)))
+}
+
+func TestDedupWorkers_KeepsLatest(t *testing.T) {
+	now := time.Now()
+	workers := dedupWorkers([]*taskqueuespb.WorkerInfo{
+		{Identity: "a", LastAccessTime: timestamppb.New(now.Add(-time.Second)), Metadata: &taskqueuespb.WorkerMetadata{UsedSlots: 1}},
+		{Identity: "b", LastAccessTime: timestamppb.New(now)},
+		{Identity: "a", LastAccessTime: timestamppb.New(now), Metadata: &taskqueuespb.WorkerMetadata{UsedSlots: 2}},
+		{Identity: "a", LastAccessTime: timestamppb.New(now.Add(-time.Minute)), Metadata: &taskqueuespb.WorkerMetadata{UsedSlots: 3}},
+	})
+	require.Len(t, workers, 2)
+	require.Equal(t, "a", workers[0].GetIdentity())
+	require.Equal(t, int32(2), workers[0].GetMetadata().GetUsedSlots())
+	require.Equal(t, "b", workers[1].GetIdentity())
+}
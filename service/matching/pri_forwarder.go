@@ -28,6 +28,7 @@ import (
 	"context"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -113,6 +114,8 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				ForwardInfo:            f.getForwardInfo(task),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
+				WorkflowType:           &commonpb.WorkflowType{Name: task.event.Data.GetTypeName()},
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
@@ -131,6 +134,8 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
+				ActivityType:           &commonpb.ActivityType{Name: task.event.Data.GetTypeName()},
 			},
 		)
 	default:
@@ -229,6 +234,7 @@ func (f *priForwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				WorkerVersionCapabilities: pollMetadata.workerVersionCapabilities,
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
+			WorkerMetadata:  pollMetadata.workerMetadata,
 			ForwardedSource: f.partition.RpcName(),
 		})
 		if err != nil {
@@ -249,6 +255,7 @@ func (f *priForwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				WorkerVersionCapabilities: pollMetadata.workerVersionCapabilities,
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 			},
+			WorkerMetadata:  pollMetadata.workerMetadata,
 			ForwardedSource: f.partition.RpcName(),
 		})
 		if err != nil {
@@ -269,6 +276,7 @@ func (f *priForwarder) ForwardPoll(ctx context.Context, pollMetadata *pollMetada
 				DeploymentOptions:         pollMetadata.deploymentOptions,
 				// Namespace is ignored here.
 			},
+			WorkerMetadata:  pollMetadata.workerMetadata,
 			ForwardedSource: f.partition.RpcName(),
 		})
 		if err != nil {
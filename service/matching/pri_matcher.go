@@ -26,6 +26,7 @@ package matching
 
 import (
 	"context"
+	"maps"
 	"strconv"
 	"sync"
 	"time"
@@ -68,6 +69,7 @@ type priTaskMatcher struct {
 	adminNsRate float64
 	adminTqRate float64
 	dynamicRate float64
+	typeRates   map[string]float64 // per-type rates from task queue user data
 
 	cancel1, cancel2 func()
 }
@@ -535,6 +537,21 @@ func (tm *priTaskMatcher) setLimitLocked() {
 	)
 
 	tm.data.UpdateRateLimit(rate, burstDuration)
+	tm.data.UpdateTypeRateLimits(tm.typeRates, burstDuration)
+}
+
+// UpdateTypeRateLimits updates the per-type dispatch rates, keyed by workflow or activity
+// type name. Rates are for the whole task queue. They aren't divided across partitions since
+// load may be uneven; instead, child partitions forward tasks of limited types to the root,
+// which enforces the full rate.
+func (tm *priTaskMatcher) UpdateTypeRateLimits(rates map[string]float64) {
+	tm.limiterLock.Lock()
+	defer tm.limiterLock.Unlock()
+	if maps.Equal(tm.typeRates, rates) {
+		return
+	}
+	tm.typeRates = rates
+	tm.setLimitLocked()
 }
 
 // Rate returns the current dynamic rate setting
@@ -586,6 +603,10 @@ func (tm *priTaskMatcher) poll(
 			metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 		}
 		metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+		if key := task.getFairnessKey(); key != "" {
+			key = metrics.GetFairnessKeyTagValue(key, tm.config.BreakdownMetricsByFairnessKey())
+			metrics.TaskDispatchedPerFairnessKey.With(tm.metricsHandler).Record(1, metrics.FairnessKeyTag(key))
+		}
 	} else {
 		metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
 		metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
@@ -23,6 +23,7 @@
 package matching
 
 import (
+	"context"
 	"time"
 
 	"go.temporal.io/server/common/log"
@@ -91,6 +92,11 @@ func (t priMetricsTimer) Record(duration time.Duration, tag ...metrics.Tag) {
 	t.handler.Timer(withPriPrefix(t.name)).Record(duration, tag...)
 }
 
+func (t priMetricsTimer) RecordContext(ctx context.Context, duration time.Duration, tag ...metrics.Tag) {
+	t.handler.Timer(t.name).RecordContext(ctx, duration, tag...)
+	t.handler.Timer(withPriPrefix(t.name)).RecordContext(ctx, duration, tag...)
+}
+
 func (t priMetricsGauge) Record(v float64, tag ...metrics.Tag) {
 	t.handler.Gauge(t.name).Record(v, tag...)
 	t.handler.Gauge(withPriPrefix(t.name)).Record(v, tag...)
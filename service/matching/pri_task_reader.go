@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"maps"
 	"slices"
 	"sync"
 	"time"
@@ -71,6 +72,9 @@ type (
 
 		addRetries *semaphore.Weighted
 
+		// tasks read before their not-before time, these are outstanding but not loaded
+		delayed *delayedTasks
+
 		// ack manager state
 		outstandingTasks *treemap.Map // TaskID->acked
 		loadedTasks      int
@@ -92,7 +96,7 @@ func newPriTaskReader(
 	subqueue int,
 	initialAckLevel int64,
 ) *priTaskReader {
-	return &priTaskReader{
+	tr := &priTaskReader{
 		backlogMgr: backlogMgr,
 		subqueue:   subqueue,
 		notifyC:    make(chan struct{}, 1),
@@ -109,11 +113,14 @@ func newPriTaskReader(
 		readLevel:        initialAckLevel,
 		ackLevel:         initialAckLevel,
 	}
+	tr.delayed = newDelayedTasks(backlogMgr.tqCtx, tr.releaseDelayedTasks, backlogMgr.config.MaxDelayedBacklogTasks)
+	return tr
 }
 
 // Start priTaskReader background goroutines.
 func (tr *priTaskReader) Start() {
 	go tr.getTasksPump()
+	tr.delayed.start()
 }
 
 func (tr *priTaskReader) SignalTaskLoading() {
@@ -161,7 +168,8 @@ func (tr *priTaskReader) completeTask(task *internalTask, res taskResponse) {
 	tr.lock.Lock()
 	defer tr.lock.Unlock()
 
-	tr.backlogAge.record(task.event.AllocatedTaskInfo.Data.CreateTime, -1)
+	tr.backlogAge.record(backlogStartTime(task.event.AllocatedTaskInfo.Data), -1)
+	tr.backlogMgr.db.ackFairnessKey(tr.subqueue, task.event.Data.GetFairnessKey())
 
 	numAcked := tr.ackTaskLocked(task.event.TaskId)
 
@@ -180,6 +188,9 @@ func (tr *priTaskReader) completeTask(task *internalTask, res taskResponse) {
 func (tr *priTaskReader) getTasksPump() {
 	ctx := tr.backlogMgr.tqCtx
 
+	respoolTimer := time.NewTimer(tr.backlogMgr.config.UpdateAckInterval())
+	defer respoolTimer.Stop()
+
 	tr.SignalTaskLoading() // prime pump
 Loop:
 	for {
@@ -187,22 +198,35 @@ Loop:
 		case <-ctx.Done():
 			return
 
+		case <-respoolTimer.C:
+			if err := tr.respoolDelayedTasks(ctx); err != nil {
+				tr.backlogMgr.signalIfFatal(err)
+				tr.logger.Error("Failed to respool delayed tasks", tag.Error(err))
+				// keep going, the tasks are still outstanding and we'll try again next time
+			}
+			respoolTimer.Reset(tr.backlogMgr.config.UpdateAckInterval())
+
 		case <-tr.notifyC:
 			if tr.getLoadedTasks() > tr.backlogMgr.config.GetTasksReloadAt() {
 				// Too many loaded already, ignore this signal. We'll get another signal when
 				// loadedTasks drops low enough.
 				continue Loop
 			}
+			if level, ok := tr.delayed.rereadLevel(); ok {
+				// Some delayed tasks were skipped while there was no room to hold them, and
+				// now there is. Pick them up before reading further.
+				if err := tr.rereadSkippedTasks(ctx, level); err != nil {
+					tr.backoffAfterReadError(err)
+					continue Loop
+				}
+				tr.retrier.Reset()
+				tr.SignalTaskLoading()
+				continue Loop
+			}
 
 			batch, err := tr.getTaskBatch(ctx)
-			tr.backlogMgr.signalIfFatal(err)
 			if err != nil {
-				// TODO: Should we ever stop retrying on db errors?
-				if common.IsResourceExhausted(err) {
-					tr.backoffSignal(taskReaderThrottleRetryDelay)
-				} else {
-					tr.backoffSignal(tr.retrier.NextBackOff(err))
-				}
+				tr.backoffAfterReadError(err)
 				continue Loop
 			}
 			tr.retrier.Reset()
@@ -222,6 +246,16 @@ Loop:
 	}
 }
 
+func (tr *priTaskReader) backoffAfterReadError(err error) {
+	tr.backlogMgr.signalIfFatal(err)
+	// TODO: Should we ever stop retrying on db errors?
+	if common.IsResourceExhausted(err) {
+		tr.backoffSignal(taskReaderThrottleRetryDelay)
+	} else {
+		tr.backoffSignal(tr.retrier.NextBackOff(err))
+	}
+}
+
 // TODO(pri): old matcher cleanup: move here
 // type getTasksBatchResponse struct {
 // 	tasks           []*persistencespb.AllocatedTaskInfo
@@ -273,6 +307,11 @@ func (tr *priTaskReader) processTaskBatch(tasks []*persistencespb.AllocatedTaskI
 
 		if IsTaskExpired(t) {
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
+			tr.backlogMgr.db.ackFairnessKey(tr.subqueue, t.Data.GetFairnessKey())
+			return true
+		}
+		if tr.backlogMgr.db.isTaskDeleted(t.TaskId) {
+			tr.backlogMgr.db.ackFairnessKey(tr.subqueue, t.Data.GetFairnessKey())
 			return true
 		}
 
@@ -283,24 +322,200 @@ func (tr *priTaskReader) processTaskBatch(tasks []*persistencespb.AllocatedTaskI
 		return found
 	})
 
-	tr.recordNewTasksLocked(tasks)
+	tasks = tr.recordNewTasksLocked(tasks)
 
 	tr.lock.Unlock()
 
 	tr.addNewTasks(tasks)
 }
 
+// rereadSkippedTasks reads tasks from persistence after level again, and loads the ones that
+// were skipped by tr.delayed. Skipped tasks are still outstanding, so the ack level can't have
+// moved past them.
+func (tr *priTaskReader) rereadSkippedTasks(ctx context.Context, level int64) error {
+	tr.lock.Lock()
+	readLevel := tr.readLevel
+	tr.lock.Unlock()
+
+	batchSize := tr.backlogMgr.config.GetTasksBatchSize()
+	response, err := tr.backlogMgr.db.GetTasks(ctx, tr.subqueue, level+1, readLevel+1, batchSize)
+	if err != nil {
+		return err
+	}
+	// If we got a full batch, we've only seen skipped tasks up to the last one returned.
+	covered := readLevel
+	if len(response.Tasks) >= batchSize {
+		covered = response.Tasks[len(response.Tasks)-1].TaskId
+	}
+	skipped := tr.delayed.takeSkipped(covered)
+
+	tr.lock.Lock()
+
+	var numAcked int64
+	tasks := slices.DeleteFunc(response.Tasks, func(t *persistencespb.AllocatedTaskInfo) bool {
+		if _, ok := skipped[t.TaskId]; !ok {
+			return true // already loaded or completed
+		}
+		delete(skipped, t.TaskId)
+		if IsTaskExpired(t) {
+			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1)
+			tr.backlogMgr.db.ackFairnessKey(tr.subqueue, t.Data.GetFairnessKey())
+			numAcked += tr.ackSkippedTaskLocked(t.TaskId)
+			return true
+		}
+		if tr.backlogMgr.db.isTaskDeleted(t.TaskId) {
+			tr.backlogMgr.db.ackFairnessKey(tr.subqueue, t.Data.GetFairnessKey())
+			numAcked += tr.ackSkippedTaskLocked(t.TaskId)
+			return true
+		}
+		return false
+	})
+	// Anything left wasn't found in persistence anymore.
+	for taskID := range skipped {
+		numAcked += tr.ackSkippedTaskLocked(taskID)
+	}
+
+	tasks = tr.recordNewTasksLocked(tasks)
+
+	if numAcked > 0 {
+		tr.maybeGCLocked()
+		tr.backlogMgr.db.updateAckLevelAndBacklogStats(tr.subqueue, tr.ackLevel, -numAcked, tr.backlogAge.oldestTime())
+	}
+
+	tr.lock.Unlock()
+
+	tr.addNewTasks(tasks)
+	return nil
+}
+
+// respoolDelayedTasks writes delayed tasks that aren't due before the next UpdateAckInterval
+// (including skipped ones) back to the end of the backlog and acks the originals. Delayed tasks
+// are outstanding, so without this a task with a far not-before time would hold back the ack
+// level and task GC until it's dispatched. With it, the ack level is held back for at most about
+// one interval, at the cost of rewriting each delayed task once per interval.
+func (tr *priTaskReader) respoolDelayedTasks(ctx context.Context) error {
+	horizon := time.Now().Add(tr.backlogMgr.config.UpdateAckInterval())
+	for _, t := range tr.delayed.takeDueAfter(horizon) {
+		if err := tr.respoolTask(ctx, t); err != nil {
+			tr.delayed.add(t)
+			return err
+		}
+	}
+
+	level, ok := tr.delayed.skippedLevel()
+	if !ok {
+		return nil
+	}
+	tr.lock.Lock()
+	readLevel := tr.readLevel
+	tr.lock.Unlock()
+
+	batchSize := tr.backlogMgr.config.GetTasksBatchSize()
+	response, err := tr.backlogMgr.db.GetTasks(ctx, tr.subqueue, level+1, readLevel+1, batchSize)
+	if err != nil {
+		return err
+	}
+	// If we got a full batch, we've only seen skipped tasks up to the last one returned.
+	covered := readLevel
+	if len(response.Tasks) >= batchSize {
+		covered = response.Tasks[len(response.Tasks)-1].TaskId
+	}
+	skipped := tr.delayed.takeSkipped(covered)
+	for _, t := range response.Tasks {
+		if _, ok := skipped[t.TaskId]; !ok {
+			continue // already loaded or completed
+		}
+		if IsTaskExpired(t) || tr.backlogMgr.db.isTaskDeleted(t.TaskId) {
+			delete(skipped, t.TaskId)
+			tr.backlogMgr.db.ackFairnessKey(tr.subqueue, t.Data.GetFairnessKey())
+			tr.ackUnloadedTasks(t.TaskId)
+			continue
+		}
+		if err := tr.respoolTask(ctx, t); err != nil {
+			// leave the rest for next time
+			tr.delayed.restoreSkipped(skipped)
+			return err
+		}
+		delete(skipped, t.TaskId)
+	}
+	// Anything left wasn't found in persistence anymore.
+	tr.ackUnloadedTasks(slices.Collect(maps.Keys(skipped))...)
+	return nil
+}
+
+// respoolTask writes an outstanding task that isn't loaded back to persistence with a new task
+// ID and acks the original.
+func (tr *priTaskReader) respoolTask(ctx context.Context, task *persistencespb.AllocatedTaskInfo) error {
+	metrics.TaskRewrites.With(tr.backlogMgr.metricsHandler).Record(1)
+	err := backoff.ThrottleRetryContext(ctx, func(context.Context) error {
+		return tr.backlogMgr.SpoolTask(task.Data)
+	}, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	if err != nil {
+		return err
+	}
+	tr.backlogMgr.db.ackFairnessKey(tr.subqueue, task.Data.GetFairnessKey())
+	tr.ackUnloadedTasks(task.TaskId)
+	return nil
+}
+
+// ackUnloadedTasks acks outstanding tasks that were never loaded.
+func (tr *priTaskReader) ackUnloadedTasks(taskIDs ...int64) {
+	if len(taskIDs) == 0 {
+		return
+	}
+	tr.lock.Lock()
+	defer tr.lock.Unlock()
+
+	var numAcked int64
+	for _, taskID := range taskIDs {
+		numAcked += tr.ackSkippedTaskLocked(taskID)
+	}
+	tr.maybeGCLocked()
+	tr.backlogMgr.db.updateAckLevelAndBacklogStats(tr.subqueue, tr.ackLevel, -numAcked, tr.backlogAge.oldestTime())
+}
+
+// ackSkippedTaskLocked acks a task that was skipped or held by tr.delayed without ever being
+// loaded.
+func (tr *priTaskReader) ackSkippedTaskLocked(taskID int64) int64 {
+	tr.loadedTasks++ // ackTaskLocked assumes it was loaded
+	return tr.ackTaskLocked(taskID)
+}
+
 // To add tasks to the matcher: call recordNewTasksLocked with tr.lock held, then release the
-// lock and call addNewTasks. We call addTaskToMatcher outside tr.lock since it may take other
-// locks to redirect the task.
-func (tr *priTaskReader) recordNewTasksLocked(tasks []*persistencespb.AllocatedTaskInfo) {
+// lock and call addNewTasks with the returned tasks. We call addTaskToMatcher outside tr.lock
+// since it may take other locks to redirect the task.
+// Tasks with a future not-before time are held back and not returned. They stay outstanding,
+// so the ack level can't move past them, but don't count as loaded, so they don't stop us from
+// reading the tasks behind them. If too many are held already, tr.delayed skips them and they're
+// read again later.
+func (tr *priTaskReader) recordNewTasksLocked(tasks []*persistencespb.AllocatedTaskInfo) []*persistencespb.AllocatedTaskInfo {
 	// After we get to this point, we must eventually call task.finish or
 	// task.finishForwarded, which will call tr.completeTask.
-	for _, t := range tasks {
+	now := time.Now()
+	return slices.DeleteFunc(tasks, func(t *persistencespb.AllocatedTaskInfo) bool {
 		tr.outstandingTasks.Put(t.TaskId, false)
+		if isTaskDelayed(t.Data, now) {
+			tr.delayed.add(t)
+			return true
+		}
 		tr.loadedTasks++
-		tr.backlogAge.record(t.Data.CreateTime, 1)
+		tr.backlogAge.record(backlogStartTime(t.Data), 1)
+		return false
+	})
+}
+
+// releaseDelayedTasks is called when held back tasks reach their not-before time.
+func (tr *priTaskReader) releaseDelayedTasks(tasks []*persistencespb.AllocatedTaskInfo) {
+	tr.lock.Lock()
+	for _, t := range tasks {
+		tr.loadedTasks++
+		tr.backlogAge.record(backlogStartTime(t.Data), 1)
 	}
+	tr.lock.Unlock()
+
+	tr.addNewTasks(tasks)
+	// there may be room for skipped delayed tasks now
+	tr.SignalTaskLoading()
 }
 
 // To add tasks to the matcher: call recordNewTasksLocked with tr.lock held, then release the
@@ -309,6 +524,7 @@ func (tr *priTaskReader) recordNewTasksLocked(tasks []*persistencespb.AllocatedT
 func (tr *priTaskReader) addNewTasks(tasks []*persistencespb.AllocatedTaskInfo) {
 	for _, t := range tasks {
 		task := newInternalTaskFromBacklog(t, tr.completeTask)
+		task.event.isDeleted = tr.backlogMgr.db.isTaskDeleted
 		tr.addTaskToMatcher(task)
 	}
 }
@@ -409,11 +625,11 @@ func (tr *priTaskReader) signalNewTasks(resp subqueueCreateTasksResponse) {
 
 	tr.readLevel = resp.maxReadLevelAfter
 
-	tr.recordNewTasksLocked(resp.tasks)
+	tasks := tr.recordNewTasksLocked(slices.Clone(resp.tasks))
 
 	tr.lock.Unlock()
 
-	tr.addNewTasks(resp.tasks)
+	tr.addNewTasks(tasks)
 }
 
 func (tr *priTaskReader) backoffSignal(duration time.Duration) {
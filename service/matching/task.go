@@ -42,6 +42,8 @@ type (
 	genericTaskInfo struct {
 		*persistencespb.AllocatedTaskInfo
 		completionFunc func(*internalTask, taskResponse)
+		// isDeleted reports whether the task was deleted from the backlog after it was loaded
+		isDeleted func(taskID int64) bool
 	}
 	// queryTaskInfo contains the info for a query task
 	queryTaskInfo struct {
@@ -90,6 +92,11 @@ type (
 		waitableMatchResult
 		forwardCtx      context.Context // non-nil for sync match task only
 		isPollForwarder bool
+		// fairnessPass is the virtual time at which this task should be dispatched relative to
+		// other tasks in the same priority level, zero if not assigned yet. fairnessPrevPass
+		// is the previous pass of the task's key, to undo the assignment.
+		fairnessPass     float64
+		fairnessPrevPass float64
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -143,6 +150,12 @@ func newInternalTaskFromBacklog(
 	}
 }
 
+// isDeletedFromBacklog returns true if this is a backlog task that was deleted by an operator
+// after it was loaded.
+func (task *internalTask) isDeletedFromBacklog() bool {
+	return task.event != nil && task.event.isDeleted != nil && task.event.isDeleted(task.event.GetTaskId())
+}
+
 func newInternalQueryTask(
 	taskID string,
 	request *matchingservice.QueryWorkflowRequest,
@@ -280,6 +293,23 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+// getFairnessKey returns the fairness key of the task, query and nexus tasks use the empty key.
+func (task *internalTask) getFairnessKey() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetFairnessKey()
+	}
+	return ""
+}
+
+// getTypeName returns the workflow or activity type name of the task, query and nexus tasks
+// have no type name.
+func (task *internalTask) getTypeName() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetTypeName()
+	}
+	return ""
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
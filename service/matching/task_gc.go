@@ -53,6 +53,9 @@ var maxTimeBetweenTaskDeletes = time.Second
 //   - Size Threshold: More than MaxDeleteBatchSize tasks are waiting to be deleted (rough estimation)
 //   - Time Threshold: Time since previous delete was attempted exceeds maxTimeBetweenTaskDeletes
 //
+// Tasks are only deleted below the ack level, which doesn't move past tasks still waiting for
+// their not-before time.
+//
 // Finally, the Run() method is safe to be called from multiple threads. The underlying
 // implementation will make sure only one caller executes Run() and others simply bail out
 func newTaskGC(
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/worker_versioning"
@@ -80,6 +81,7 @@ type (
 		cachedPhysicalInfoByBuildId     map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo // non-nil for root-partition
 		cachedPhysicalInfoByBuildIdLock sync.RWMutex                                                             // locks mutation of cachedPhysicalInfoByBuildId
 		lastFanOut                      int64                                                                    // serves as a TTL for cachedPhysicalInfoByBuildId
+		scaler                          *partitionScaler                                                         // non-nil for the root workflow partition
 	}
 )
 
@@ -114,6 +116,11 @@ func newTaskQueuePartitionManager(
 		return nil, err
 	}
 	pm.defaultQueue = defaultQ
+	if partition.IsRoot() && partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL &&
+		partition.TaskType() == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		// the root workflow partition owns the user data, so it makes the scaling decisions for the whole family
+		pm.scaler = newPartitionScaler(pm)
+	}
 	return pm, nil
 }
 
@@ -121,11 +128,17 @@ func (pm *taskQueuePartitionManagerImpl) Start() {
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, 1)
 	pm.userDataManager.Start()
 	pm.defaultQueue.Start()
+	if pm.scaler != nil {
+		pm.scaler.Start()
+	}
 }
 
 // Stop does not unload the partition from matching engine. It is intended to be called by matching engine when
 // unloading the partition. For stopping and unloading a partition call unloadFromEngine instead.
 func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
+	if pm.scaler != nil {
+		pm.scaler.Stop()
+	}
 	pm.versionedQueuesLock.Lock()
 	defer pm.versionedQueuesLock.Unlock()
 	for _, vq := range pm.versionedQueues {
@@ -140,6 +153,21 @@ func (pm *taskQueuePartitionManagerImpl) Namespace() *namespace.Namespace {
 	return pm.ns
 }
 
+// PartitionCounts returns the number of read and write partitions of this partition's task queue. These are the
+// configured counts, lowered to the ones chosen by partition auto-scaling when it is enabled.
+func (pm *taskQueuePartitionManagerImpl) PartitionCounts() (read int, write int) {
+	read, write = pm.config.NumReadPartitions(), pm.config.NumWritePartitions()
+	if !pm.config.EnablePartitionAutoScaling() {
+		return read, write
+	}
+	userData, _, err := pm.userDataManager.GetUserData()
+	if err != nil {
+		return read, write
+	}
+	counts := effectivePartitionCounts(userData.GetData().GetPerType()[int32(pm.partition.TaskType())].GetPartitionScaling(), pm.config)
+	return int(counts.read), int(counts.write)
+}
+
 func (pm *taskQueuePartitionManagerImpl) MarkAlive() {
 	pm.defaultQueue.MarkAlive()
 }
@@ -189,7 +217,8 @@ reredirectTask:
 		return "", false, err
 	}
 
-	if isActive {
+	// delayed tasks go straight to the backlog, the backlog reader holds them until they're ready
+	if isActive && !isTaskDelayed(params.taskInfo, time.Now()) {
 		syncMatched, err = syncMatchQueue.TrySyncMatch(ctx, syncMatchTask)
 		if syncMatched && !pm.shouldBacklogSyncMatchTaskOnError(err) {
 
@@ -530,6 +559,16 @@ func (pm *taskQueuePartitionManagerImpl) GetAllPollerInfo() []*taskqueuepb.Polle
 	return ret
 }
 
+func (pm *taskQueuePartitionManagerImpl) GetAllWorkerInfo() []*taskqueuespb.WorkerInfo {
+	ret := pm.defaultQueue.GetAllWorkerInfo()
+	pm.versionedQueuesLock.RLock()
+	defer pm.versionedQueuesLock.RUnlock()
+	for _, vq := range pm.versionedQueues {
+		ret = append(ret, vq.GetAllWorkerInfo()...)
+	}
+	return ret
+}
+
 func (pm *taskQueuePartitionManagerImpl) HasAnyPollerAfter(accessTime time.Time) bool {
 	if pm.defaultQueue.HasPollerAfter(accessTime) {
 		return true
@@ -699,7 +738,7 @@ func (pm *taskQueuePartitionManagerImpl) ForceLoadAllNonRootPartitions() {
 	namespaceId := partition.NamespaceId()
 	taskQueueName := taskQueue.Name()
 	taskQueueType := taskQueue.TaskType()
-	partitionTotal := pm.config.NumReadPartitions()
+	partitionTotal, _ := pm.PartitionCounts()
 
 	// record total - 1 as we won't try to forceLoad the Root partition.
 	pm.metricsHandler.Counter(metrics.ForceLoadedTaskQueuePartitions.Name()).Record(int64(partitionTotal) - 1)
@@ -756,6 +795,34 @@ func (pm *taskQueuePartitionManagerImpl) GetPhysicalTaskQueueInfoFromCache() map
 	return pm.cachedPhysicalInfoByBuildId
 }
 
+func (pm *taskQueuePartitionManagerImpl) ListBacklogTasks(
+	ctx context.Context,
+	buildId string,
+	subqueue int,
+	pageSize int,
+	nextPageToken []byte,
+	filter func(*persistencespb.AllocatedTaskInfo) bool,
+) (*persistence.GetTasksResponse, error) {
+	pq, err := pm.getPhysicalQueue(ctx, buildId, nil)
+	if err != nil {
+		return nil, err
+	}
+	return pq.ListBacklogTasks(ctx, subqueue, pageSize, nextPageToken, filter)
+}
+
+func (pm *taskQueuePartitionManagerImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	buildId string,
+	inclusiveMinTaskID int64,
+	exclusiveMaxTaskID int64,
+) error {
+	pq, err := pm.getPhysicalQueue(ctx, buildId, nil)
+	if err != nil {
+		return err
+	}
+	return pq.DeleteBacklogTasks(ctx, inclusiveMinTaskID, exclusiveMaxTaskID)
+}
+
 func (pm *taskQueuePartitionManagerImpl) unloadPhysicalQueue(unloadedDbq physicalTaskQueueManager, unloadCause unloadCause) {
 	version := unloadedDbq.QueueKey().Version()
 
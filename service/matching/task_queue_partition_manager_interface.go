@@ -33,8 +33,10 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/tqid"
 )
 
@@ -76,6 +78,11 @@ type (
 		// MarkAlive updates the liveness timer to keep this partition manager alive.
 		MarkAlive()
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
+		// GetAllWorkerInfo returns the metadata reported by recent pollers of all physical queues in this partition.
+		GetAllWorkerInfo() []*taskqueuespb.WorkerInfo
+		// PartitionCounts returns the number of read and write partitions currently in use by this partition's
+		// task queue, taking partition auto-scaling into account.
+		PartitionCounts() (read int, write int)
 		// HasPollerAfter checks pollers on the queue associated with the given buildId, or the unversioned queue if an empty string is given
 		HasPollerAfter(buildId string, accessTime time.Time) bool
 		// HasAnyPollerAfter checks pollers on all versioned and unversioned queues
@@ -91,5 +98,12 @@ type (
 		UpdateTimeSinceLastFanOutAndCache(physicalInfoByBuildId map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo)
 		// GetPhysicalTaskQueueInfoFromCache returns the cached physicalInfoByBuildId
 		GetPhysicalTaskQueueInfoFromCache() map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo
+		// ListBacklogTasks returns a page of backlog tasks of the physical queue for the given build
+		// ID, or the unversioned queue if an empty string is given. Only tasks that match the
+		// filter, if given, are returned.
+		ListBacklogTasks(ctx context.Context, buildId string, subqueue int, pageSize int, nextPageToken []byte, filter func(*persistencespb.AllocatedTaskInfo) bool) (*persistence.GetTasksResponse, error)
+		// DeleteBacklogTasks deletes a range of backlog tasks of the physical queue for the given
+		// build ID, or the unversioned queue if an empty string is given.
+		DeleteBacklogTasks(ctx context.Context, buildId string, inclusiveMinTaskID int64, exclusiveMaxTaskID int64) error
 	}
 )
@@ -41,8 +41,10 @@ import (
 	enums "go.temporal.io/api/enums/v1"
 	taskqueue "go.temporal.io/api/taskqueue/v1"
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
+	persistence "go.temporal.io/server/api/persistence/v1"
 	taskqueue0 "go.temporal.io/server/api/taskqueue/v1"
 	namespace "go.temporal.io/server/common/namespace"
+	persistence0 "go.temporal.io/server/common/persistence"
 	tqid "go.temporal.io/server/common/tqid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -101,6 +103,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) AddTask(ctx, params any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTask", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).AddTask), ctx, params)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MocktaskQueuePartitionManager) DeleteBacklogTasks(ctx context.Context, buildId string, inclusiveMinTaskID, exclusiveMaxTaskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", ctx, buildId, inclusiveMinTaskID, exclusiveMaxTaskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) DeleteBacklogTasks(ctx, buildId, inclusiveMinTaskID, exclusiveMaxTaskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).DeleteBacklogTasks), ctx, buildId, inclusiveMinTaskID, exclusiveMaxTaskID)
+}
+
 // Describe mocks base method.
 func (m *MocktaskQueuePartitionManager) Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +176,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) GetAllPollerInfo() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetAllPollerInfo))
 }
 
+// GetAllWorkerInfo mocks base method.
+func (m *MocktaskQueuePartitionManager) GetAllWorkerInfo() []*taskqueue0.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWorkerInfo")
+	ret0, _ := ret[0].([]*taskqueue0.WorkerInfo)
+	return ret0
+}
+
+// GetAllWorkerInfo indicates an expected call of GetAllWorkerInfo.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) GetAllWorkerInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWorkerInfo", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetAllWorkerInfo))
+}
+
 // GetPhysicalTaskQueueInfoFromCache mocks base method.
 func (m *MocktaskQueuePartitionManager) GetPhysicalTaskQueueInfoFromCache() map[string]map[enums.TaskQueueType]*taskqueue0.PhysicalTaskQueueInfo {
 	m.ctrl.T.Helper()
@@ -231,6 +261,21 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) LegacyDescribeTaskQueue(inc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LegacyDescribeTaskQueue", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).LegacyDescribeTaskQueue), includeTaskQueueStatus)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MocktaskQueuePartitionManager) ListBacklogTasks(ctx context.Context, buildId string, subqueue, pageSize int, nextPageToken []byte, filter func(*persistence.AllocatedTaskInfo) bool) (*persistence0.GetTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", ctx, buildId, subqueue, pageSize, nextPageToken, filter)
+	ret0, _ := ret[0].(*persistence0.GetTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) ListBacklogTasks(ctx, buildId, subqueue, pageSize, nextPageToken, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).ListBacklogTasks), ctx, buildId, subqueue, pageSize, nextPageToken, filter)
+}
+
 // LongPollExpirationInterval mocks base method.
 func (m *MocktaskQueuePartitionManager) LongPollExpirationInterval() time.Duration {
 	m.ctrl.T.Helper()
@@ -285,6 +330,21 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) Partition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Partition", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Partition))
 }
 
+// PartitionCounts mocks base method.
+func (m *MocktaskQueuePartitionManager) PartitionCounts() (int, int) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartitionCounts")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	return ret0, ret1
+}
+
+// PartitionCounts indicates an expected call of PartitionCounts.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) PartitionCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartitionCounts", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).PartitionCounts))
+}
+
 // PollTask mocks base method.
 func (m *MocktaskQueuePartitionManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, bool, error) {
 	m.ctrl.T.Helper()
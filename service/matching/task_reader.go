@@ -53,6 +53,7 @@ type (
 		taskBuffer chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
 		notifyC    chan struct{}                          // Used as signal to notify pump of new tasks
 		backlogMgr *backlogManagerImpl
+		delayed    *delayedTasks // tasks read before their not-before time
 
 		backoffTimerLock      sync.Mutex
 		backoffTimer          *time.Timer
@@ -74,6 +75,7 @@ func newTaskReader(backlogMgr *backlogManagerImpl) *taskReader {
 		),
 	}
 	tr.backlogHeadCreateTime.Store(-1)
+	tr.delayed = newDelayedTasks(backlogMgr.tqCtx, tr.releaseDelayedTasks, backlogMgr.config.MaxDelayedBacklogTasks)
 	return tr
 }
 
@@ -81,6 +83,7 @@ func newTaskReader(backlogMgr *backlogManagerImpl) *taskReader {
 func (tr *taskReader) Start() {
 	go tr.dispatchBufferedTasks()
 	go tr.getTasksPump()
+	tr.delayed.start()
 }
 
 func (tr *taskReader) Signal() {
@@ -121,6 +124,7 @@ dispatchLoop:
 				break dispatchLoop
 			}
 			task := newInternalTaskFromBacklog(taskInfo, tr.completeTask)
+			task.event.isDeleted = tr.backlogMgr.db.isTaskDeleted
 			for ctx.Err() == nil {
 				tr.updateBacklogAge(task)
 				taskCtx, cancel := context.WithTimeout(ctx, taskReaderOfferTimeout)
@@ -177,15 +181,20 @@ Loop:
 			return
 
 		case <-tr.notifyC:
+			if level, ok := tr.delayed.rereadLevel(); ok {
+				// Some delayed tasks were skipped while there was no room to hold them, and
+				// now there is. Pick them up before reading further.
+				if err := tr.rereadSkippedTasks(ctx, level); err != nil {
+					tr.reEnqueueAfterReadError(err)
+					continue Loop
+				}
+				tr.retrier.Reset()
+				tr.Signal()
+				continue Loop
+			}
 			batch, err := tr.getTaskBatch(ctx)
-			tr.backlogMgr.signalIfFatal(err)
 			if err != nil {
-				// TODO: Should we ever stop retrying on db errors?
-				if common.IsResourceExhausted(err) {
-					tr.reEnqueueAfterDelay(taskReaderThrottleRetryDelay)
-				} else {
-					tr.reEnqueueAfterDelay(tr.retrier.NextBackOff(err))
-				}
+				tr.reEnqueueAfterReadError(err)
 				continue Loop
 			}
 			tr.retrier.Reset()
@@ -205,6 +214,11 @@ Loop:
 			tr.Signal()
 
 		case <-updateAckTimer.C:
+			if err := tr.respoolDelayedTasks(ctx); err != nil {
+				tr.backlogMgr.signalIfFatal(err)
+				tr.throttledLogger().Error("Failed to respool delayed tasks", tag.Error(err))
+				// keep going, the tasks are still outstanding and we'll try again next time
+			}
 			err := tr.persistAckBacklogCountLevel(ctx)
 			isConditionFailed := tr.backlogMgr.signalIfFatal(err)
 			if err != nil && !isConditionFailed {
@@ -219,6 +233,16 @@ Loop:
 	}
 }
 
+func (tr *taskReader) reEnqueueAfterReadError(err error) {
+	tr.backlogMgr.signalIfFatal(err)
+	// TODO: Should we ever stop retrying on db errors?
+	if common.IsResourceExhausted(err) {
+		tr.reEnqueueAfterDelay(taskReaderThrottleRetryDelay)
+	} else {
+		tr.reEnqueueAfterDelay(tr.retrier.NextBackOff(err))
+	}
+}
+
 func (tr *taskReader) getTaskBatchWithRange(
 	ctx context.Context,
 	readLevel int64,
@@ -284,6 +308,10 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
 			continue
 		}
+		if tr.backlogMgr.db.isTaskDeleted(t.GetTaskId()) {
+			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			continue
+		}
 		if err := tr.addSingleTaskToBuffer(ctx, t); err != nil {
 			return err
 		}
@@ -296,6 +324,12 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
 	tr.backlogMgr.taskAckManager.addTask(task.GetTaskId())
+	if isTaskDelayed(task.GetData(), time.Now()) {
+		// keep it outstanding in the ack manager, but don't let it block the buffer (if it's
+		// skipped, it's read again later)
+		tr.delayed.add(task)
+		return nil
+	}
 	select {
 	case tr.taskBuffer <- task:
 		return nil
@@ -304,6 +338,133 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	}
 }
 
+// rereadSkippedTasks reads tasks from persistence after level again, and buffers the ones that
+// were skipped by tr.delayed. Skipped tasks are still outstanding in the ack manager, so the ack
+// level can't have moved past them.
+func (tr *taskReader) rereadSkippedTasks(ctx context.Context, level int64) error {
+	readLevel := tr.backlogMgr.taskAckManager.getReadLevel()
+	tasks, err := tr.getTaskBatchWithRange(ctx, level, readLevel)
+	if err != nil {
+		return err
+	}
+	// If we got a full batch, we've only seen skipped tasks up to the last one returned.
+	covered := readLevel
+	if len(tasks) >= tr.backlogMgr.config.GetTasksBatchSize() {
+		covered = tasks[len(tasks)-1].GetTaskId()
+	}
+	skipped := tr.delayed.takeSkipped(covered)
+
+	for _, t := range tasks {
+		if _, ok := skipped[t.GetTaskId()]; !ok {
+			continue // already buffered or completed
+		}
+		delete(skipped, t.GetTaskId())
+		if IsTaskExpired(t) {
+			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+			tr.backlogMgr.ackTask(t.GetTaskId())
+			continue
+		}
+		if tr.backlogMgr.db.isTaskDeleted(t.GetTaskId()) {
+			tr.backlogMgr.ackTask(t.GetTaskId())
+			continue
+		}
+		if isTaskDelayed(t.GetData(), time.Now()) {
+			tr.delayed.add(t)
+			continue
+		}
+		select {
+		case tr.taskBuffer <- t:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	// Anything left wasn't found in persistence anymore.
+	for taskID := range skipped {
+		tr.backlogMgr.ackTask(taskID)
+	}
+	return nil
+}
+
+// releaseDelayedTasks is called when held back tasks reach their not-before time.
+func (tr *taskReader) releaseDelayedTasks(tasks []*persistencespb.AllocatedTaskInfo) {
+	for _, t := range tasks {
+		select {
+		case tr.taskBuffer <- t:
+		case <-tr.backlogMgr.tqCtx.Done():
+			return
+		}
+	}
+	// there may be room for skipped delayed tasks now
+	tr.Signal()
+}
+
+// respoolDelayedTasks writes delayed tasks that aren't due before the next ack update (including
+// skipped ones) back to the end of the backlog and acks the originals. Delayed tasks are
+// outstanding, so without this a task with a far not-before time would hold back the ack level
+// and task GC until it's dispatched. With it, the ack level is held back for at most about one
+// UpdateAckInterval, at the cost of rewriting each delayed task once per interval.
+func (tr *taskReader) respoolDelayedTasks(ctx context.Context) error {
+	horizon := time.Now().Add(tr.backlogMgr.config.UpdateAckInterval())
+	for _, t := range tr.delayed.takeDueAfter(horizon) {
+		if err := tr.respoolTask(ctx, t); err != nil {
+			tr.delayed.add(t)
+			return err
+		}
+	}
+
+	level, ok := tr.delayed.skippedLevel()
+	if !ok {
+		return nil
+	}
+	readLevel := tr.backlogMgr.taskAckManager.getReadLevel()
+	tasks, err := tr.getTaskBatchWithRange(ctx, level, readLevel)
+	if err != nil {
+		return err
+	}
+	// If we got a full batch, we've only seen skipped tasks up to the last one returned.
+	covered := readLevel
+	if len(tasks) >= tr.backlogMgr.config.GetTasksBatchSize() {
+		covered = tasks[len(tasks)-1].GetTaskId()
+	}
+	skipped := tr.delayed.takeSkipped(covered)
+	for _, t := range tasks {
+		if _, ok := skipped[t.GetTaskId()]; !ok {
+			continue // already buffered or completed
+		}
+		if IsTaskExpired(t) || tr.backlogMgr.db.isTaskDeleted(t.GetTaskId()) {
+			delete(skipped, t.GetTaskId())
+			tr.backlogMgr.ackTask(t.GetTaskId())
+			continue
+		}
+		if err := tr.respoolTask(ctx, t); err != nil {
+			// leave the rest for next time
+			tr.delayed.restoreSkipped(skipped)
+			return err
+		}
+		delete(skipped, t.GetTaskId())
+	}
+	// Anything left wasn't found in persistence anymore.
+	for taskID := range skipped {
+		tr.backlogMgr.ackTask(taskID)
+	}
+	return nil
+}
+
+// respoolTask writes an outstanding task back to persistence with a new task ID and acks the
+// original.
+func (tr *taskReader) respoolTask(ctx context.Context, task *persistencespb.AllocatedTaskInfo) error {
+	metrics.TaskRewrites.With(tr.backlogMgr.metricsHandler).Record(1)
+	err := executeWithRetry(ctx, func(_ context.Context) error {
+		return tr.backlogMgr.taskWriter.appendTask(task.Data)
+	})
+	if err != nil {
+		return err
+	}
+	tr.backlogMgr.ackTask(task.GetTaskId())
+	tr.Signal()
+	return nil
+}
+
 func (tr *taskReader) persistAckBacklogCountLevel(ctx context.Context) error {
 	ackLevel := tr.backlogMgr.taskAckManager.getAckLevel()
 	return tr.backlogMgr.db.OldUpdateState(ctx, ackLevel)
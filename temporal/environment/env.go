@@ -43,6 +43,8 @@ const (
 	CassandraPort = "CASSANDRA_PORT"
 	// CassandraDefaultPort Cassandra default port
 	CassandraDefaultPort = 9042
+	// CassandraScyllaDB env, set to true if the Cassandra seeds are ScyllaDB nodes
+	CassandraScyllaDB = "CASSANDRA_SCYLLADB"
 
 	// MySQLSeeds env
 	MySQLSeeds = "MYSQL_SEEDS"
@@ -68,6 +70,20 @@ const (
 	PostgresPort = "POSTGRES_PORT"
 	// PostgresDefaultPort Postgres default port
 	PostgresDefaultPort = 5432
+
+	// CockroachDBSeeds env
+	CockroachDBSeeds = "COCKROACHDB_SEEDS"
+	// CockroachDBPort env
+	CockroachDBPort = "COCKROACHDB_PORT"
+	// CockroachDBDefaultPort CockroachDB default port
+	CockroachDBDefaultPort = 26257
+
+	// YugabyteDBSeeds env
+	YugabyteDBSeeds = "YUGABYTEDB_SEEDS"
+	// YugabyteDBPort env
+	YugabyteDBPort = "YUGABYTEDB_PORT"
+	// YugabyteDBDefaultPort YugabyteDB YSQL default port
+	YugabyteDBDefaultPort = 5433
 )
 
 type varSpec struct {
@@ -104,6 +120,22 @@ var envVars = []varSpec{
 		name:       PostgresPort,
 		getDefault: func() string { return strconv.Itoa(PostgresDefaultPort) },
 	},
+	{
+		name:       CockroachDBSeeds,
+		getDefault: GetLocalhostIP,
+	},
+	{
+		name:       CockroachDBPort,
+		getDefault: func() string { return strconv.Itoa(CockroachDBDefaultPort) },
+	},
+	{
+		name:       YugabyteDBSeeds,
+		getDefault: GetLocalhostIP,
+	},
+	{
+		name:       YugabyteDBPort,
+		getDefault: func() string { return strconv.Itoa(YugabyteDBDefaultPort) },
+	},
 	{
 		name:       ESSeeds,
 		getDefault: GetLocalhostIP,
@@ -181,6 +213,12 @@ func GetCassandraPort() int {
 	return p
 }
 
+// GetCassandraScyllaDB returns whether the Cassandra seeds are ScyllaDB nodes
+func GetCassandraScyllaDB() bool {
+	scyllaDB, _ := strconv.ParseBool(os.Getenv(CassandraScyllaDB))
+	return scyllaDB
+}
+
 // GetMySQLAddress return the cassandra address
 func GetMySQLAddress() string {
 	addr := os.Getenv(MySQLSeeds)
@@ -224,3 +262,47 @@ func GetPostgreSQLPort() int {
 	}
 	return p
 }
+
+// GetCockroachDBAddress return the CockroachDB address
+func GetCockroachDBAddress() string {
+	addr := os.Getenv(CockroachDBSeeds)
+	if addr == "" {
+		addr = GetLocalhostIP()
+	}
+	return addr
+}
+
+// GetCockroachDBPort return the CockroachDB port
+func GetCockroachDBPort() int {
+	port := os.Getenv(CockroachDBPort)
+	if port == "" {
+		return CockroachDBDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", CockroachDBPort))
+	}
+	return p
+}
+
+// GetYugabyteDBAddress return the YugabyteDB address
+func GetYugabyteDBAddress() string {
+	addr := os.Getenv(YugabyteDBSeeds)
+	if addr == "" {
+		addr = GetLocalhostIP()
+	}
+	return addr
+}
+
+// GetYugabyteDBPort return the YugabyteDB YSQL port
+func GetYugabyteDBPort() int {
+	port := os.Getenv(YugabyteDBPort)
+	if port == "" {
+		return YugabyteDBDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", YugabyteDBPort))
+	}
+	return p
+}
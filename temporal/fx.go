@@ -76,6 +76,7 @@ import (
 	"go.temporal.io/server/service/worker"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
+	"go.uber.org/zap"
 	expmaps "golang.org/x/exp/maps"
 	"google.golang.org/grpc"
 )
@@ -115,9 +116,11 @@ type (
 		StopChan                   chan interface{}
 		StartupSynchronizationMode synchronizationModeParams
 
-		Config      *config.Config
-		PProfConfig *config.PProf
-		LogConfig   log.Config
+		Config            *config.Config
+		PProfConfig       *config.PProf
+		LogConfig         log.Config
+		LogLevelOverrides *log.LevelOverrides
+		OTLPLogShutdown   log.OTLPShutdown
 
 		ServiceNames    resource.ServiceNames
 		NamespaceLogger resource.NamespaceLogger
@@ -163,7 +166,10 @@ var (
 		pprof.Module,
 		TraceExportModule,
 		FxLogAdapter,
+		// invoked first so that its stop hook runs last and exports the logs of all other stop hooks
+		fx.Invoke(OTLPLogExporterLifetimeHooks),
 		fx.Invoke(ServerLifetimeHooks),
+		fx.Invoke(LogLevelOverridesLifetimeHooks),
 	)
 )
 
@@ -199,9 +205,15 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 
 	// Logger
 	logger := so.logger
+	otlpLogShutdown := log.OTLPShutdown(func() {})
 	if logger == nil {
-		logger = log.NewZapLogger(log.BuildZapLogger(so.config.Log))
+		var zl *zap.Logger
+		zl, otlpLogShutdown = log.BuildZapLoggerWithOTLP(so.config.Log, so.config.ExporterConfig.Dial)
+		logger = log.NewZapLogger(zl)
 	}
+	// the overrides are kept in sync with dynamic config by LogLevelOverridesLifetimeHooks
+	logLevelOverrides := log.NewLevelOverrides()
+	logger = log.NewLevelOverrideLogger(logger, logLevelOverrides)
 
 	// ClientFactoryProvider
 	clientFactoryProvider := so.clientFactoryProvider
@@ -288,9 +300,11 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		StopChan:                   stopChan,
 		StartupSynchronizationMode: so.startupSynchronizationMode,
 
-		Config:      so.config,
-		PProfConfig: &so.config.Global.PProf,
-		LogConfig:   so.config.Log,
+		Config:            so.config,
+		PProfConfig:       &so.config.Global.PProf,
+		LogConfig:         so.config.Log,
+		LogLevelOverrides: logLevelOverrides,
+		OTLPLogShutdown:   otlpLogShutdown,
 
 		ServiceNames:    so.serviceNames,
 		ServiceHosts:    so.hostsByService,
@@ -849,6 +863,29 @@ func ServerLifetimeHooks(
 	lc.Append(fx.StartStopHook(svr.Start, svr.Stop))
 }
 
+// OTLPLogExporterLifetimeHooks exports the queued log records and stops the OTLP log exporter of the server
+// logger when the server stops
+func OTLPLogExporterLifetimeHooks(lc fx.Lifecycle, shutdown log.OTLPShutdown) {
+	lc.Append(fx.StopHook(shutdown))
+}
+
+// LogLevelOverridesLifetimeHooks keeps the log level overrides of the server logger in sync with dynamic config
+func LogLevelOverridesLifetimeHooks(
+	lc fx.Lifecycle,
+	overrides *log.LevelOverrides,
+	dc *dynamicconfig.Collection,
+	logger log.Logger,
+) {
+	update := func(rules []log.LevelOverride) {
+		if err := overrides.Update(rules); err != nil {
+			logger.Warn("Ignoring invalid log level overrides", tag.Key(dynamicconfig.LogLevelOverrides.Key().String()), tag.Error(err))
+		}
+	}
+	rules, cancel := dynamicconfig.LogLevelOverrides.Subscribe(dc)(update)
+	update(rules)
+	lc.Append(fx.StopHook(cancel))
+}
+
 func verifyPersistenceCompatibleVersion(config config.Persistence, persistenceServiceResolver resolver.ServiceResolver) error {
 	// cassandra schema version validation
 	if err := cassandra.VerifyCompatibleVersion(config, persistenceServiceResolver); err != nil {
@@ -964,12 +1001,21 @@ var ServiceTracingModule = fx.Options(
 			fx.ParamTags(``, `optional:"true"`),
 		),
 	),
-	fx.Provide(func(lc fx.Lifecycle, r *otelresource.Resource, sps []otelsdktrace.SpanProcessor) trace.TracerProvider {
+	fx.Provide(func(
+		lc fx.Lifecycle,
+		r *otelresource.Resource,
+		sps []otelsdktrace.SpanProcessor,
+		dc *dynamicconfig.Collection,
+	) trace.TracerProvider {
 		if len(sps) == 0 {
 			return telemetry.NoopTracerProvider
 		}
-		opts := make([]otelsdktrace.TracerProviderOption, 0, len(sps)+1)
+		opts := make([]otelsdktrace.TracerProviderOption, 0, len(sps)+2)
 		opts = append(opts, otelsdktrace.WithResource(r))
+		// A sampler configured with OTEL_TRACES_SAMPLER takes precedence, the SDK uses it when none is set here.
+		if os.Getenv("OTEL_TRACES_SAMPLER") == "" {
+			opts = append(opts, otelsdktrace.WithSampler(telemetry.NewNamespaceSampler(dynamicconfig.TraceSamplingRate.Get(dc))))
+		}
 		for _, sp := range sps {
 			opts = append(opts, otelsdktrace.WithSpanProcessor(sp))
 		}
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	commonnexus "go.temporal.io/server/common/nexus"
@@ -834,6 +835,48 @@ func (s *OperatorSuite) TestUpdate() {
 	}
 }
 
+func (s *OperatorSuite) TestUpdateCarriesOverOutboundSettings() {
+	endpointName := testcore.RandomizedNexusEndpoint(s.T().Name())
+	externalSpec := func(url string) *nexuspb.EndpointSpec {
+		return &nexuspb.EndpointSpec{
+			Name: endpointName,
+			Target: &nexuspb.EndpointTarget{
+				Variant: &nexuspb.EndpointTarget_External_{
+					External: &nexuspb.EndpointTarget_External{Url: url},
+				},
+			},
+		}
+	}
+	createResp, err := s.OperatorClient().CreateNexusEndpoint(testcore.NewContext(), &operatorservice.CreateNexusEndpointRequest{
+		Spec: externalSpec("https://example.com"),
+	})
+	s.NoError(err)
+
+	authHeader := &persistencespb.NexusEndpointTarget_AuthHeader{Name: "Authorization", SecretName: "TOKEN"}
+	settingsResp, err := s.AdminClient().UpdateNexusEndpointOutboundSettings(testcore.NewContext(), &adminservice.UpdateNexusEndpointOutboundSettingsRequest{
+		Id:         createResp.Endpoint.Id,
+		Version:    createResp.Endpoint.Version,
+		AuthHeader: authHeader,
+	})
+	s.NoError(err)
+	s.ProtoEqual(authHeader, settingsResp.Entry.Endpoint.Spec.Target.GetExternal().GetAuthHeader())
+
+	updateResp, err := s.OperatorClient().UpdateNexusEndpoint(testcore.NewContext(), &operatorservice.UpdateNexusEndpointRequest{
+		Id:      createResp.Endpoint.Id,
+		Version: settingsResp.Entry.Version,
+		Spec:    externalSpec("https://example.com/updated"),
+	})
+	s.NoError(err)
+
+	entry, err := s.GetTestCluster().TestBase().NexusEndpointManager.GetNexusEndpoint(testcore.NewContext(), &p.GetNexusEndpointRequest{
+		ID: createResp.Endpoint.Id,
+	})
+	s.NoError(err)
+	s.Equal(updateResp.Endpoint.Version, entry.Version)
+	s.Equal("https://example.com/updated", entry.Endpoint.Spec.Target.GetExternal().GetUrl())
+	s.ProtoEqual(authHeader, entry.Endpoint.Spec.Target.GetExternal().GetAuthHeader())
+}
+
 func (s *OperatorSuite) TestDelete() {
 	endpoint := s.createNexusEndpoint("endpoint-to-delete-operator")
 	type testcase struct {
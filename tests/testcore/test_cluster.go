@@ -177,6 +177,10 @@ func (f *defaultPersistenceTestBaseFactory) NewTestBase(options *persistencetest
 			ops = persistencetests.GetPostgreSQLTestClusterOption()
 		case postgresql.PluginNamePGX:
 			ops = persistencetests.GetPostgreSQLPGXTestClusterOption()
+		case postgresql.PluginNameCockroachDB:
+			ops = persistencetests.GetCockroachDBTestClusterOption()
+		case postgresql.PluginNameYugabyteDB:
+			ops = persistencetests.GetYugabyteDBTestClusterOption()
 		case sqlite.PluginName:
 			ops = persistencetests.GetSQLiteMemoryTestClusterOption()
 		default:
@@ -42,12 +42,13 @@ import (
 
 type (
 	cqlClient struct {
-		nReplicas  int
-		datacenter string
-		keyspace   string
-		timeout    time.Duration
-		session    commongocql.Session
-		logger     log.Logger
+		nReplicas      int
+		datacenter     string
+		disableTablets bool
+		keyspace       string
+		timeout        time.Duration
+		session        commongocql.Session
+		logger         log.Logger
 	}
 	// CQLClientConfig contains the configuration for cql client
 	CQLClientConfig struct {
@@ -60,6 +61,7 @@ type (
 		Timeout                  int
 		numReplicas              int
 		Datacenter               string
+		DisableTablets           bool
 		Consistency              string
 		TLS                      *auth.TLS
 		DisableInitialHostLookup bool
@@ -77,6 +79,8 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT table_name, column_name from system_schema.columns where keyspace_name=?`
+	listIndexesCQL              = `SELECT table_name, index_name from system_schema.indexes where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -96,13 +100,17 @@ const (
 		`PRIMARY KEY ((year, month), update_time));`
 
 	createKeyspaceCQL = `CREATE KEYSPACE IF NOT EXISTS %v ` +
-		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v};`
+		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v}`
 
 	createKeyspaceNetworkTopologyCQL = `CREATE KEYSPACE IF NOT EXISTS %v ` +
-		`WITH replication = { 'class' : 'NetworkTopologyStrategy', '%v' : %v};`
+		`WITH replication = { 'class' : 'NetworkTopologyStrategy', '%v' : %v}`
+
+	// ScyllaDB doesn't support lightweight transactions on tablets, which are enabled by default since 6.0
+	disableTabletsCQL = ` AND tablets = { 'enabled' : false }`
 )
 
 var _ schema.DB = (*cqlClient)(nil)
+var _ schema.Inspector = (*cqlClient)(nil)
 
 // newCQLClient returns a new instance of CQLClient
 func newCQLClient(cfg *CQLClientConfig, logger log.Logger) (*cqlClient, error) {
@@ -125,12 +133,13 @@ func newCQLClient(cfg *CQLClientConfig, logger log.Logger) (*cqlClient, error) {
 	logger.Info("Connection validation succeeded.")
 
 	return &cqlClient{
-		keyspace:   cfg.Keyspace,
-		nReplicas:  cfg.numReplicas,
-		datacenter: cfg.Datacenter,
-		timeout:    time.Duration(cfg.Timeout) * time.Second,
-		session:    session,
-		logger:     logger,
+		keyspace:       cfg.Keyspace,
+		nReplicas:      cfg.numReplicas,
+		datacenter:     cfg.Datacenter,
+		disableTablets: cfg.DisableTablets,
+		timeout:        time.Duration(cfg.Timeout) * time.Second,
+		session:        session,
+		logger:         logger,
 	}, nil
 }
 
@@ -167,12 +176,18 @@ func (client *cqlClient) DropDatabase(name string) error {
 
 // createKeyspace creates a cassandra Keyspace if it doesn't exist
 func (client *cqlClient) createKeyspace(name string) error {
+	var stmt string
 	if client.datacenter != "" {
 		client.logger.Info(fmt.Sprintf("Creating Keyspace %v using NetworkTopologyStrategy in Datacenter %v with RF=%v.", name, client.datacenter, client.nReplicas))
-		return client.Exec(fmt.Sprintf(createKeyspaceNetworkTopologyCQL, name, client.datacenter, client.nReplicas))
+		stmt = fmt.Sprintf(createKeyspaceNetworkTopologyCQL, name, client.datacenter, client.nReplicas)
+	} else {
+		client.logger.Info(fmt.Sprintf("Creating Keyspace %v using SimpleStrategy with RF=%v.", name, client.nReplicas))
+		stmt = fmt.Sprintf(createKeyspaceCQL, name, client.nReplicas)
+	}
+	if client.disableTablets {
+		stmt += disableTabletsCQL
 	}
-	client.logger.Info(fmt.Sprintf("Creating Keyspace %v using SimpleStrategy with RF=%v.", name, client.nReplicas))
-	return client.Exec(fmt.Sprintf(createKeyspaceCQL, name, client.nReplicas))
+	return client.Exec(stmt + ";")
 }
 
 // dropKeyspace drops a Keyspace
@@ -253,6 +268,29 @@ func (client *cqlClient) ListTables() ([]string, error) {
 	return names, nil
 }
 
+// ListColumns lists the columns of all tables in a Keyspace
+func (client *cqlClient) ListColumns() ([]schema.TableObject, error) {
+	return client.listTableObjects(listColumnsCQL)
+}
+
+// ListIndexes lists the secondary indexes of all tables in a Keyspace
+func (client *cqlClient) ListIndexes() ([]schema.TableObject, error) {
+	return client.listTableObjects(listIndexesCQL)
+}
+
+func (client *cqlClient) listTableObjects(cql string) ([]schema.TableObject, error) {
+	iter := client.session.Query(cql, client.keyspace).Iter()
+	var objects []schema.TableObject
+	var table, name string
+	for iter.Scan(&table, &name) {
+		objects = append(objects, schema.TableObject{Table: table, Name: name})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
 // listTypes lists the User defined types in a Keyspace
 func (client *cqlClient) listTypes() ([]string, error) {
 	qry := client.session.Query(listTypesCQL, client.keyspace)
@@ -88,6 +88,46 @@ func updateSchema(cli *cli.Context, logger log.Logger) error {
 	return nil
 }
 
+// planSchema prints the pending schema updates without applying them
+func planSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	if err := schema.Plan(cli, client, logger); err != nil {
+		logger.Error("Unable to plan CQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// diffSchema compares the live schema with the versioned schema
+func diffSchema(cli *cli.Context, logger log.Logger) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
+		return err
+	}
+	client, err := newCQLClient(config, logger)
+	if err != nil {
+		logger.Error("Unable to establish CQL session.", tag.Error(err))
+		return err
+	}
+	defer client.Close()
+	if err := schema.Diff(cli, client, logger); err != nil {
+		logger.Error("Unable to diff CQL schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
 func createKeyspace(cli *cli.Context, logger log.Logger) error {
 	config, err := newCQLClientConfig(cli)
 	if err != nil {
@@ -178,6 +218,7 @@ func newCQLClientConfig(cli *cli.Context) (*CQLClientConfig, error) {
 		Keyspace:                 cli.GlobalString(schema.CLIOptKeyspace),
 		numReplicas:              cli.Int(schema.CLIOptReplicationFactor),
 		Datacenter:               cli.String(schema.CLIOptDatacenter),
+		DisableTablets:           cli.Bool(schema.CLIOptDisableTablets),
 		Consistency:              cli.String(schema.CLIOptConsistency),
 		DisableInitialHostLookup: cli.GlobalBool(schema.CLIFlagDisableInitialHostLookup),
 	}
@@ -218,6 +218,48 @@ func buildCLIOptions() *cli.App {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "plan-schema",
+			Aliases: []string{"plan"},
+			Usage:   "print the cassandra schema updates that update-schema would apply, without applying them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "target version for the schema update, defaults to latest",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("cassandra")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, planSchema, logger)
+			},
+		},
+		{
+			Name:    "diff-schema",
+			Aliases: []string{"diff", "drift"},
+			Usage:   "compare the live cassandra schema with the versioned schema and exit non-zero on drift",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("cassandra")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, diffSchema, logger)
+			},
+		},
 		{
 			Name:    "create-keyspace",
 			Aliases: []string{"create", "create-Keyspace"},
@@ -237,6 +279,10 @@ func buildCLIOptions() *cli.App {
 					Value: "",
 					Usage: "enable NetworkTopologyStrategy by providing datacenter name",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIOptDisableTablets,
+					Usage: "create the keyspace with tablets disabled, required by ScyllaDB 6.0+ for lightweight transactions",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, createKeyspace, logger)
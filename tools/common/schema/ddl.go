@@ -0,0 +1,522 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type (
+	// schemaModel is the set of tables, columns and secondary indexes described by DDL statements or
+	// read from a live database. All names are lower-cased.
+	schemaModel struct {
+		tables map[string]*tableModel
+		// optionalTables are tables which may legitimately be missing, e.g. legacy tables which are created by
+		// the versioned schema but not by the schema file used to set up new databases
+		optionalTables map[string]struct{}
+		// createdTables are all tables ever created by the applied statements, including dropped ones
+		createdTables map[string]struct{}
+	}
+
+	tableModel struct {
+		columns map[string]struct{}
+		indexes map[string]struct{}
+		// anonymousIndexes holds the first column of each index declared without a name (e.g. by a UNIQUE
+		// column constraint), whose name is chosen by the database
+		anonymousIndexes []string
+	}
+
+	ddlTokenKind int
+
+	ddlToken struct {
+		kind ddlTokenKind
+		text string
+	}
+
+	// ddlParser extracts tables, columns and indexes from a single DDL statement. It understands the subset of
+	// CQL and the MySQL, PostgreSQL and SQLite dialects used by the versioned schemas and ignores everything else.
+	ddlParser struct {
+		tokens []ddlToken
+		pos    int
+	}
+)
+
+const (
+	ddlWord ddlTokenKind = iota
+	ddlQuotedIdent
+	ddlLiteral
+	ddlPunct
+)
+
+func newSchemaModel() *schemaModel {
+	return &schemaModel{
+		tables:         make(map[string]*tableModel),
+		optionalTables: make(map[string]struct{}),
+		createdTables:  make(map[string]struct{}),
+	}
+}
+
+func newTableModel() *tableModel {
+	return &tableModel{
+		columns: make(map[string]struct{}),
+		indexes: make(map[string]struct{}),
+	}
+}
+
+func (t *tableModel) clone() *tableModel {
+	clone := newTableModel()
+	for column := range t.columns {
+		clone.columns[column] = struct{}{}
+	}
+	for index := range t.indexes {
+		clone.indexes[index] = struct{}{}
+	}
+	clone.anonymousIndexes = append(clone.anonymousIndexes, t.anonymousIndexes...)
+	return clone
+}
+
+// apply updates the model with the effect of the given DDL statement
+func (m *schemaModel) apply(stmt string) error {
+	p := &ddlParser{tokens: tokenizeDDL(stmt)}
+	switch {
+	case p.acceptWords("create", "table"):
+		return m.createTable(p)
+	case p.acceptWords("alter", "table"):
+		return m.alterTable(p)
+	case p.acceptWords("drop", "table"):
+		return m.dropTable(p)
+	case p.acceptWords("drop", "index"):
+		return m.dropIndex(p)
+	case p.acceptWords("create"):
+		p.acceptWords("unique")
+		p.acceptWords("custom")
+		if p.acceptWords("index") {
+			return m.createIndex(p)
+		}
+	}
+	return nil
+}
+
+func (m *schemaModel) createTable(p *ddlParser) error {
+	p.acceptWords("if", "not", "exists")
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	elements, err := p.list()
+	if err != nil {
+		return fmt.Errorf("table %v: %w", name, err)
+	}
+	table := newTableModel()
+	for _, element := range elements {
+		table.addElement(element)
+	}
+	m.tables[name] = table
+	m.createdTables[name] = struct{}{}
+	return nil
+}
+
+func (m *schemaModel) alterTable(p *ddlParser) error {
+	p.acceptWords("if", "exists")
+	p.acceptWords("only")
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	table, ok := m.tables[name]
+	if !ok {
+		return nil
+	}
+	if p.acceptWords("rename", "to") {
+		newName, err := p.name()
+		if err != nil {
+			return err
+		}
+		delete(m.tables, name)
+		m.tables[newName] = table
+		return nil
+	}
+	for _, action := range splitTopLevel(p.rest()) {
+		table.alter(&ddlParser{tokens: action})
+	}
+	return nil
+}
+
+func (m *schemaModel) dropTable(p *ddlParser) error {
+	p.acceptWords("if", "exists")
+	for {
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		delete(m.tables, name)
+		if !p.acceptPunct(",") {
+			return nil
+		}
+	}
+}
+
+func (m *schemaModel) createIndex(p *ddlParser) error {
+	p.acceptWords("concurrently")
+	p.acceptWords("if", "not", "exists")
+	var name string
+	if !p.peekWord("on") {
+		var err error
+		if name, err = p.name(); err != nil {
+			return err
+		}
+	}
+	if !p.acceptWords("on") {
+		return fmt.Errorf("index %v: missing table", name)
+	}
+	p.acceptWords("only")
+	tableName, err := p.name()
+	if err != nil {
+		return err
+	}
+	table, ok := m.tables[tableName]
+	if !ok {
+		return nil
+	}
+	if name == "" {
+		// both Cassandra and PostgreSQL name unnamed indexes <table>_<column>_idx
+		column := ""
+		if p.skipTo("(") {
+			column, _ = p.name()
+		}
+		name = tableName + "_" + column + "_idx"
+	}
+	table.indexes[name] = struct{}{}
+	return nil
+}
+
+func (m *schemaModel) dropIndex(p *ddlParser) error {
+	p.acceptWords("concurrently")
+	p.acceptWords("if", "exists")
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	if p.acceptWords("on") {
+		tableName, err := p.name()
+		if err != nil {
+			return err
+		}
+		if table, ok := m.tables[tableName]; ok {
+			delete(table.indexes, name)
+		}
+		return nil
+	}
+	for _, table := range m.tables {
+		delete(table.indexes, name)
+	}
+	return nil
+}
+
+// addElement adds a column or index definition of a CREATE TABLE statement
+func (t *tableModel) addElement(element []ddlToken) {
+	p := &ddlParser{tokens: element}
+	switch {
+	case p.peekWord("primary"), p.peekWord("constraint"), p.peekWord("foreign"), p.peekWord("check"):
+	case p.acceptWords("unique"):
+		t.addIndex(p)
+	case p.acceptWords("index"), p.acceptWords("key"):
+		t.addIndex(p)
+	default:
+		t.addColumn(p)
+	}
+}
+
+// alter applies a single action of an ALTER TABLE statement
+func (t *tableModel) alter(p *ddlParser) {
+	switch {
+	case p.acceptWords("add"):
+		p.acceptWords("column")
+		p.acceptWords("if", "not", "exists")
+		switch {
+		case p.peekWord("constraint"), p.peekWord("primary"), p.peekWord("foreign"), p.peekWord("check"):
+		case p.acceptWords("unique"):
+			t.addIndex(p)
+		case p.acceptWords("index"), p.acceptWords("key"):
+			t.addIndex(p)
+		case p.peekPunct("("):
+			// CQL allows adding several columns at once
+			elements, _ := p.list()
+			for _, element := range elements {
+				t.addColumn(&ddlParser{tokens: element})
+			}
+		default:
+			t.addColumn(p)
+		}
+	case p.acceptWords("drop"):
+		p.acceptWords("column")
+		p.acceptWords("if", "exists")
+		switch {
+		case p.peekWord("constraint"), p.peekWord("primary"), p.peekWord("foreign"), p.peekWord("check"):
+		case p.acceptWords("index"), p.acceptWords("key"):
+			if name, err := p.name(); err == nil {
+				delete(t.indexes, name)
+			}
+		case p.peekPunct("("):
+			elements, _ := p.list()
+			for _, element := range elements {
+				if name, err := (&ddlParser{tokens: element}).name(); err == nil {
+					delete(t.columns, name)
+				}
+			}
+		default:
+			if name, err := p.name(); err == nil {
+				delete(t.columns, name)
+			}
+		}
+	case p.acceptWords("rename"):
+		names := t.columns
+		if p.acceptWords("index") {
+			names = t.indexes
+		} else {
+			p.acceptWords("column")
+		}
+		from, err := p.name()
+		if err != nil || !p.acceptWords("to") {
+			return
+		}
+		if to, err := p.name(); err == nil {
+			delete(names, from)
+			names[to] = struct{}{}
+		}
+	case p.acceptWords("change"):
+		p.acceptWords("column")
+		if from, err := p.name(); err == nil {
+			if to, err := p.name(); err == nil {
+				delete(t.columns, from)
+				t.columns[to] = struct{}{}
+			}
+		}
+	}
+}
+
+func (t *tableModel) addColumn(p *ddlParser) {
+	name, err := p.name()
+	if err != nil {
+		return
+	}
+	t.columns[name] = struct{}{}
+	if hasTopLevelWord(p.rest(), "unique") {
+		t.anonymousIndexes = append(t.anonymousIndexes, name)
+	}
+}
+
+// addIndex adds an index from an inline definition like [INDEX|KEY] [name] (col, ...)
+func (t *tableModel) addIndex(p *ddlParser) {
+	if !p.acceptWords("index") {
+		p.acceptWords("key")
+	}
+	if p.acceptPunct("(") {
+		if column, err := p.name(); err == nil {
+			t.anonymousIndexes = append(t.anonymousIndexes, column)
+		}
+		return
+	}
+	if name, err := p.name(); err == nil {
+		t.indexes[name] = struct{}{}
+	}
+}
+
+func tokenizeDDL(stmt string) []ddlToken {
+	var tokens []ddlToken
+	runes := []rune(stmt)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"' || r == '`':
+			j := i + 1
+			for j < len(runes) && runes[j] != r {
+				j++
+			}
+			kind := ddlQuotedIdent
+			if r == '\'' {
+				kind = ddlLiteral
+			}
+			tokens = append(tokens, ddlToken{kind: kind, text: strings.ToLower(string(runes[i+1 : min(j, len(runes))]))})
+			i = j + 1
+		case isDDLWordRune(r):
+			j := i
+			for j < len(runes) && isDDLWordRune(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlWord, text: strings.ToLower(string(runes[i:j]))})
+			i = j
+		default:
+			tokens = append(tokens, ddlToken{kind: ddlPunct, text: string(r)})
+			i++
+		}
+	}
+	return tokens
+}
+
+func isDDLWordRune(r rune) bool {
+	return r == '_' || r == '$' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// splitTopLevel splits tokens at commas which are not nested in parentheses or in the angle brackets of
+// CQL collection types. Angle brackets inside parentheses are operators, e.g. MySQL's JSON ->> operator.
+func splitTopLevel(tokens []ddlToken) [][]ddlToken {
+	var result [][]ddlToken
+	parens, angles, start := 0, 0, 0
+	for i, token := range tokens {
+		if token.kind != ddlPunct {
+			continue
+		}
+		switch token.text {
+		case "(":
+			parens++
+		case ")":
+			parens--
+		case "<":
+			if parens == 0 {
+				angles++
+			}
+		case ">":
+			if parens == 0 {
+				angles--
+			}
+		case ",":
+			if parens == 0 && angles == 0 {
+				result = append(result, tokens[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(tokens) {
+		result = append(result, tokens[start:])
+	}
+	return result
+}
+
+func hasTopLevelWord(tokens []ddlToken, word string) bool {
+	depth := 0
+	for _, token := range tokens {
+		switch {
+		case token.kind == ddlPunct && token.text == "(":
+			depth++
+		case token.kind == ddlPunct && token.text == ")":
+			depth--
+		case token.kind == ddlWord && token.text == word && depth == 0:
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ddlParser) peekWord(word string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == ddlWord && p.tokens[p.pos].text == word
+}
+
+func (p *ddlParser) peekPunct(punct string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == ddlPunct && p.tokens[p.pos].text == punct
+}
+
+// acceptWords consumes the given sequence of keywords if the next tokens match it
+func (p *ddlParser) acceptWords(words ...string) bool {
+	if p.pos+len(words) > len(p.tokens) {
+		return false
+	}
+	for i, word := range words {
+		token := p.tokens[p.pos+i]
+		if token.kind != ddlWord || token.text != word {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+func (p *ddlParser) acceptPunct(punct string) bool {
+	if p.peekPunct(punct) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// name consumes an identifier, stripping any keyspace or schema qualifier
+func (p *ddlParser) name() (string, error) {
+	if p.pos >= len(p.tokens) || (p.tokens[p.pos].kind != ddlWord && p.tokens[p.pos].kind != ddlQuotedIdent) {
+		return "", fmt.Errorf("expected identifier at token %d", p.pos)
+	}
+	name := p.tokens[p.pos].text
+	p.pos++
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	return name, nil
+}
+
+// list consumes a parenthesized list and returns its top-level elements
+func (p *ddlParser) list() ([][]ddlToken, error) {
+	if !p.acceptPunct("(") {
+		return nil, fmt.Errorf("expected '(' at token %d", p.pos)
+	}
+	start, depth := p.pos, 1
+	for ; p.pos < len(p.tokens); p.pos++ {
+		token := p.tokens[p.pos]
+		if token.kind != ddlPunct {
+			continue
+		}
+		switch token.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				elements := splitTopLevel(p.tokens[start:p.pos])
+				p.pos++
+				return elements, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unmatched '('")
+}
+
+// skipTo consumes tokens up to and including the given punctuation
+func (p *ddlParser) skipTo(punct string) bool {
+	for p.pos < len(p.tokens) {
+		if p.acceptPunct(punct) {
+			return true
+		}
+		p.pos++
+	}
+	return false
+}
+
+func (p *ddlParser) rest() []ddlToken {
+	rest := p.tokens[p.pos:]
+	p.pos = len(p.tokens)
+	return rest
+}
@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	dbschemas "go.temporal.io/server/schema"
+)
+
+type (
+	// DiffTask represents a task that compares the live schema of a database
+	// with the schema expected at its current version
+	DiffTask struct {
+		db     DB
+		config *UpdateConfig
+		logger log.Logger
+	}
+)
+
+var (
+	// tables managed by the schema tool itself rather than by the versioned schema
+	versioningTables = []string{"schema_version", "schema_update_history", migrationProgressTable}
+
+	// MySQL names an index declared without a name after its first column, adding a numeric suffix
+	// if that name is taken
+	anonymousIndexSuffixRegex = regexp.MustCompile(`^_\d+$`)
+)
+
+// NewDiffSchemaTask returns a new instance of DiffTask
+func NewDiffSchemaTask(db DB, config *UpdateConfig, logger log.Logger) *DiffTask {
+	return &DiffTask{
+		db:     db,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run prints the differences between the live and the expected schema to w and
+// returns an error if there are any
+func (task *DiffTask) Run(w io.Writer) error {
+	inspector, ok := task.db.(Inspector)
+	if !ok {
+		return fmt.Errorf("schema drift detection is not supported for %v", task.db.Type())
+	}
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+	task.logger.Info("Comparing live schema with expected schema", tag.NewStringTag("version", currVer))
+
+	expected, err := task.expectedSchema(currVer)
+	if err != nil {
+		return err
+	}
+	live, err := liveSchema(inspector)
+	if err != nil {
+		return fmt.Errorf("error reading live schema:%v", err.Error())
+	}
+
+	drifts := diffSchemas(expected, live)
+	if len(drifts) == 0 {
+		fmt.Fprintf(w, "Schema matches version %v.\n", currVer)
+		return nil
+	}
+	fmt.Fprintf(w, "Schema differs from version %v:\n", currVer)
+	for _, drift := range drifts {
+		fmt.Fprintf(w, "  %v\n", drift)
+	}
+	return fmt.Errorf("found %d differences from schema version %v", len(drifts), currVer)
+}
+
+// expectedSchema replays the versioned schema changes up to version
+func (task *DiffTask) expectedSchema(version string) (*schemaModel, error) {
+	config := *task.config
+	config.TargetVersion = version
+	updateTask := NewUpdateSchemaTask(task.db, &config, task.logger)
+	changes, err := updateTask.buildChangeSet("0.0")
+	if err != nil {
+		return nil, err
+	}
+
+	baseline, err := task.schemaFileModel()
+	if err != nil {
+		return nil, err
+	}
+
+	model := newSchemaModel()
+	if baseline != nil {
+		// some versioned schemas only start after the initial tables were created by the schema file, so
+		// tables which the versioned statements never create are taken from the schema file instead
+		created := newSchemaModel()
+		if err := created.applyChanges(changes); err != nil {
+			return nil, err
+		}
+		for name, table := range baseline.tables {
+			if _, ok := created.createdTables[name]; !ok {
+				model.tables[name] = table.clone()
+			}
+		}
+	}
+	if err := model.applyChanges(changes); err != nil {
+		return nil, err
+	}
+	for _, name := range versioningTables {
+		delete(model.tables, name)
+	}
+
+	if baseline != nil {
+		for name := range model.tables {
+			if _, ok := baseline.tables[name]; !ok {
+				model.optionalTables[name] = struct{}{}
+			}
+		}
+	}
+	return model, nil
+}
+
+func (m *schemaModel) applyChanges(changes []changeSet) error {
+	for _, cs := range changes {
+		for _, stmt := range cs.cqlStmts {
+			if err := m.apply(stmt); err != nil {
+				return fmt.Errorf("error parsing statement of version %v: %w", cs.version, err)
+			}
+		}
+		for _, migration := range cs.manifest.OnlineMigrations {
+			if err := m.apply(migration.statement()); err != nil {
+				return fmt.Errorf("error parsing online migration %v of version %v: %w", migration.Name, cs.version, err)
+			}
+		}
+	}
+	return nil
+}
+
+// schemaFileModel parses the schema file which sets up new databases at the latest version, if there is one
+func (task *DiffTask) schemaFileModel() (*schemaModel, error) {
+	var content []byte
+	var err error
+	if len(task.config.SchemaName) > 0 {
+		content, err = fs.ReadFile(dbschemas.Assets(),
+			path.Join(task.config.SchemaName, "schema"+schemaFileEnding(task.config.SchemaName)))
+	} else {
+		dir := filepath.Dir(filepath.Clean(task.config.SchemaDir))
+		for _, ending := range []string{".cql", ".sql"} {
+			if content, err = os.ReadFile(filepath.Join(dir, "schema"+ending)); err == nil {
+				break
+			}
+		}
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	stmts, err := persistence.LoadAndSplitQueryFromReaders([]io.Reader{bytes.NewReader(content)})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema file: %w", err)
+	}
+	model := newSchemaModel()
+	for _, stmt := range stmts {
+		if err := model.apply(stmt); err != nil {
+			return nil, fmt.Errorf("error parsing schema file: %w", err)
+		}
+	}
+	return model, nil
+}
+
+func liveSchema(inspector Inspector) (*schemaModel, error) {
+	tables, err := inspector.ListTables()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := inspector.ListColumns()
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := inspector.ListIndexes()
+	if err != nil {
+		return nil, err
+	}
+
+	model := newSchemaModel()
+	for _, name := range tables {
+		model.tables[strings.ToLower(name)] = newTableModel()
+	}
+	for _, column := range columns {
+		if table, ok := model.tables[strings.ToLower(column.Table)]; ok {
+			table.columns[strings.ToLower(column.Name)] = struct{}{}
+		}
+	}
+	for _, index := range indexes {
+		if table, ok := model.tables[strings.ToLower(index.Table)]; ok {
+			table.indexes[strings.ToLower(index.Name)] = struct{}{}
+		}
+	}
+	for _, name := range versioningTables {
+		delete(model.tables, name)
+	}
+	return model, nil
+}
+
+// diffSchemas returns the tables, columns and indexes which are missing from
+// or unexpected in the live schema, in a stable order
+func diffSchemas(expected *schemaModel, live *schemaModel) []string {
+	var drifts []string
+	for name, expectedTable := range expected.tables {
+		liveTable, ok := live.tables[name]
+		if !ok {
+			if _, optional := expected.optionalTables[name]; !optional {
+				drifts = append(drifts, "missing table "+name)
+			}
+			continue
+		}
+		for column := range expectedTable.columns {
+			if _, ok := liveTable.columns[column]; !ok {
+				drifts = append(drifts, fmt.Sprintf("missing column %v.%v", name, column))
+			}
+		}
+		for column := range liveTable.columns {
+			if _, ok := expectedTable.columns[column]; !ok {
+				drifts = append(drifts, fmt.Sprintf("unexpected column %v.%v", name, column))
+			}
+		}
+		for index := range expectedTable.indexes {
+			if _, ok := liveTable.indexes[index]; !ok {
+				drifts = append(drifts, fmt.Sprintf("missing index %v.%v", name, index))
+			}
+		}
+		anonymous := slices.Clone(expectedTable.anonymousIndexes)
+		for index := range liveTable.indexes {
+			if _, ok := expectedTable.indexes[index]; ok {
+				continue
+			}
+			if i := slices.IndexFunc(anonymous, func(column string) bool {
+				return index == column || (len(index) > len(column) && index[:len(column)] == column &&
+					anonymousIndexSuffixRegex.MatchString(index[len(column):]))
+			}); i >= 0 {
+				anonymous = slices.Delete(anonymous, i, i+1)
+				continue
+			}
+			drifts = append(drifts, fmt.Sprintf("unexpected index %v.%v", name, index))
+		}
+	}
+	for name := range live.tables {
+		if _, ok := expected.tables[name]; !ok {
+			drifts = append(drifts, "unexpected table "+name)
+		}
+	}
+	slices.Sort(drifts)
+	return drifts
+}
@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+	dbschemas "go.temporal.io/server/schema"
+)
+
+func TestSchemaModelApply(t *testing.T) {
+	model := newSchemaModel()
+	for _, stmt := range []string{
+		"CREATE TABLE IF NOT EXISTS `tasks` (id BIGINT NOT NULL, name VARCHAR(255) UNIQUE NOT NULL, data MEDIUMBLOB, " +
+			"PRIMARY KEY (id), INDEX (name, id), KEY by_data (data(10)))",
+		`CREATE TABLE executions (shard_id int, data map<int, frozen<list<blob>>>, PRIMARY KEY ((shard_id), data)) ` +
+			`WITH COMPACTION = {'class': 'LeveledCompactionStrategy'}`,
+		`CREATE TABLE visibility (namespace_id CHAR(64), attrs JSON, ` +
+			`Keyword01 VARCHAR(255) GENERATED ALWAYS AS (attrs->>"$.Keyword01"), PRIMARY KEY (namespace_id))`,
+		`CREATE TYPE serialized_event_batch (encoding_type text, version int, data blob)`,
+		`ALTER TABLE tasks ADD COLUMN IF NOT EXISTS ttl BIGINT, DROP COLUMN data, ADD INDEX by_ttl (ttl)`,
+		`ALTER TABLE executions ADD (next_event_id bigint, state blob)`,
+		`ALTER TABLE executions DROP state`,
+		`ALTER TABLE visibility RENAME COLUMN Keyword01 TO keyword_01`,
+		`CREATE INDEX by_namespace ON public.visibility USING GIN (namespace_id)`,
+		`CREATE INDEX ON executions (next_event_id)`,
+		`DROP INDEX by_data ON tasks`,
+		`CREATE TABLE dropped (id int)`,
+		`DROP TABLE IF EXISTS dropped`,
+		`INSERT INTO tasks (id) VALUES (1)`,
+	} {
+		require.NoError(t, model.apply(stmt), stmt)
+	}
+
+	require.Len(t, model.tables, 3)
+	tasks := model.tables["tasks"]
+	require.Equal(t, map[string]struct{}{"id": {}, "name": {}, "ttl": {}}, tasks.columns)
+	require.Equal(t, map[string]struct{}{"by_ttl": {}}, tasks.indexes)
+	require.Equal(t, []string{"name", "name"}, tasks.anonymousIndexes)
+	executions := model.tables["executions"]
+	require.Equal(t, map[string]struct{}{"shard_id": {}, "data": {}, "next_event_id": {}}, executions.columns)
+	require.Equal(t, map[string]struct{}{"executions_next_event_id_idx": {}}, executions.indexes)
+	visibility := model.tables["visibility"]
+	require.Equal(t, map[string]struct{}{"namespace_id": {}, "attrs": {}, "keyword_01": {}}, visibility.columns)
+	require.Equal(t, map[string]struct{}{"by_namespace": {}}, visibility.indexes)
+}
+
+func TestDiffSchemas(t *testing.T) {
+	expected := newSchemaModel()
+	require.NoError(t, expected.apply(`CREATE TABLE tasks (id BIGINT, name VARCHAR(255) UNIQUE, data BLOB, `+
+		`INDEX (name, id), INDEX by_data (data))`))
+	require.NoError(t, expected.apply(`CREATE TABLE queues (id BIGINT)`))
+
+	live := newSchemaModel()
+	require.NoError(t, live.apply(`CREATE TABLE tasks (id BIGINT, name VARCHAR(255), extra BLOB, `+
+		`INDEX name (name), INDEX name_2 (name, id), INDEX by_extra (extra))`))
+	require.NoError(t, live.apply(`CREATE TABLE schema_version (keyspace_name text)`))
+	require.NoError(t, live.apply(`CREATE TABLE orphan (id BIGINT)`))
+	delete(live.tables, "schema_version")
+
+	require.Equal(t, []string{
+		"missing column tasks.data",
+		"missing index tasks.by_data",
+		"missing table queues",
+		"unexpected column tasks.extra",
+		"unexpected index tasks.by_extra",
+		"unexpected table orphan",
+	}, diffSchemas(expected, live))
+	require.Empty(t, diffSchemas(expected, expected))
+}
+
+// TestEmbeddedSchemas verifies that a database set up with the schema file of each embedded schema
+// matches the schema expected from replaying its versioned schema.
+func TestEmbeddedSchemas(t *testing.T) {
+	for _, db := range []string{"cassandra", "sql"} {
+		for _, name := range dbschemas.PathsByDB(db) {
+			t.Run(name, func(t *testing.T) {
+				task := NewDiffSchemaTask(nil, &UpdateConfig{SchemaName: name}, log.NewNoopLogger())
+				expected, err := task.expectedSchema("")
+				require.NoError(t, err)
+				live, err := task.schemaFileModel()
+				require.NoError(t, err)
+
+				require.NotEmpty(t, live.tables)
+				drifts := diffSchemas(expected, live)
+				require.Empty(t, drifts, strings.Join(drifts, "\n"))
+			})
+		}
+	}
+}
+
+func TestSchemaDirWithoutBaseline(t *testing.T) {
+	// the sqlite versioned schema only alters tables created by its schema file
+	task := NewDiffSchemaTask(nil, &UpdateConfig{SchemaDir: "../../../schema/sqlite/v3/temporal/versioned"}, log.NewNoopLogger())
+	expected, err := task.expectedSchema("")
+	require.NoError(t, err)
+	live, err := task.schemaFileModel()
+	require.NoError(t, err)
+
+	require.Contains(t, expected.tables, "current_executions")
+	require.Contains(t, expected.tables["current_executions"].columns, "start_time")
+	drifts := diffSchemas(expected, live)
+	require.Empty(t, drifts, strings.Join(drifts, "\n"))
+}
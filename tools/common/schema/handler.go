@@ -52,11 +52,32 @@ func Update(cli *cli.Context, db DB, logger log.Logger) error {
 	return NewUpdateSchemaTask(db, cfg, logger).Run()
 }
 
+// Plan prints the pending schema updates for the specified database
+func Plan(cli *cli.Context, db DB, logger log.Logger) error {
+	cfg, err := newUpdateConfig(cli, db)
+	if err != nil {
+		return err
+	}
+	return NewUpdateSchemaTask(db, cfg, logger).Plan(cli.App.Writer)
+}
+
+// Diff compares the schema of the specified database with the versioned schema
+// and returns an error if they differ
+func Diff(cli *cli.Context, db DB, logger log.Logger) error {
+	cfg, err := newUpdateConfig(cli, db)
+	if err != nil {
+		return err
+	}
+	return NewDiffSchemaTask(db, cfg, logger).Run(cli.App.Writer)
+}
+
 func newUpdateConfig(cli *cli.Context, db DB) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
 	config.SchemaName = cli.String(CLIOptSchemaName)
 	config.TargetVersion = cli.String(CLIOptTargetVersion)
+	config.OnlineChunkSize = cli.Int(CLIOptOnlineChunkSize)
+	config.OnlineChunkInterval = cli.Duration(CLIOptOnlineChunkInterval)
 
 	if err := validateUpdateConfig(config, db); err != nil {
 		return nil, err
@@ -129,6 +150,12 @@ func validateUpdateConfig(config *UpdateConfig, db DB) error {
 		}
 		config.TargetVersion = ver
 	}
+	if config.OnlineChunkSize < 0 {
+		return NewConfigError(flag(CLIOptOnlineChunkSize) + " must not be negative")
+	}
+	if config.OnlineChunkInterval < 0 {
+		return NewConfigError(flag(CLIOptOnlineChunkInterval) + " must not be negative")
+	}
 	return nil
 }
 
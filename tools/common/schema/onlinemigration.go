@@ -0,0 +1,668 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// OnlineMigration is a change of a large table which runs in throttled chunks while the table stays
+	// writable, instead of as a single DDL statement which locks the table. Online migrations are listed in
+	// the OnlineMigrations of a version's manifest and run after the statements of the version. Their
+	// progress is checkpointed after every chunk, so an interrupted update resumes where it stopped.
+	OnlineMigration struct {
+		// Name identifies the migration within its version
+		Name string
+		// Strategy is either OnlineStrategyCopySwap or OnlineStrategyBackfill
+		Strategy string
+		// Table is the table to migrate
+		Table string
+		// KeyColumns are the primary key columns of Table, which are used to split it into chunks
+		KeyColumns []string
+		// Alter is the ALTER TABLE specification applied by copy-swap migrations,
+		// e.g. "ADD COLUMN start_time DATETIME(6) NULL"
+		Alter string
+		// Set is the SET clause of the UPDATE statement which backfill migrations run for every chunk,
+		// e.g. "data_encoding = ''"
+		Set string
+		// Where optionally restricts the rows updated by backfill migrations, e.g. "data_encoding IS NULL"
+		Where string
+		// ChunkSize is the maximum number of rows per chunk, defaults to 1000. Chunks shrink while they take
+		// longer than a second and grow back once the database keeps up again.
+		ChunkSize int
+		// ChunkInterval is the minimum pause between two chunks to throttle the migration, e.g. "100ms".
+		// The pause is never shorter than the previous chunk took, so the migration backs off when the
+		// database slows down and never keeps it busy for more than half of the time.
+		ChunkInterval string
+	}
+
+	onlineMigrator struct {
+		db            OnlineMigrationDB
+		logger        log.Logger
+		oldVersion    string
+		cs            *changeSet
+		migration     *OnlineMigration
+		chunkSize     int
+		maxChunkSize  int
+		chunkInterval time.Duration
+	}
+
+	onlineProgress struct {
+		state string
+		// checkpoint is the key of the last row of the last processed chunk
+		checkpoint []interface{}
+		chunks     int64
+	}
+
+	// keyValue is the JSON representation of a single column of a checkpoint
+	keyValue struct {
+		Type  string `json:"t"`
+		Value string `json:"v"`
+	}
+)
+
+const (
+	// OnlineStrategyCopySwap copies the table into a ghost table with the new definition and swaps the two
+	// tables once all rows are copied. Triggers keep the ghost table in sync with writes to the table while
+	// rows are copied. This strategy is only supported by MySQL.
+	OnlineStrategyCopySwap = "copy-swap"
+	// OnlineStrategyBackfill updates the rows of the table in chunks
+	OnlineStrategyBackfill = "backfill"
+
+	onlineStateRunning  = "running"
+	onlineStateSwapping = "swapping"
+	onlineStateDone     = "done"
+
+	defaultOnlineChunkSize    = 1000
+	minOnlineChunkSize        = 10
+	onlineChunkTargetDuration = time.Second
+	onlineProgressLogInterval = time.Minute
+	maxUpdateLogDescription   = 255
+
+	migrationProgressTable = "schema_migration_progress"
+	// statementProgressPrefix prefixes the progress names of the statements of a version, see execTrackedStmts
+	statementProgressPrefix = "#statement-"
+
+	createMigrationProgressTableQuery = `CREATE TABLE IF NOT EXISTS schema_migration_progress(` +
+		`version VARCHAR(64) NOT NULL, ` +
+		`name VARCHAR(255) NOT NULL, ` +
+		`state VARCHAR(16) NOT NULL, ` +
+		`last_key TEXT, ` +
+		`chunks BIGINT NOT NULL, ` +
+		`PRIMARY KEY (version, name))`
+
+	readMigrationProgressQuery   = `SELECT state, last_key, chunks FROM schema_migration_progress WHERE version = ? AND name = ?`
+	deleteMigrationProgressQuery = `DELETE FROM schema_migration_progress WHERE version = ? AND name = ?`
+	insertMigrationProgressQuery = `INSERT INTO schema_migration_progress(version, name, state, last_key, chunks) VALUES (?, ?, ?, ?, ?)`
+)
+
+func (m *OnlineMigration) validate() error {
+	if len(m.Name) == 0 {
+		return errors.New("online migration is missing Name")
+	}
+	if strings.HasPrefix(m.Name, "#") {
+		return fmt.Errorf("online migration name %v must not start with #", m.Name)
+	}
+	if len(m.Table) == 0 {
+		return fmt.Errorf("online migration %v is missing Table", m.Name)
+	}
+	if len(m.KeyColumns) == 0 {
+		return fmt.Errorf("online migration %v is missing KeyColumns", m.Name)
+	}
+	switch m.Strategy {
+	case OnlineStrategyCopySwap:
+		if len(m.Alter) == 0 {
+			return fmt.Errorf("online migration %v is missing Alter", m.Name)
+		}
+	case OnlineStrategyBackfill:
+		if len(m.Set) == 0 {
+			return fmt.Errorf("online migration %v is missing Set", m.Name)
+		}
+	default:
+		return fmt.Errorf("online migration %v has unknown Strategy %q, must be one of %v or %v",
+			m.Name, m.Strategy, OnlineStrategyCopySwap, OnlineStrategyBackfill)
+	}
+	if m.ChunkSize < 0 {
+		return fmt.Errorf("online migration %v has negative ChunkSize", m.Name)
+	}
+	if len(m.ChunkInterval) > 0 {
+		if _, err := time.ParseDuration(m.ChunkInterval); err != nil {
+			return fmt.Errorf("online migration %v has invalid ChunkInterval: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// statement returns the statement which has the same effect as the migration
+func (m *OnlineMigration) statement() string {
+	if m.Strategy == OnlineStrategyCopySwap {
+		return fmt.Sprintf("ALTER TABLE %v %v", m.Table, m.Alter)
+	}
+	stmt := fmt.Sprintf("UPDATE %v SET %v", m.Table, m.Set)
+	if len(m.Where) > 0 {
+		stmt += " WHERE " + m.Where
+	}
+	return stmt
+}
+
+func validateOnlineMigrations(migrations []OnlineMigration) error {
+	names := make(map[string]struct{}, len(migrations))
+	for i := range migrations {
+		if err := migrations[i].validate(); err != nil {
+			return err
+		}
+		if _, ok := names[migrations[i].Name]; ok {
+			return fmt.Errorf("duplicate online migration %v", migrations[i].Name)
+		}
+		names[migrations[i].Name] = struct{}{}
+	}
+	return nil
+}
+
+// execTrackedStmts runs the statements of a version with online migrations. Every applied statement is
+// recorded in the progress table, so an update which is interrupted by a long running migration does not
+// run the statements again when it is resumed. Not all of them are idempotent, e.g. CREATE INDEX on MySQL.
+func (task *UpdateTask) execTrackedStmts(db OnlineMigrationDB, cs *changeSet) error {
+	if err := db.Exec(createMigrationProgressTableQuery); err != nil {
+		return fmt.Errorf("error creating %v table: %w", migrationProgressTable, err)
+	}
+	task.logger.Debug(fmt.Sprintf("---- Executing updates for version %v ----", cs.version))
+	for i, stmt := range cs.cqlStmts {
+		name := statementProgressName(i, stmt)
+		rows, err := db.Query(db.Rebind(readMigrationProgressQuery), cs.version, name)
+		if err != nil {
+			return fmt.Errorf("error reading statement progress: %w", err)
+		}
+		if len(rows) > 0 {
+			task.logger.Info("Statement already applied by a previous update attempt, skipping it", tag.NewInt("statement", i+1))
+			continue
+		}
+		if err := task.execStmt(stmt); err != nil {
+			return err
+		}
+		if err := db.Exec(db.Rebind(insertMigrationProgressQuery), cs.version, name, onlineStateDone, nil, 0); err != nil {
+			return fmt.Errorf("error saving statement progress: %w", err)
+		}
+	}
+	task.logger.Debug("---- Done ----")
+	return nil
+}
+
+// statementProgressName identifies a statement by its position and content, so a statement which was
+// changed between two attempts runs again
+func statementProgressName(i int, stmt string) string {
+	sum := sha256.Sum256([]byte(stmt))
+	return fmt.Sprintf("%v%d-%x", statementProgressPrefix, i+1, sum[:8])
+}
+
+func (task *UpdateTask) runOnlineMigrations(oldVer string, cs *changeSet) error {
+	if len(cs.manifest.OnlineMigrations) == 0 {
+		return nil
+	}
+	db, ok := task.db.(OnlineMigrationDB)
+	if !ok {
+		return fmt.Errorf("version %v has online migrations, which are not supported by %v databases", cs.version, task.db.Type())
+	}
+	for i := range cs.manifest.OnlineMigrations {
+		migrator := newOnlineMigrator(db, task.config, task.logger, oldVer, cs, &cs.manifest.OnlineMigrations[i])
+		if err := migrator.run(); err != nil {
+			return fmt.Errorf("error running online migration %v of version %v: %w", migrator.migration.Name, cs.version, err)
+		}
+	}
+	return nil
+}
+
+func newOnlineMigrator(
+	db OnlineMigrationDB,
+	config *UpdateConfig,
+	logger log.Logger,
+	oldVersion string,
+	cs *changeSet,
+	migration *OnlineMigration,
+) *onlineMigrator {
+	chunkSize := migration.ChunkSize
+	if config.OnlineChunkSize > 0 {
+		chunkSize = config.OnlineChunkSize
+	}
+	if chunkSize == 0 {
+		chunkSize = defaultOnlineChunkSize
+	}
+	// ChunkInterval was validated with the manifest
+	chunkInterval, _ := time.ParseDuration(migration.ChunkInterval)
+	if config.OnlineChunkInterval > 0 {
+		chunkInterval = config.OnlineChunkInterval
+	}
+	return &onlineMigrator{
+		db:            db,
+		logger:        log.With(logger, tag.NewStringTag("online-migration", migration.Name), tag.NewStringTag("table", migration.Table)),
+		oldVersion:    oldVersion,
+		cs:            cs,
+		migration:     migration,
+		chunkSize:     chunkSize,
+		maxChunkSize:  chunkSize,
+		chunkInterval: chunkInterval,
+	}
+}
+
+func (m *onlineMigrator) run() error {
+	if err := m.db.Exec(createMigrationProgressTableQuery); err != nil {
+		return fmt.Errorf("error creating %v table: %w", migrationProgressTable, err)
+	}
+	progress, err := m.readProgress()
+	if err != nil {
+		return err
+	}
+	if progress != nil && progress.state == onlineStateDone {
+		m.logger.Info("Online migration already done, skipping it")
+		return nil
+	}
+
+	switch m.migration.Strategy {
+	case OnlineStrategyCopySwap:
+		err = m.copySwap(progress)
+	case OnlineStrategyBackfill:
+		err = m.backfill(progress)
+	default:
+		err = fmt.Errorf("unknown online migration strategy %v", m.migration.Strategy)
+	}
+	return err
+}
+
+func (m *onlineMigrator) backfill(progress *onlineProgress) error {
+	if progress == nil {
+		progress = &onlineProgress{state: onlineStateRunning}
+		if err := m.writeUpdateLog("started"); err != nil {
+			return err
+		}
+	} else {
+		m.logger.Info("Resuming online migration", tag.NewInt64("chunks", progress.chunks))
+	}
+
+	err := m.processChunks(progress, func(cond string, args []interface{}) error {
+		stmt := fmt.Sprintf("UPDATE %v SET %v WHERE %v", m.migration.Table, m.migration.Set, cond)
+		if len(m.migration.Where) > 0 {
+			stmt += " AND (" + m.migration.Where + ")"
+		}
+		return m.db.Exec(m.db.Rebind(stmt), args...)
+	})
+	if err != nil {
+		return err
+	}
+	return m.finish(progress)
+}
+
+func (m *onlineMigrator) copySwap(progress *onlineProgress) error {
+	if !strings.HasPrefix(m.db.PluginName(), "mysql") {
+		return fmt.Errorf("%v online migrations are only supported by mysql, not %v", OnlineStrategyCopySwap, m.db.PluginName())
+	}
+	table := m.migration.Table
+	ghost := "_" + table + "_gho"
+	old := "_" + table + "_del"
+	triggers := []string{ghost + "_ins", ghost + "_upd", ghost + "_del"}
+
+	if progress != nil && progress.state == onlineStateRunning {
+		exists, err := m.tableExists(ghost)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			m.logger.Warn("Ghost table is missing, restarting online migration", tag.NewStringTag("ghost-table", ghost))
+			progress = nil
+		}
+	}
+
+	if progress == nil {
+		if err := m.createGhostTable(ghost, triggers); err != nil {
+			return err
+		}
+		progress = &onlineProgress{state: onlineStateRunning}
+		if err := m.saveProgress(progress); err != nil {
+			return err
+		}
+		if err := m.writeUpdateLog("started, copying rows to " + ghost); err != nil {
+			return err
+		}
+	} else {
+		m.logger.Info("Resuming online migration", tag.NewStringTag("state", progress.state), tag.NewInt64("chunks", progress.chunks))
+	}
+
+	if progress.state == onlineStateRunning {
+		columns, err := m.sharedColumns(table, ghost)
+		if err != nil {
+			return err
+		}
+		columnList := strings.Join(columns, ", ")
+		err = m.processChunks(progress, func(cond string, args []interface{}) error {
+			stmt := fmt.Sprintf("INSERT IGNORE INTO %v (%v) SELECT %v FROM %v WHERE %v", ghost, columnList, columnList, table, cond)
+			return m.db.Exec(m.db.Rebind(stmt), args...)
+		})
+		if err != nil {
+			return err
+		}
+		progress.state = onlineStateSwapping
+		if err := m.saveProgress(progress); err != nil {
+			return err
+		}
+	}
+
+	// the swap may already have happened if a previous attempt failed after renaming the tables
+	exists, err := m.tableExists(ghost)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := m.db.Exec(fmt.Sprintf("RENAME TABLE %v TO %v, %v TO %v", table, old, ghost, table)); err != nil {
+			return fmt.Errorf("error swapping %v with %v: %w", ghost, table, err)
+		}
+	}
+	if err := m.dropTriggers(triggers); err != nil {
+		return err
+	}
+	if err := m.db.Exec("DROP TABLE IF EXISTS " + old); err != nil {
+		return fmt.Errorf("error dropping %v: %w", old, err)
+	}
+	return m.finish(progress)
+}
+
+func (m *onlineMigrator) createGhostTable(ghost string, triggers []string) error {
+	table := m.migration.Table
+	if err := m.dropTriggers(triggers); err != nil {
+		return err
+	}
+	stmts := []string{
+		"DROP TABLE IF EXISTS " + ghost,
+		fmt.Sprintf("CREATE TABLE %v LIKE %v", ghost, table),
+		fmt.Sprintf("ALTER TABLE %v %v", ghost, m.migration.Alter),
+	}
+	for _, stmt := range stmts {
+		if err := m.db.Exec(stmt); err != nil {
+			return fmt.Errorf("error creating ghost table %v: %w", ghost, err)
+		}
+	}
+
+	columns, err := m.sharedColumns(table, ghost)
+	if err != nil {
+		return err
+	}
+	columnList := strings.Join(columns, ", ")
+	newValues := "NEW." + strings.Join(columns, ", NEW.")
+	keyColumns := "(" + strings.Join(m.migration.KeyColumns, ", ") + ")"
+	oldKey := "(OLD." + strings.Join(m.migration.KeyColumns, ", OLD.") + ")"
+	replace := fmt.Sprintf("REPLACE INTO %v (%v) VALUES (%v)", ghost, columnList, newValues)
+	deleteOld := fmt.Sprintf("DELETE FROM %v WHERE %v = %v", ghost, keyColumns, oldKey)
+	stmts = []string{
+		fmt.Sprintf("CREATE TRIGGER %v AFTER INSERT ON %v FOR EACH ROW %v", triggers[0], table, replace),
+		fmt.Sprintf("CREATE TRIGGER %v AFTER UPDATE ON %v FOR EACH ROW BEGIN %v; %v; END", triggers[1], table, deleteOld, replace),
+		fmt.Sprintf("CREATE TRIGGER %v AFTER DELETE ON %v FOR EACH ROW %v", triggers[2], table, deleteOld),
+	}
+	for _, stmt := range stmts {
+		if err := m.db.Exec(stmt); err != nil {
+			return fmt.Errorf("error creating trigger on %v: %w", table, err)
+		}
+	}
+	return nil
+}
+
+func (m *onlineMigrator) dropTriggers(triggers []string) error {
+	for _, trigger := range triggers {
+		if err := m.db.Exec("DROP TRIGGER IF EXISTS " + trigger); err != nil {
+			return fmt.Errorf("error dropping trigger %v: %w", trigger, err)
+		}
+	}
+	return nil
+}
+
+// sharedColumns returns the columns which exist in both tables, i.e. the columns to copy
+func (m *onlineMigrator) sharedColumns(table string, ghost string) ([]string, error) {
+	columns, err := m.db.ListColumns()
+	if err != nil {
+		return nil, fmt.Errorf("error listing columns: %w", err)
+	}
+	tableColumns := make(map[string]struct{})
+	for _, column := range columns {
+		if strings.EqualFold(column.Table, table) {
+			tableColumns[strings.ToLower(column.Name)] = struct{}{}
+		}
+	}
+	var shared []string
+	for _, column := range columns {
+		if !strings.EqualFold(column.Table, ghost) {
+			continue
+		}
+		if _, ok := tableColumns[strings.ToLower(column.Name)]; ok {
+			shared = append(shared, column.Name)
+		}
+	}
+	if len(shared) == 0 {
+		return nil, fmt.Errorf("tables %v and %v have no columns in common", table, ghost)
+	}
+	slices.Sort(shared)
+	return shared, nil
+}
+
+func (m *onlineMigrator) tableExists(name string) (bool, error) {
+	tables, err := m.db.ListTables()
+	if err != nil {
+		return false, fmt.Errorf("error listing tables: %w", err)
+	}
+	return slices.ContainsFunc(tables, func(table string) bool {
+		return strings.EqualFold(table, name)
+	}), nil
+}
+
+// processChunks calls process for consecutive ranges of ChunkSize rows of the table, starting after the
+// checkpoint of the progress. The range is passed as a condition on the key columns and its arguments.
+func (m *onlineMigrator) processChunks(progress *onlineProgress, process func(cond string, args []interface{}) error) error {
+	keys := strings.Join(m.migration.KeyColumns, ", ")
+	keyTuple := "(" + keys + ")"
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(m.migration.KeyColumns)), ", ") + ")"
+	lastLog := time.Now()
+
+	for {
+		var conds []string
+		var args []interface{}
+		if progress.checkpoint != nil {
+			conds = append(conds, keyTuple+" > "+placeholders)
+			args = append(args, progress.checkpoint...)
+		}
+
+		boundaryQuery := fmt.Sprintf("SELECT %v FROM %v", keys, m.migration.Table)
+		if len(conds) > 0 {
+			boundaryQuery += " WHERE " + conds[0]
+		}
+		boundaryQuery += fmt.Sprintf(" ORDER BY %v LIMIT 1 OFFSET %d", keys, m.chunkSize-1)
+		rows, err := m.db.Query(m.db.Rebind(boundaryQuery), args...)
+		if err != nil {
+			return fmt.Errorf("error reading chunk boundary: %w", err)
+		}
+		var upper []interface{}
+		if len(rows) > 0 {
+			upper = rows[0]
+			conds = append(conds, keyTuple+" <= "+placeholders)
+			args = append(args, upper...)
+		}
+
+		cond := "1 = 1"
+		if len(conds) > 0 {
+			cond = strings.Join(conds, " AND ")
+		}
+		start := time.Now()
+		if err := process(cond, args); err != nil {
+			return fmt.Errorf("error processing chunk %v: %w", progress.chunks+1, err)
+		}
+		pause := m.throttle(time.Since(start))
+		progress.chunks++
+		if upper == nil {
+			return nil
+		}
+
+		progress.checkpoint = upper
+		if err := m.saveProgress(progress); err != nil {
+			return err
+		}
+		if time.Since(lastLog) >= onlineProgressLogInterval {
+			lastLog = time.Now()
+			if err := m.writeUpdateLog(fmt.Sprintf("%v chunks processed", progress.chunks)); err != nil {
+				return err
+			}
+		}
+		time.Sleep(pause)
+	}
+}
+
+// throttle adapts the chunk size to how long the last chunk took and returns the pause before the next one
+func (m *onlineMigrator) throttle(elapsed time.Duration) time.Duration {
+	if elapsed > onlineChunkTargetDuration {
+		m.chunkSize = max(m.chunkSize/2, min(minOnlineChunkSize, m.maxChunkSize))
+	} else if elapsed < onlineChunkTargetDuration/2 {
+		m.chunkSize = min(m.chunkSize*2, m.maxChunkSize)
+	}
+	return max(m.chunkInterval, elapsed)
+}
+
+func (m *onlineMigrator) finish(progress *onlineProgress) error {
+	progress.state = onlineStateDone
+	progress.checkpoint = nil
+	if err := m.saveProgress(progress); err != nil {
+		return err
+	}
+	m.logger.Info("Online migration done", tag.NewInt64("chunks", progress.chunks))
+	return m.writeUpdateLog(fmt.Sprintf("done after %v chunks", progress.chunks))
+}
+
+func (m *onlineMigrator) readProgress() (*onlineProgress, error) {
+	rows, err := m.db.Query(m.db.Rebind(readMigrationProgressQuery), m.cs.version, m.migration.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error reading online migration progress: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	row := rows[0]
+	progress := &onlineProgress{}
+	progress.state, _ = row[0].(string)
+	progress.chunks, _ = row[2].(int64)
+	if lastKey, ok := row[1].(string); ok && len(lastKey) > 0 {
+		if progress.checkpoint, err = decodeKey(lastKey); err != nil {
+			return nil, fmt.Errorf("error decoding online migration checkpoint: %w", err)
+		}
+	}
+	return progress, nil
+}
+
+func (m *onlineMigrator) saveProgress(progress *onlineProgress) error {
+	var lastKey interface{}
+	if progress.checkpoint != nil {
+		encoded, err := encodeKey(progress.checkpoint)
+		if err != nil {
+			return fmt.Errorf("error encoding online migration checkpoint: %w", err)
+		}
+		lastKey = encoded
+	}
+	if err := m.db.Exec(m.db.Rebind(deleteMigrationProgressQuery), m.cs.version, m.migration.Name); err != nil {
+		return fmt.Errorf("error saving online migration progress: %w", err)
+	}
+	err := m.db.Exec(m.db.Rebind(insertMigrationProgressQuery), m.cs.version, m.migration.Name, progress.state, lastKey, progress.chunks)
+	if err != nil {
+		return fmt.Errorf("error saving online migration progress: %w", err)
+	}
+	return nil
+}
+
+// writeUpdateLog records a milestone of the migration in the schema update history
+func (m *onlineMigrator) writeUpdateLog(event string) error {
+	m.logger.Info("Online migration " + event)
+	desc := fmt.Sprintf("online %v migration %v of %v: %v", m.migration.Strategy, m.migration.Name, m.migration.Table, event)
+	if len(desc) > maxUpdateLogDescription {
+		desc = desc[:maxUpdateLogDescription]
+	}
+	if err := m.db.WriteSchemaUpdateLog(m.oldVersion, m.cs.version, m.cs.manifest.md5, desc); err != nil {
+		return fmt.Errorf("failed to add entry to schema_update_history, err=%w", err)
+	}
+	return nil
+}
+
+func encodeKey(key []interface{}) (string, error) {
+	values := make([]keyValue, len(key))
+	for i, v := range key {
+		switch v := v.(type) {
+		case int64:
+			values[i] = keyValue{Type: "i", Value: strconv.FormatInt(v, 10)}
+		case float64:
+			values[i] = keyValue{Type: "f", Value: strconv.FormatFloat(v, 'g', -1, 64)}
+		case string:
+			values[i] = keyValue{Type: "s", Value: v}
+		case []byte:
+			values[i] = keyValue{Type: "b", Value: base64.StdEncoding.EncodeToString(v)}
+		case time.Time:
+			values[i] = keyValue{Type: "t", Value: v.Format(time.RFC3339Nano)}
+		default:
+			return "", fmt.Errorf("unsupported key column type %T", v)
+		}
+	}
+	encoded, err := json.Marshal(values)
+	return string(encoded), err
+}
+
+func decodeKey(encoded string) ([]interface{}, error) {
+	var values []keyValue
+	if err := json.Unmarshal([]byte(encoded), &values); err != nil {
+		return nil, err
+	}
+	key := make([]interface{}, len(values))
+	for i, v := range values {
+		var err error
+		switch v.Type {
+		case "i":
+			key[i], err = strconv.ParseInt(v.Value, 10, 64)
+		case "f":
+			key[i], err = strconv.ParseFloat(v.Value, 64)
+		case "s":
+			key[i] = v.Value
+		case "b":
+			key[i], err = base64.StdEncoding.DecodeString(v.Value)
+		case "t":
+			key[i], err = time.Parse(time.RFC3339Nano, v.Value)
+		default:
+			err = fmt.Errorf("unknown key column type %q", v.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
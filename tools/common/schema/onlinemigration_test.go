@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeKey(t *testing.T) {
+	key := []interface{}{
+		int64(42),
+		"workflow-id",
+		[]byte{0x00, 0xff, 0x10},
+		1.5,
+		time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+	}
+	encoded, err := encodeKey(key)
+	require.NoError(t, err)
+	decoded, err := decodeKey(encoded)
+	require.NoError(t, err)
+	require.Equal(t, key, decoded)
+
+	_, err = encodeKey([]interface{}{true})
+	require.Error(t, err)
+	_, err = decodeKey(`[{"t":"x","v":"1"}]`)
+	require.Error(t, err)
+}
+
+func TestOnlineMigrationStatement(t *testing.T) {
+	copySwap := OnlineMigration{Strategy: OnlineStrategyCopySwap, Table: "executions", Alter: "ADD COLUMN x INT NULL"}
+	require.Equal(t, "ALTER TABLE executions ADD COLUMN x INT NULL", copySwap.statement())
+
+	backfill := OnlineMigration{Strategy: OnlineStrategyBackfill, Table: "executions", Set: "x = 0", Where: "x IS NULL"}
+	require.Equal(t, "UPDATE executions SET x = 0 WHERE x IS NULL", backfill.statement())
+
+	model := newSchemaModel()
+	require.NoError(t, model.apply("CREATE TABLE executions (shard_id INT, PRIMARY KEY (shard_id))"))
+	require.NoError(t, model.apply(copySwap.statement()))
+	require.Contains(t, model.tables["executions"].columns, "x")
+}
+
+func TestOnlineMigratorThrottle(t *testing.T) {
+	m := &onlineMigrator{chunkSize: 1000, maxChunkSize: 1000, chunkInterval: 50 * time.Millisecond}
+
+	require.Equal(t, 50*time.Millisecond, m.throttle(time.Millisecond))
+	require.Equal(t, 1000, m.chunkSize)
+
+	// slow chunks shrink the chunk size down to the minimum and lengthen the pause
+	require.Equal(t, 3*time.Second, m.throttle(3*time.Second))
+	require.Equal(t, 500, m.chunkSize)
+	for i := 0; i < 10; i++ {
+		m.throttle(3 * time.Second)
+	}
+	require.Equal(t, minOnlineChunkSize, m.chunkSize)
+
+	// chunks within the target keep their size, fast ones grow back up to the maximum
+	require.Equal(t, 700*time.Millisecond, m.throttle(700*time.Millisecond))
+	require.Equal(t, minOnlineChunkSize, m.chunkSize)
+	for i := 0; i < 10; i++ {
+		m.throttle(time.Millisecond)
+	}
+	require.Equal(t, 1000, m.chunkSize)
+}
+
+func TestValidateOnlineMigrationName(t *testing.T) {
+	m := OnlineMigration{Name: statementProgressPrefix + "1", Strategy: OnlineStrategyBackfill, Table: "t", KeyColumns: []string{"id"}, Set: "x = 0"}
+	require.ErrorContains(t, m.validate(), "must not start with #")
+}
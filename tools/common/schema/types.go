@@ -27,6 +27,7 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 type (
@@ -43,6 +44,9 @@ type (
 		SchemaDir     string
 		SchemaName    string
 		IsDryRun      bool
+		// OnlineChunkSize and OnlineChunkInterval override the chunk settings of online migrations if set
+		OnlineChunkSize     int
+		OnlineChunkInterval time.Duration
 	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
@@ -74,6 +78,36 @@ type (
 		// Type gives the type of db (e.g. "cassandra", "sql")
 		Type() string
 	}
+
+	// OnlineMigrationDB is implemented by DBs which can run the online migrations of versioned schemas
+	OnlineMigrationDB interface {
+		DB
+		Inspector
+		// Query executes a statement and returns the column values of all result rows
+		Query(stmt string, args ...interface{}) ([][]interface{}, error)
+		// Rebind converts the ? bind vars of a statement to the ones of the database
+		Rebind(stmt string) string
+		// PluginName returns the name of the sql plugin, e.g. mysql8
+		PluginName() string
+	}
+
+	// Inspector is implemented by DBs which can list the objects of their live schema,
+	// which is required to detect schema drift
+	Inspector interface {
+		// ListTables returns the tables of the database
+		ListTables() ([]string, error)
+		// ListColumns returns the columns of all tables of the database
+		ListColumns() ([]TableObject, error)
+		// ListIndexes returns the secondary indexes of all tables of the database. Indexes which back
+		// primary keys and unique constraints are excluded where the database allows to tell them apart.
+		ListIndexes() ([]TableObject, error)
+	}
+
+	// TableObject is a column or index of a table
+	TableObject struct {
+		Table string
+		Name  string
+	}
 )
 
 const (
@@ -113,10 +147,16 @@ const (
 	CLIOptSchemaDir = "schema-dir"
 	// CLIOptSchemaName is the cli option for which pre-embedded schema to use
 	CLIOptSchemaName = "schema-name"
+	// CLIOptOnlineChunkSize is the cli option for the number of rows per chunk of online migrations
+	CLIOptOnlineChunkSize = "online-chunk-size"
+	// CLIOptOnlineChunkInterval is the cli option for the pause between chunks of online migrations
+	CLIOptOnlineChunkInterval = "online-chunk-interval"
 	// CLIOptReplicationFactor is the cli option for replication factor
 	CLIOptReplicationFactor = "replication-factor"
 	// CLIOptDatacenter is the cli option for NetworkTopologyStrategy datacenter
 	CLIOptDatacenter = "datacenter"
+	// CLIOptDisableTablets is the cli option for creating ScyllaDB keyspaces without tablets
+	CLIOptDisableTablets = "disable-tablets"
 	// CLIOptConsistency is the cli option for consistency settings
 	CLIOptConsistency = "consistency"
 	// CLIOptAddressTranslator is the cli option for address translator for Cassandra
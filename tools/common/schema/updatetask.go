@@ -67,6 +67,8 @@ type (
 		MinCompatibleVersion string
 		Description          string
 		SchemaUpdateCqlFiles []string
+		// OnlineMigrations run after the statements of SchemaUpdateCqlFiles, see OnlineMigration
+		OnlineMigrations []OnlineMigration
 		// If set, the manifest is intentionally opting out of schema updates.
 		AllowNoCqlFiles bool
 		md5             string
@@ -131,6 +133,35 @@ func (task *UpdateTask) Run() error {
 	return nil
 }
 
+// Plan prints the schema changes which Run would apply, without applying them
+func (task *UpdateTask) Plan(w io.Writer) error {
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	updates, err := task.buildChangeSet(currVer)
+	if err != nil {
+		return err
+	}
+
+	if len(updates) == 0 {
+		fmt.Fprintf(w, "Schema is up to date at version %v.\n", currVer)
+		return nil
+	}
+	fmt.Fprintf(w, "Schema will be updated from version %v to %v.\n", currVer, updates[len(updates)-1].version)
+	for _, cs := range updates {
+		fmt.Fprintf(w, "\n-- version %v: %v\n", cs.version, cs.manifest.Description)
+		for _, stmt := range cs.cqlStmts {
+			fmt.Fprintf(w, "%v;\n", strings.TrimSuffix(strings.TrimSpace(stmt), ";"))
+		}
+		for _, m := range cs.manifest.OnlineMigrations {
+			fmt.Fprintf(w, "-- online %v migration %v:\n%v;\n", m.Strategy, m.Name, m.statement())
+		}
+	}
+	return nil
+}
+
 func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) error {
 	if len(updates) == 0 {
 		task.logger.Debug(fmt.Sprintf("found zero updates from current version %v", currVer))
@@ -139,7 +170,16 @@ func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) erro
 
 	task.logger.Debug(fmt.Sprintf("running %v updates for current version %v", len(updates), currVer))
 	for _, cs := range updates {
-		err := task.execStmts(cs.version, cs.cqlStmts)
+		var err error
+		if db, ok := task.db.(OnlineMigrationDB); ok && len(cs.manifest.OnlineMigrations) > 0 {
+			err = task.execTrackedStmts(db, &cs)
+		} else {
+			err = task.execStmts(cs.version, cs.cqlStmts)
+		}
+		if err != nil {
+			return err
+		}
+		err = task.runOnlineMigrations(currVer, &cs)
 		if err != nil {
 			return err
 		}
@@ -158,27 +198,34 @@ func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) erro
 func (task *UpdateTask) execStmts(ver string, stmts []string) error {
 	task.logger.Debug(fmt.Sprintf("---- Executing updates for version %v ----", ver))
 	for _, stmt := range stmts {
-		task.logger.Debug(rmspaceRegex.ReplaceAllString(stmt, " "))
-		err := task.db.Exec(stmt)
-		if err != nil {
-			// To make schema update idempotent, we need to handle error when retry on previous partially succeeded update attempt.
-			// There are 2 major cases that will be handled:
-			// 1) Add table or column that already exists (message contains 'already existing' for table or 'already exists' for column)
-			// 2) Drop column that is not found (message contains 'not found')
-			alreadyExists := strings.Contains(err.Error(), "already exist")
-			notFound := strings.Contains(err.Error(), "not found")
-			if alreadyExists || notFound {
-				task.logger.Warn("Duplicate update, most likely due to previous partially succeeded update attempt. Ignoring it and continue.", tag.Error(err))
-				continue
-			}
-
-			return fmt.Errorf("error executing statement: %w", err)
+		if err := task.execStmt(stmt); err != nil {
+			return err
 		}
 	}
 	task.logger.Debug("---- Done ----")
 	return nil
 }
 
+func (task *UpdateTask) execStmt(stmt string) error {
+	task.logger.Debug(rmspaceRegex.ReplaceAllString(stmt, " "))
+	err := task.db.Exec(stmt)
+	if err != nil {
+		// To make schema update idempotent, we need to handle error when retry on previous partially succeeded update attempt.
+		// There are 2 major cases that will be handled:
+		// 1) Add table or column that already exists (message contains 'already existing' for table or 'already exists' for column)
+		// 2) Drop column that is not found (message contains 'not found')
+		alreadyExists := strings.Contains(err.Error(), "already exist")
+		notFound := strings.Contains(err.Error(), "not found")
+		if alreadyExists || notFound {
+			task.logger.Warn("Duplicate update, most likely due to previous partially succeeded update attempt. Ignoring it and continue.", tag.Error(err))
+			return nil
+		}
+
+		return fmt.Errorf("error executing statement: %w", err)
+	}
+	return nil
+}
+
 func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet) error {
 	err := task.db.UpdateSchemaVersion(cs.version, cs.manifest.MinCompatibleVersion)
 	if err != nil {
@@ -277,7 +324,7 @@ func (task *UpdateTask) parseSQLStmts(fsys fs.FS, dir string, manifest *manifest
 		result = append(result, stmts...)
 	}
 
-	if len(result) == 0 && !manifest.AllowNoCqlFiles {
+	if len(result) == 0 && len(manifest.OnlineMigrations) == 0 && !manifest.AllowNoCqlFiles {
 		return nil, fmt.Errorf("found 0 updates in dir %v", dir)
 	}
 
@@ -334,10 +381,14 @@ func readManifest(fsys fs.FS, dirPath string) (*manifest, error) {
 	}
 	manifest.MinCompatibleVersion = minVer
 
-	if len(manifest.SchemaUpdateCqlFiles) == 0 && !manifest.AllowNoCqlFiles {
+	if len(manifest.SchemaUpdateCqlFiles) == 0 && len(manifest.OnlineMigrations) == 0 && !manifest.AllowNoCqlFiles {
 		return nil, fmt.Errorf("manifest missing SchemaUpdateCqlFiles")
 	}
 
+	if err := validateOnlineMigrations(manifest.OnlineMigrations); err != nil {
+		return nil, err
+	}
+
 	// See comment above. This is an appropriate usage of md5.
 	// #nosec
 	md5Bytes := md5.Sum(jsonBlob)
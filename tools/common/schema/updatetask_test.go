@@ -177,6 +177,22 @@ func (s *UpdateTaskTestSuite) TestReadManifest() {
 			}`,
 			files: nil,
 		},
+		{
+			schema: `{
+				"CurrVersion": "0.4",
+				"MinCompatibleVersion": "0.1",
+				"Description": "upgrade",
+				"OnlineMigrations": [{
+					"Name": "add_column",
+					"Strategy": "copy-swap",
+					"Table": "executions",
+					"KeyColumns": ["shard_id", "run_id"],
+					"Alter": "ADD COLUMN x INT NULL",
+					"ChunkInterval": "100ms"
+				}]
+			}`,
+			files: nil,
+		},
 	}
 	for _, c := range validCases {
 		s.runReadManifestTest(tmpDir, c.schema, "0.4", "0.1", "upgrade", c.files, false)
@@ -216,6 +232,33 @@ func (s *UpdateTaskTestSuite) TestReadManifest() {
 			"Description": "base version of schema",
 			"SchemaUpdateCqlFiles": []
 		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "unknown online migration strategy",
+			"OnlineMigrations": [{"Name": "m", "Strategy": "copy", "Table": "t", "KeyColumns": ["id"]}]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "backfill without Set",
+			"OnlineMigrations": [{"Name": "m", "Strategy": "backfill", "Table": "t", "KeyColumns": ["id"]}]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "online migration without KeyColumns",
+			"OnlineMigrations": [{"Name": "m", "Strategy": "backfill", "Table": "t", "Set": "x = 1"}]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "duplicate online migrations",
+			"OnlineMigrations": [
+				{"Name": "m", "Strategy": "backfill", "Table": "t", "KeyColumns": ["id"], "Set": "x = 1"},
+				{"Name": "m", "Strategy": "backfill", "Table": "t", "KeyColumns": ["id"], "Set": "y = 1"}
+			]
+		 }`,
 	}
 
 	for _, in := range errInputs {
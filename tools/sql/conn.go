@@ -45,6 +45,8 @@ type (
 const dbType = "sql"
 
 var _ schema.DB = (*Connection)(nil)
+var _ schema.Inspector = (*Connection)(nil)
+var _ schema.OnlineMigrationDB = (*Connection)(nil)
 
 // NewConnection creates a new connection to database
 func NewConnection(cfg *config.SQL, logger log.Logger) (*Connection, error) {
@@ -84,11 +86,46 @@ func (c *Connection) Exec(stmt string, args ...interface{}) error {
 	return c.adminDb.Exec(stmt, args...)
 }
 
+// Query executes a sql query and returns the column values of all result rows
+func (c *Connection) Query(stmt string, args ...interface{}) ([][]interface{}, error) {
+	return c.adminDb.QueryRows(stmt, args...)
+}
+
+// Rebind converts the ? bind vars of a statement to the ones of this database
+func (c *Connection) Rebind(stmt string) string {
+	return c.adminDb.Rebind(stmt)
+}
+
+// PluginName returns the name of the sql plugin
+func (c *Connection) PluginName() string {
+	return c.adminDb.PluginName()
+}
+
 // ListTables returns a list of tables in this database
 func (c *Connection) ListTables() ([]string, error) {
 	return c.adminDb.ListTables(c.dbName)
 }
 
+// ListColumns returns the columns of all tables in this database
+func (c *Connection) ListColumns() ([]schema.TableObject, error) {
+	rows, err := c.adminDb.ListColumns(c.dbName)
+	return toTableObjects(rows), err
+}
+
+// ListIndexes returns the secondary indexes of all tables in this database
+func (c *Connection) ListIndexes() ([]schema.TableObject, error) {
+	rows, err := c.adminDb.ListIndexes(c.dbName)
+	return toTableObjects(rows), err
+}
+
+func toTableObjects(rows []sqlplugin.TableObjectRow) []schema.TableObject {
+	objects := make([]schema.TableObject, 0, len(rows))
+	for _, row := range rows {
+		objects = append(objects, schema.TableObject{Table: row.TableName, Name: row.Name})
+	}
+	return objects
+}
+
 // DropTable drops a given table from the database
 func (c *Connection) DropTable(name string) error {
 	return c.adminDb.DropTable(name)
@@ -191,11 +191,61 @@ func BuildCLIOptions() *cli.App {
 					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
 						dbschemas.PathsByDB("mysql")),
 				},
+				cli.IntFlag{
+					Name:  schema.CLIOptOnlineChunkSize,
+					Usage: "number of rows per chunk of online migrations, overrides the chunk size of the manifests",
+				},
+				cli.DurationFlag{
+					Name:  schema.CLIOptOnlineChunkInterval,
+					Usage: "pause between chunks of online migrations, overrides the chunk interval of the manifests",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema, logger)
 			},
 		},
+		{
+			Name:    "plan-schema",
+			Aliases: []string{"plan"},
+			Usage:   "print the sql schema updates that update-schema would apply, without applying them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "target version for the schema update, defaults to latest",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("mysql")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, planSchema, logger)
+			},
+		},
+		{
+			Name:    "diff-schema",
+			Aliases: []string{"diff", "drift"},
+			Usage:   "compare the live sql schema with the versioned schema and exit non-zero on drift",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name: schema.CLIFlagSchemaName,
+					Usage: fmt.Sprintf("name of embedded versioned schema, one of: %v",
+						dbschemas.PathsByDB("mysql")),
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, diffSchema, logger)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
@@ -0,0 +1,183 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/tools/common/schema"
+)
+
+const onlineMigrationManifest = `{
+	"CurrVersion": "0.1",
+	"MinCompatibleVersion": "0.1",
+	"Description": "backfill data_encoding",
+	"OnlineMigrations": [{
+		"Name": "backfill_data_encoding",
+		"Strategy": "backfill",
+		"Table": "executions",
+		"KeyColumns": ["shard_id", "workflow_id"],
+		"Set": "data_encoding = 'json'",
+		"Where": "data_encoding IS NULL",
+		"ChunkSize": 7
+	}]
+}`
+
+func TestOnlineBackfill(t *testing.T) {
+	conn := newBackfillTestConnection(t)
+
+	task := schema.NewUpdateSchemaTask(conn, &schema.UpdateConfig{SchemaDir: writeBackfillManifest(t)}, log.NewNoopLogger())
+	require.NoError(t, task.Run())
+
+	version, err := conn.ReadSchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, "0.1", version)
+
+	rows, err := conn.Query("SELECT data_encoding, COUNT(*) FROM executions GROUP BY data_encoding ORDER BY data_encoding")
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{{"json", int64(45)}, {"proto3", int64(5)}}, rows)
+
+	rows, err = conn.Query("SELECT state, chunks FROM schema_migration_progress WHERE version = '0.1'")
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{{"done", int64(8)}}, rows)
+
+	rows, err = conn.Query("SELECT description FROM schema_update_history WHERE description LIKE 'online %' ORDER BY update_time")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+}
+
+func TestOnlineBackfillResumesFromCheckpoint(t *testing.T) {
+	conn := newBackfillTestConnection(t)
+	// progress of an interrupted attempt which processed all rows up to shard 2
+	require.NoError(t, conn.Exec("CREATE TABLE schema_migration_progress (version VARCHAR(64) NOT NULL, name VARCHAR(255) NOT NULL, "+
+		"state VARCHAR(16) NOT NULL, last_key TEXT, chunks BIGINT NOT NULL, PRIMARY KEY (version, name))"))
+	require.NoError(t, conn.Exec("INSERT INTO schema_migration_progress VALUES ('0.1', 'backfill_data_encoding', 'running', ?, 5)",
+		`[{"t":"i","v":"2"},{"t":"s","v":"wf-9"}]`))
+
+	task := schema.NewUpdateSchemaTask(conn, &schema.UpdateConfig{SchemaDir: writeBackfillManifest(t)}, log.NewNoopLogger())
+	require.NoError(t, task.Run())
+
+	rows, err := conn.Query("SELECT shard_id, COUNT(*) FROM executions WHERE data_encoding = 'json' GROUP BY shard_id ORDER BY shard_id")
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{{int64(3), int64(9)}, {int64(4), int64(9)}}, rows)
+
+	rows, err = conn.Query("SELECT state, chunks FROM schema_migration_progress WHERE version = '0.1'")
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{{"done", int64(8)}}, rows)
+}
+
+func TestOnlineMigrationSkipsAppliedStatements(t *testing.T) {
+	conn := newBackfillTestConnection(t)
+	dir := writeBackfillManifest(t)
+	manifest := strings.Replace(onlineMigrationManifest, `"Description"`, `"SchemaUpdateCqlFiles": ["insert.sql"], "Description"`, 1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v0.1", "manifest.json"), []byte(manifest), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v0.1", "insert.sql"), []byte(
+		"INSERT INTO executions VALUES (5, 'wf-0', NULL);\nINSERT INTO executions VALUES (6, 'wf-0', NULL);\n"), 0644))
+
+	// an interrupted attempt applied the first statement, running it again would violate the primary key
+	require.NoError(t, conn.Exec("INSERT INTO executions VALUES (5, 'wf-0', NULL)"))
+	task := schema.NewUpdateSchemaTask(conn, &schema.UpdateConfig{SchemaDir: dir}, log.NewNoopLogger())
+	require.ErrorContains(t, task.Run(), "error executing statement")
+	require.NoError(t, conn.Exec("DELETE FROM executions WHERE shard_id = 5"))
+	require.NoError(t, conn.Exec("DELETE FROM schema_migration_progress"))
+
+	// fail the update after the statements, before the migration
+	require.NoError(t, conn.Exec("ALTER TABLE executions RENAME COLUMN data_encoding TO encoding"))
+	require.Error(t, task.Run())
+	require.NoError(t, conn.Exec("ALTER TABLE executions RENAME COLUMN encoding TO data_encoding"))
+
+	require.NoError(t, task.Run())
+	rows, err := conn.Query("SELECT COUNT(*) FROM executions WHERE data_encoding = 'json'")
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{{int64(47)}}, rows)
+}
+
+func TestOnlineCopySwapRequiresMySQL(t *testing.T) {
+	conn := newSQLiteConnection(t)
+	require.NoError(t, conn.Exec("CREATE TABLE executions (shard_id INT NOT NULL PRIMARY KEY)"))
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "v0.1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v0.1", "manifest.json"), []byte(`{
+		"CurrVersion": "0.1",
+		"MinCompatibleVersion": "0.1",
+		"Description": "add column",
+		"OnlineMigrations": [{
+			"Name": "add_column",
+			"Strategy": "copy-swap",
+			"Table": "executions",
+			"KeyColumns": ["shard_id"],
+			"Alter": "ADD COLUMN x INT NULL"
+		}]
+	}`), 0644))
+
+	task := schema.NewUpdateSchemaTask(conn, &schema.UpdateConfig{SchemaDir: dir}, log.NewNoopLogger())
+	require.ErrorContains(t, task.Run(), "only supported by mysql")
+}
+
+func newBackfillTestConnection(t *testing.T) *Connection {
+	conn := newSQLiteConnection(t)
+	require.NoError(t, conn.Exec("CREATE TABLE executions (shard_id INT NOT NULL, workflow_id VARCHAR(255) NOT NULL, "+
+		"data_encoding VARCHAR(16), PRIMARY KEY (shard_id, workflow_id))"))
+	for shard := 0; shard < 5; shard++ {
+		for i := 0; i < 10; i++ {
+			encoding := interface{}(nil)
+			if i == 0 {
+				encoding = "proto3"
+			}
+			require.NoError(t, conn.Exec("INSERT INTO executions VALUES (?, ?, ?)", shard, fmt.Sprintf("wf-%d", i), encoding))
+		}
+	}
+	return conn
+}
+
+func writeBackfillManifest(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "v0.1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v0.1", "manifest.json"), []byte(onlineMigrationManifest), 0644))
+	return dir
+}
+
+func newSQLiteConnection(t *testing.T) *Connection {
+	conn, err := NewConnection(&config.SQL{
+		PluginName:        "sqlite",
+		DatabaseName:      filepath.Join(t.TempDir(), "temporal.db"),
+		ConnectAttributes: map[string]string{"mode": "rwc"},
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+
+	require.NoError(t, conn.CreateSchemaVersionTables())
+	require.NoError(t, conn.UpdateSchemaVersion("0.0", "0.0"))
+	return conn
+}
@@ -593,6 +593,57 @@ func AdminListClusterMembers(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// AdminDescribeNexusEndpoint describes the circuit breakers of a Nexus endpoint
+func AdminDescribeNexusEndpoint(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeNexusEndpoint(ctx, &adminservice.DescribeNexusEndpointRequest{
+		Id: c.String(FlagEndpointID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe Nexus endpoint: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminUpdateNexusEndpointOutboundSettings sets the outbound TLS and auth header settings of a Nexus endpoint
+func AdminUpdateNexusEndpointOutboundSettings(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	request := &adminservice.UpdateNexusEndpointOutboundSettingsRequest{
+		Id:      c.String(FlagEndpointID),
+		Version: c.Int64(FlagEndpointVersion),
+	}
+	if c.IsSet(FlagTargetTLSClientCertFile) || c.IsSet(FlagTargetTLSClientKeyFile) || c.IsSet(FlagTargetTLSCAFile) || c.IsSet(FlagTargetTLSServerName) {
+		request.Tls = &persistencespb.NexusEndpointTarget_TLS{
+			ClientCertFile: c.String(FlagTargetTLSClientCertFile),
+			ClientKeyFile:  c.String(FlagTargetTLSClientKeyFile),
+			CaFile:         c.String(FlagTargetTLSCAFile),
+			ServerName:     c.String(FlagTargetTLSServerName),
+		}
+	}
+	if c.IsSet(FlagTargetAuthHeaderName) || c.IsSet(FlagTargetAuthSecretName) || c.IsSet(FlagTargetAuthValuePrefix) {
+		request.AuthHeader = &persistencespb.NexusEndpointTarget_AuthHeader{
+			Name:        c.String(FlagTargetAuthHeaderName),
+			SecretName:  c.String(FlagTargetAuthSecretName),
+			ValuePrefix: c.String(FlagTargetAuthValuePrefix),
+		}
+	}
+	resp, err := adminClient.UpdateNexusEndpointOutboundSettings(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to update Nexus endpoint outbound settings: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
 // AdminDescribeHistoryHost describes history host
 func AdminDescribeHistoryHost(c *cli.Context, clientFactory ClientFactory) error {
 	adminClient := clientFactory.AdminClient(c)
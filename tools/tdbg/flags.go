@@ -91,4 +91,25 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagEndpointID                 = "endpoint-id"
+	FlagEndpointVersion            = "endpoint-version"
+	FlagTargetTLSClientCertFile    = "target-tls-client-cert-file"
+	FlagTargetTLSClientKeyFile     = "target-tls-client-key-file"
+	FlagTargetTLSCAFile            = "target-tls-ca-file"
+	FlagTargetTLSServerName        = "target-tls-server-name"
+	FlagTargetAuthHeaderName       = "target-auth-header-name"
+	FlagTargetAuthSecretName       = "target-auth-secret-name"
+	FlagTargetAuthValuePrefix      = "target-auth-value-prefix"
+	FlagTypeRateLimit              = "type-rate-limit"
+	FlagUnsetTypeRateLimit         = "unset-type-rate-limit"
+	FlagBuildID                    = "build-id"
+	FlagSubqueue                   = "subqueue"
+	FlagTypeName                   = "type-name"
+	FlagDestination                = "destination"
+	FlagSource                     = "source"
+	FlagVisibility                 = "visibility"
+	FlagDatabase                   = "db"
+	FlagConnectAttributes          = "connect-attributes"
+	FlagStartTime                  = "start-time"
+	FlagEndTime                    = "end-time"
 )
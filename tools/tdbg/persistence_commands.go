@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+)
+
+// AdminBackupPersistence asks the frontend to back up a persistence store while the cluster is running
+func AdminBackupPersistence(c *cli.Context, clientFactory ClientFactory) error {
+	destination, err := getRequiredOption(c, FlagDestination)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.BackupPersistence(ctx, &adminservice.BackupPersistenceRequest{
+		DestinationPath: destination,
+		Visibility:      c.Bool(FlagVisibility),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to back up persistence: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminRestorePersistence restores a local SQLite database from a backup. Unlike a backup, it cannot be done
+// through a running server, which would keep serving from state that no longer matches the database.
+func AdminRestorePersistence(c *cli.Context, prompter *Prompter) error {
+	database, err := getRequiredOption(c, FlagDatabase)
+	if err != nil {
+		return err
+	}
+	source, err := getRequiredOption(c, FlagSource)
+	if err != nil {
+		return err
+	}
+
+	connectAttributes, err := parseConnectAttributes(c.String(FlagConnectAttributes))
+	if err != nil {
+		return err
+	}
+
+	prompter.Prompt(fmt.Sprintf("Database: %s Backup: %s\nThe server using the database must be stopped. Replace the database contents with the backup?",
+		database, source))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	cfg := &config.SQL{
+		PluginName:        sqlite.PluginName,
+		DatabaseName:      database,
+		ConnectAttributes: connectAttributes,
+	}
+	if err := sql.RestoreDB(ctx, cfg, source); err != nil {
+		return fmt.Errorf("unable to restore persistence: %w", err)
+	}
+	fmt.Fprintf(c.App.Writer, "Restored %s from %s\n", database, source)
+	return nil
+}
+
+// parseConnectAttributes parses connect attributes given as a query string, the format also used by
+// temporal-sql-tool.
+func parseConnectAttributes(query string) (map[string]string, error) {
+	attrs := make(map[string]string)
+	if query == "" {
+		return attrs, nil
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connect attributes: %w", err)
+	}
+	for key, vals := range values {
+		if len(vals) > 1 {
+			return nil, fmt.Errorf("invalid connect attribute %v, only 1 value allowed: %v", key, vals)
+		}
+		attrs[key] = vals[0]
+	}
+	return attrs, nil
+}
@@ -27,11 +27,14 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 )
 
@@ -267,3 +270,235 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+// AdminUpdateTaskQueueConfig updates the per-type dispatch rate limits of a task queue
+func AdminUpdateTaskQueueConfig(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && tqType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return errors.New("task queue type must be workflow or activity")
+	}
+
+	setLimits := make(map[string]*persistencespb.TaskTypeRateLimit)
+	for _, limit := range c.StringSlice(FlagTypeRateLimit) {
+		typeName, rpsString, ok := strings.Cut(limit, "=")
+		if !ok || typeName == "" {
+			return fmt.Errorf("invalid type rate limit %q: expected <type>=<requests per second>", limit)
+		}
+		rps, err := strconv.ParseFloat(rpsString, 64)
+		if err != nil {
+			return fmt.Errorf("invalid type rate limit %q: %w", limit, err)
+		}
+		setLimits[typeName] = &persistencespb.TaskTypeRateLimit{RequestsPerSecond: rps}
+	}
+	unsetLimits := c.StringSlice(FlagUnsetTypeRateLimit)
+	if len(setLimits) == 0 && len(unsetLimits) == 0 {
+		return fmt.Errorf("at least one of --%s or --%s must be provided", FlagTypeRateLimit, FlagUnsetTypeRateLimit)
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.UpdateTaskQueueConfigRequest{
+		Namespace:           namespace,
+		TaskQueue:           tqName,
+		TaskQueueType:       tqType,
+		SetTypeRateLimits:   setLimits,
+		UnsetTypeRateLimits: unsetLimits,
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.UpdateTaskQueueConfig(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to update Task Queue config: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminListTaskQueueBacklog lists the backlog tasks of a task queue partition
+func AdminListTaskQueueBacklog(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqPartition, err := getTaskQueuePartition(c)
+	if err != nil {
+		return err
+	}
+	pageSize := defaultPageSize
+	if c.IsSet(FlagPageSize) {
+		pageSize = c.Int(FlagPageSize)
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.ListTaskQueueBacklogRequest{
+		Namespace:          namespace,
+		TaskQueuePartition: tqPartition,
+		BuildId:            c.String(FlagBuildID),
+		Subqueue:           int32(c.Int(FlagSubqueue)),
+		WorkflowId:         c.String(FlagWorkflowID),
+		TypeName:           c.String(FlagTypeName),
+		PageSize:           int32(pageSize),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		req.NextPageToken = paginationToken
+		response, err := client.ListTaskQueueBacklog(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []interface{}
+		for _, task := range response.Tasks {
+			items = append(items, task)
+		}
+		return items, response.NextPageToken, nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list Task Queue backlog: %w", err)
+	}
+	return nil
+}
+
+// AdminDeleteTaskQueueBacklogTasks deletes a range of backlog tasks from a task queue partition
+func AdminDeleteTaskQueueBacklogTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqPartition, err := getTaskQueuePartition(c)
+	if err != nil {
+		return err
+	}
+	minTaskID := c.Int64(FlagMinTaskID)
+	maxTaskID := c.Int64(FlagMaxTaskID)
+	if minTaskID >= maxTaskID {
+		return fmt.Errorf("--%s must be less than --%s", FlagMinTaskID, FlagMaxTaskID)
+	}
+
+	prompter.Prompt(fmt.Sprintf("Namespace: %s TaskQueue: %s TaskIDs: [%d, %d)\nDelete above backlog tasks?",
+		namespace, tqPartition.GetTaskQueue(), minTaskID, maxTaskID))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DeleteTaskQueueBacklogTasks(ctx, &adminservice.DeleteTaskQueueBacklogTasksRequest{
+		Namespace:          namespace,
+		TaskQueuePartition: tqPartition,
+		BuildId:            c.String(FlagBuildID),
+		InclusiveMinTaskId: minTaskID,
+		ExclusiveMaxTaskId: maxTaskID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete Task Queue backlog tasks: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminListTaskQueueWorkers lists the workers that recently polled a task queue
+func AdminListTaskQueueWorkers(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return errors.New("invalid task queue type")
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.ListTaskQueueWorkers(ctx, &adminservice.ListTaskQueueWorkersRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list Task Queue workers: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminGetTaskQueueScalingRecommendation gets the scaling recommendation of a task queue
+func AdminGetTaskQueueScalingRecommendation(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType := enumspb.TASK_QUEUE_TYPE_UNSPECIFIED
+	if c.IsSet(FlagTaskQueueType) {
+		tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+		if err != nil {
+			return fmt.Errorf("invalid task queue type: %w", err)
+		}
+		tqType = enumspb.TaskQueueType(tlTypeInt)
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.GetTaskQueueScalingRecommendation(ctx, &adminservice.GetTaskQueueScalingRecommendationRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get Task Queue scaling recommendation: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+func getTaskQueuePartition(c *cli.Context) (*taskqueuespb.TaskQueuePartition, error) {
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return nil, err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, errors.New("invalid task queue type")
+	}
+
+	tqPartition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	}
+	if stickyName := c.String(FlagStickyName); stickyName != "" {
+		tqPartition.PartitionId = &taskqueuespb.TaskQueuePartition_StickyName{StickyName: stickyName}
+	} else {
+		tqPartition.PartitionId = &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(c.Int(FlagPartitionID))}
+	}
+	return tqPartition, nil
+}
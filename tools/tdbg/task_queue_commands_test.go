@@ -27,6 +27,7 @@ package tdbg
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,10 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		listTaskQueueBacklogFn          func(request *adminservice.ListTaskQueueBacklogRequest) (*adminservice.ListTaskQueueBacklogResponse, error)
+		deleteTaskQueueBacklogTasksFn   func(request *adminservice.DeleteTaskQueueBacklogTasksRequest) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error)
+		listTaskQueueWorkersFn          func(request *adminservice.ListTaskQueueWorkersRequest) (*adminservice.ListTaskQueueWorkersResponse, error)
+		getScalingRecommendationFn      func(request *adminservice.GetTaskQueueScalingRecommendationRequest) (*adminservice.GetTaskQueueScalingRecommendationResponse, error)
 	}
 )
 
@@ -106,6 +111,22 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) ListTaskQueueBacklog(_ context.Context, request *adminservice.ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	return t.listTaskQueueBacklogFn(request)
+}
+
+func (t *testClient) DeleteTaskQueueBacklogTasks(_ context.Context, request *adminservice.DeleteTaskQueueBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+	return t.deleteTaskQueueBacklogTasksFn(request)
+}
+
+func (t *testClient) ListTaskQueueWorkers(_ context.Context, request *adminservice.ListTaskQueueWorkersRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueWorkersResponse, error) {
+	return t.listTaskQueueWorkersFn(request)
+}
+
+func (t *testClient) GetTaskQueueScalingRecommendation(_ context.Context, request *adminservice.GetTaskQueueScalingRecommendationRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+	return t.getScalingRecommendationFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
@@ -118,6 +139,22 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 		forceUnloadTaskQueuePartitionFn: func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 			return &adminservice.ForceUnloadTaskQueuePartitionResponse{}, nil
 		},
+		listTaskQueueBacklogFn: func(request *adminservice.ListTaskQueueBacklogRequest) (*adminservice.ListTaskQueueBacklogResponse, error) {
+			return &adminservice.ListTaskQueueBacklogResponse{}, nil
+		},
+		deleteTaskQueueBacklogTasksFn: func(request *adminservice.DeleteTaskQueueBacklogTasksRequest) (*adminservice.DeleteTaskQueueBacklogTasksResponse, error) {
+			s.Equal(int64(10), request.GetInclusiveMinTaskId())
+			s.Equal(int64(20), request.GetExclusiveMaxTaskId())
+			return &adminservice.DeleteTaskQueueBacklogTasksResponse{}, nil
+		},
+		listTaskQueueWorkersFn: func(request *adminservice.ListTaskQueueWorkersRequest) (*adminservice.ListTaskQueueWorkersResponse, error) {
+			s.Equal("test", request.GetTaskQueue())
+			return &adminservice.ListTaskQueueWorkersResponse{}, nil
+		},
+		getScalingRecommendationFn: func(request *adminservice.GetTaskQueueScalingRecommendationRequest) (*adminservice.GetTaskQueueScalingRecommendationResponse, error) {
+			s.Equal("test", request.GetTaskQueue())
+			return &adminservice.GetTaskQueueScalingRecommendationResponse{}, nil
+		},
 	}
 	s.app = NewCliApp(func(params *Params) {
 		params.ClientFactory = client
@@ -187,3 +224,120 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+// TestListTaskQueueBacklog tests that the cli accepts the various arguments for --list-backlog
+func (s *taskQueueCommandTestSuite) TestListTaskQueueBacklog() {
+	listBacklogTests := make([]AdminTests, len(testCases))
+	copy(listBacklogTests, testCases)
+	listBacklogTests = append(listBacklogTests,
+		AdminTests{
+			Name:       "filters",
+			inputFlags: []string{"--workflow-id", "wf", "--type-name", "type", "--subqueue", "1", "--build-id", "b1"},
+			err:        nil,
+		},
+	)
+
+	baseCommand := []string{"tdbg", "taskqueue", "list-backlog", "--task-queue", "test"}
+
+	for _, test := range listBacklogTests {
+		cliCommand := append(baseCommand, test.inputFlags...)
+		resp := s.app.Run(cliCommand)
+		if resp != nil {
+			s.ErrorContainsf(resp, test.err.Error(), "error present")
+		}
+	}
+}
+
+// TestDeleteTaskQueueBacklogTasks tests that the cli accepts the various arguments for
+// --delete-backlog-tasks
+func (s *taskQueueCommandTestSuite) TestDeleteTaskQueueBacklogTasks() {
+	deleteTests := make([]AdminTests, len(testCases))
+	copy(deleteTests, testCases)
+	deleteTests = append(deleteTests,
+		AdminTests{
+			Name:       "empty range",
+			inputFlags: []string{"--max-task-id", "10"},
+			err:        errors.New("--min-task-id must be less than --max-task-id"), // nolint
+		},
+	)
+
+	baseCommand := []string{"tdbg", "--yes", "taskqueue", "delete-backlog-tasks", "--task-queue", "test",
+		"--min-task-id", "10", "--max-task-id", "20"}
+
+	for _, test := range deleteTests {
+		cliCommand := append(slices.Clone(baseCommand), test.inputFlags...)
+		resp := s.app.Run(cliCommand)
+		if test.err == nil {
+			s.NoError(resp)
+		} else {
+			s.ErrorContainsf(resp, test.err.Error(), "error present")
+		}
+	}
+}
+
+// TestListTaskQueueWorkers tests that the cli accepts the various arguments for --list-workers
+func (s *taskQueueCommandTestSuite) TestListTaskQueueWorkers() {
+	listWorkersTests := []AdminTests{
+		{
+			Name:       "default task queue type",
+			inputFlags: []string{},
+			err:        nil,
+		},
+		{
+			Name:       "activity task queue type",
+			inputFlags: []string{"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY"},
+			err:        nil,
+		},
+		{
+			Name:       "invalid task queue type",
+			inputFlags: []string{"--task-queue-type", "foo"},
+			err:        errors.New("invalid task queue type"), // nolint
+		},
+	}
+
+	baseCommand := []string{"tdbg", "taskqueue", "list-workers", "--task-queue", "test"}
+
+	for _, test := range listWorkersTests {
+		cliCommand := append(slices.Clone(baseCommand), test.inputFlags...)
+		resp := s.app.Run(cliCommand)
+		if test.err == nil {
+			s.NoError(resp)
+		} else {
+			s.ErrorContainsf(resp, test.err.Error(), "error present")
+		}
+	}
+}
+
+// TestGetTaskQueueScalingRecommendation tests that the cli accepts the various arguments for
+// --get-scaling-recommendation
+func (s *taskQueueCommandTestSuite) TestGetTaskQueueScalingRecommendation() {
+	tests := []AdminTests{
+		{
+			Name:       "all task queue types",
+			inputFlags: []string{},
+			err:        nil,
+		},
+		{
+			Name:       "activity task queue type",
+			inputFlags: []string{"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY"},
+			err:        nil,
+		},
+		{
+			Name:       "invalid task queue type",
+			inputFlags: []string{"--task-queue-type", "foo"},
+			err:        errors.New("invalid task queue type"), // nolint
+		},
+	}
+
+	baseCommand := []string{"tdbg", "taskqueue", "get-scaling-recommendation", "--task-queue", "test"}
+
+	for _, test := range tests {
+		cliCommand := append(slices.Clone(baseCommand), test.inputFlags...)
+		resp := s.app.Run(cliCommand)
+		if test.err == nil {
+			s.NoError(resp)
+		} else {
+			s.ErrorContainsf(resp, test.err.Error(), "error present")
+		}
+	}
+}
@@ -67,7 +67,12 @@ func getCommands(
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
 			Usage:       "Run admin operation on taskQueue",
-			Subcommands: newAdminTaskQueueCommands(clientFactory),
+			Subcommands: newAdminTaskQueueCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "nexus",
+			Usage:       "Run admin operation on Nexus endpoints",
+			Subcommands: newAdminNexusCommands(clientFactory),
 		},
 		{
 			Name:        "membership",
@@ -92,6 +97,16 @@ func getCommands(
 			Usage:       "Decode payload",
 			Subcommands: newDecodeCommands(taskBlobEncoder),
 		},
+		{
+			Name:        "persistence",
+			Usage:       "Back up and restore persistence stores",
+			Subcommands: newAdminPersistenceCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "usage",
+			Usage:       "Show the resource usage metered by the history service",
+			Subcommands: newAdminUsageCommands(clientFactory),
+		},
 	}
 }
 
@@ -388,6 +403,72 @@ func getTaskCategoryFlag(taskCategoryRegistry tasks.TaskCategoryRegistry) *cli.S
 	return flag
 }
 
+func newAdminNexusCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "describe-endpoint",
+			Usage: "Describe the circuit breaker state of a Nexus endpoint on all history hosts",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagEndpointID,
+					Usage:    "Nexus endpoint ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeNexusEndpoint(c, clientFactory)
+			},
+		},
+		{
+			Name:  "update-outbound-settings",
+			Usage: "Set the outbound TLS and auth header settings of a Nexus endpoint with an external target, settings that are not set are cleared",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagEndpointID,
+					Usage:    "Nexus endpoint ID",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagEndpointVersion,
+					Usage:    "Current version of the Nexus endpoint",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetTLSClientCertFile,
+					Usage: "Path to a PEM encoded client certificate presented to the target, on the history hosts",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetTLSClientKeyFile,
+					Usage: "Path to the PEM encoded private key of the client certificate, on the history hosts",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetTLSCAFile,
+					Usage: "Path to a PEM encoded CA bundle used to verify the target's certificate, on the history hosts",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetTLSServerName,
+					Usage: "Server name used for SNI and certificate verification",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetAuthHeaderName,
+					Usage: "Name of a header injected into every request to the target",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetAuthSecretName,
+					Usage: "Name of the secret used as the auth header value",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetAuthValuePrefix,
+					Usage: "Prefix prepended to the secret value, e.g. \"Bearer \"",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateNexusEndpointOutboundSettings(c, clientFactory)
+			},
+		},
+	}
+}
+
 func newAdminMembershipCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
@@ -482,7 +563,7 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
-func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminTaskQueueCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "list-tasks",
@@ -607,6 +688,257 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "update-config",
+			Usage: "Update per-type dispatch rate limits of a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTypeRateLimit,
+					Usage: "Rate limit for a workflow or activity type in the format <type>=<requests per second>. Can be passed multiple times",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagUnsetTypeRateLimit,
+					Usage: "Workflow or activity type whose rate limit should be removed. Can be passed multiple times",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateTaskQueueConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list-backlog",
+			Usage: "List backlog tasks of a task queue partition, optionally filtered by workflow ID or type",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.Int64Flag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagStickyName,
+					Usage: "Sticky Name for a task queue partition, if present",
+					Value: "",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Build ID of the versioned queue. Unversioned queue if not set",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to read",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowID,
+					Usage: "Only list tasks of this workflow ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagTypeName,
+					Usage: "Only list tasks of this workflow or activity type",
+				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListTaskQueueBacklog(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete-backlog-tasks",
+			Usage: "Delete a range of backlog tasks from a task queue partition",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.Int64Flag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagStickyName,
+					Usage: "Sticky Name for a task queue partition, if present",
+					Value: "",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Build ID of the versioned queue. Unversioned queue if not set",
+				},
+				&cli.Int64Flag{
+					Name:     FlagMinTaskID,
+					Usage:    "Inclusive min task ID",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagMaxTaskID,
+					Usage:    "Exclusive max task ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteTaskQueueBacklogTasks(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "list-workers",
+			Usage: "List workers that recently polled a task queue, with the metadata they reported",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListTaskQueueWorkers(c, clientFactory)
+			},
+		},
+		{
+			Name:  "get-scaling-recommendation",
+			Usage: "Get aggregated backlog stats and a recommended worker scaling ratio for a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue type: activity, workflow, nexus (experimental). Workflow and activity are combined if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetTaskQueueScalingRecommendation(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminPersistenceCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "backup",
+			Usage: "Write a consistent copy of a persistence store to a file in the backup directory (sql.backupDir) of the frontend host that serves the request, while the cluster is running (SQLite only)",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDestination,
+					Usage:    "Path of the backup file relative to the backup directory, must not exist yet",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagVisibility,
+					Usage: "Back up the visibility store instead of the default store",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminBackupPersistence(c, clientFactory)
+			},
+		},
+		{
+			Name:  "restore",
+			Usage: "Replace the contents of a local SQLite database with a backup. The server using the database must be stopped",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDatabase,
+					Usage:    "Path of the SQLite database file to restore into",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagSource,
+					Usage:    "Path of the backup file",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagConnectAttributes,
+					Usage: "SQLite connect attributes of the database as a query string, e.g. '_pragma=journal_mode(WAL)'. Use the connectAttributes of the server's persistence config",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestorePersistence(c, prompterFactory(c))
+			},
+		},
+	}
+}
+
+func newAdminUsageCommands(clientFactory ClientFactory) []*cli.Command {
+	timeUsage := "Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+		"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+		"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes."
+	return []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "List the usage records of a namespace, aggregated per workflow type and cost center",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Only list records whose metering window ends after this time. " + timeUsage,
+				},
+				&cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "Only list records whose metering window starts before this time. " + timeUsage,
+				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: defaultPageSize,
+					Usage: "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Value: true,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListUsageRecords(c, clientFactory)
+			},
+		},
 	}
 }
 
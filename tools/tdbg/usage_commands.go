@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminListUsageRecords lists the usage records of a namespace
+func AdminListUsageRecords(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	req := &adminservice.ListUsageRecordsRequest{
+		Namespace: namespace,
+		PageSize:  int32(c.Int(FlagPageSize)),
+	}
+	now := time.Now().UTC()
+	if c.IsSet(FlagStartTime) {
+		startTime, err := parseTime(c.String(FlagStartTime), time.Time{}, now)
+		if err != nil {
+			return err
+		}
+		req.StartTime = timestamppb.New(startTime)
+	}
+	if c.IsSet(FlagEndTime) {
+		endTime, err := parseTime(c.String(FlagEndTime), time.Time{}, now)
+		if err != nil {
+			return err
+		}
+		req.EndTime = timestamppb.New(endTime)
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		req.NextPageToken = paginationToken
+		response, err := client.ListUsageRecords(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []interface{}
+		for _, record := range response.Records {
+			items = append(items, record)
+		}
+		return items, response.NextPageToken, nil
+	}
+	if err := paginate(c, paginationFunc, c.Int(FlagPageSize)); err != nil {
+		return fmt.Errorf("unable to list usage records: %w", err)
+	}
+	return nil
+}